@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/knadh/koanf/providers/env"
 	"github.com/knadh/koanf/providers/structs"
 	"github.com/knadh/koanf/v2"
 	flag "github.com/spf13/pflag"
 
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/digest"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/hooks/github"
@@ -28,12 +31,26 @@ type (
 		Nomad   *nomad.Config   `koanf:"NOMAD" json:"nomad"`     // Configuration for Nomad.
 		Github  *github.Config  `koanf:"GITHUB" json:"github"`   // Configuration for the github.
 		Slack   *slack.Config   `koanf:"SLACK" json:"slack"`     // Configuration for the slack.
+		Digest  *digest.Config  `koanf:"DIGEST" json:"digest"`   // Configuration for the digest emails.
 
-		Secret  string `koanf:"SECRET" json:"secret"`   // Secret key for JWE.
-		Debug   bool   `koanf:"DEBUG" json:"debug"`     // Flag to enable debug mode.
-		Migrate bool   `koanf:"MIGRATE" json:"migrate"` // Flag to enable database migration.
+		Secret          string `koanf:"SECRET" json:"secret"`                     // Secret key for JWE and sensitive fields.
+		PreviousSecrets string `koanf:"PREVIOUS_SECRETS" json:"previous_secrets"` // Comma separated secrets before rotation.
+		Debug           bool   `koanf:"DEBUG" json:"debug"`                       // Flag to enable debug mode.
+		Migrate         bool   `koanf:"MIGRATE" json:"migrate"`                   // Flag to enable database migration.
+		DomainStub      bool   `koanf:"DOMAIN_STUB" json:"domain_stub"`           // Flag to verify every domain without DNS lookup.
 
 		Mode Mode `koanf:"MODE" json:"mode"`
+
+		ServiceKey ServiceKey `koanf:"-" json:"-"` // Flags of the service-key mode.
+	}
+
+	// ServiceKey holds the flags of the service-key mode. A key is issued, unless a key to rotate or to revoke is given.
+	ServiceKey struct {
+		Name   string
+		Scopes []string
+		Rotate string
+		Revoke string
+		TTL    time.Duration
 	}
 )
 
@@ -42,6 +59,8 @@ const (
 	ModeWebhook Mode = "webhook"
 	ModeGRPC    Mode = "grpc"
 	ModeWorkers Mode = "queues"
+	ModeKey     Mode = "service-key"
+	ModeRekey   Mode = "rekey"
 	ModeDefault Mode = "default"
 )
 
@@ -52,6 +71,7 @@ func (c *Config) Load() {
 	c.Pulse = &pulse.DefaultConfig
 	c.Github = &github.Config{}
 	c.Slack = &slack.Config{}
+	c.Digest = &digest.DefaultConfig
 
 	k := koanf.New("__")
 
@@ -88,6 +108,9 @@ func (c *Config) Parse() {
 		"webhook": ModeWebhook,
 		"grpc":    ModeGRPC,
 		"queues":  ModeWorkers,
+
+		"service-key": ModeKey,
+		"rekey":       ModeRekey,
 	}
 
 	flag.BoolVarP(&help, "help", "h", false, "show help message")
@@ -97,8 +120,17 @@ func (c *Config) Parse() {
 		"webhook": flag.BoolP("webhook", "w", false, "start webhook server"),
 		"grpc":    flag.BoolP("grpc", "g", false, "start gRPC server (nomad)"),
 		"queues":  flag.BoolP("queues", "q", false, "start queues worker"),
+
+		"service-key": flag.BoolP("service-key", "k", false, "issue, rotate or revoke a service key"),
+		"rekey":       flag.Bool("rekey", false, "encrypt the sensitive fields again with the current secret"),
 	}
 
+	flag.StringVar(&c.ServiceKey.Name, "key-name", "", "name of the service key to issue")
+	flag.StringSliceVar(&c.ServiceKey.Scopes, "key-scopes", nil, "scopes of the service key to issue, e.g. users:read")
+	flag.StringVar(&c.ServiceKey.Rotate, "key-rotate", "", "id of the service key to rotate")
+	flag.StringVar(&c.ServiceKey.Revoke, "key-revoke", "", "id of the service key to revoke")
+	flag.DurationVar(&c.ServiceKey.TTL, "key-ttl", auth.ServiceKeyTTLDefault, "lifetime of the issued service key")
+
 	flag.Parse()
 
 	if help {
@@ -3,16 +3,19 @@ package config
 import (
 	"log/slog"
 	"os"
+	"strings"
 
 	"go.breu.io/graceful"
 
 	"go.breu.io/quantm/cmd/quantm/workers"
 	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/digest"
 	"go.breu.io/quantm/internal/core/kernel"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/hooks/github"
 	"go.breu.io/quantm/internal/hooks/slack"
+	"go.breu.io/quantm/internal/hooks/webhook"
 	"go.breu.io/quantm/internal/nomad"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 	"go.breu.io/quantm/internal/pulse"
@@ -24,11 +27,15 @@ const (
 	ServiceKernel     = "kernel"
 	ServiceDB         = "db"
 	ServicePulse      = "pulse"
+	ServicePulseBatch = "pulse_batch"
+	ServicePulseRelay = "pulse_relay"
+	ServicePulseSinks = "pulse_sinks"
 	ServiceDurable    = "durable"
 	ServiceWebhook    = "webhook"
 	ServiceNomad      = "nomad"
 	ServiceCoreQueue  = "core_queue"
 	ServiceHooksQueue = "hooks_queue"
+	ServiceDigest     = "digest"
 )
 
 // Setup configures the application based on the provided config.
@@ -63,6 +70,8 @@ func (c *Config) Setup(app *graceful.Graceful) error {
 
 		app.Add(ServiceCoreQueue, durable.OnCore(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
 		app.Add(ServiceHooksQueue, durable.OnHooks(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
+		app.Add(ServiceDigest, &digest.Schedule{}, ServiceCoreQueue)
+		app.Add(ServicePulseRelay, pulse.NewRelay(pulse.RelayInterval), ServiceDB, ServicePulseBatch)
 	case ModeDefault:
 		if err := c.SetupServices(app); err != nil {
 			return err
@@ -75,6 +84,8 @@ func (c *Config) Setup(app *graceful.Graceful) error {
 		app.Add(ServiceNomad, nomad.New(nomad.WithConfig(c.Nomad)), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
 		app.Add(ServiceCoreQueue, durable.OnCore(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
 		app.Add(ServiceHooksQueue, durable.OnHooks(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
+		app.Add(ServiceDigest, &digest.Schedule{}, ServiceCoreQueue)
+		app.Add(ServicePulseRelay, pulse.NewRelay(pulse.RelayInterval), ServiceDB, ServicePulseBatch)
 	default:
 	}
 
@@ -100,10 +111,30 @@ func (c *Config) SetupLogger() {
 	slog.SetDefault(slog.New(handler))
 }
 
+// SetupSecrets configures the secret, and the previous secrets accepted after its rotation, for the JWEs and the
+// sensitive fields.
+func (c *Config) SetupSecrets() {
+	previous := make([]string, 0)
+
+	for _, secret := range strings.Split(c.PreviousSecrets, ",") {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			previous = append(previous, secret)
+		}
+	}
+
+	auth.SetSecret(c.Secret)
+	auth.SetPreviousSecrets(previous...)
+	db.SetSecrets(c.Secret, previous...)
+}
+
 // SetupServices configures common services.
 func (c *Config) SetupServices(app *graceful.Graceful) error {
 	c.SetupLogger()
-	auth.SetSecret(c.Secret)
+	c.SetupSecrets()
+
+	if c.DomainStub {
+		auth.StubDomainLookup()
+	}
 
 	if err := c.Github.Validate(); err != nil {
 		return err
@@ -117,9 +148,16 @@ func (c *Config) SetupServices(app *graceful.Graceful) error {
 
 	slack.Configure(slack.WithConfig(c.Slack))
 
+	if err := c.Digest.Validate(); err != nil {
+		return err
+	}
+
+	digest.Configure(digest.WithConfig(c.Digest))
+
 	kernel.Configure(
 		kernel.WithRepoHook(eventsv1.RepoHook_REPO_HOOK_GITHUB, &github.KernelImpl{}),
 		kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_SLACK, &slack.KernelImpl{}),
+		kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_WEBHOOK, &webhook.KernelImpl{}),
 	)
 
 	if err := c.SetupDB(); err != nil {
@@ -139,6 +177,8 @@ func (c *Config) SetupServices(app *graceful.Graceful) error {
 	app.Add(ServiceKernel, kernel.Get(), ServiceGithub)
 	app.Add(ServiceDB, db.Get())
 	app.Add(ServicePulse, pulse.Get())
+	app.Add(ServicePulseSinks, pulse.Sinks(), ServicePulse)
+	app.Add(ServicePulseBatch, pulse.Buffered(), ServicePulse, ServicePulseSinks)
 	app.Add(ServiceDurable, durable.Get())
 
 	return nil
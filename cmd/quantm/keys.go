@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/cmd/quantm/config"
+	"go.breu.io/quantm/internal/auth"
+)
+
+// service_key issues, rotates or revokes a service key. The token of an issued key is printed once, it cannot be
+// recovered afterwards.
+func service_key(ctx context.Context, flags config.ServiceKey) error {
+	if flags.Revoke != "" {
+		id, err := uuid.Parse(flags.Revoke)
+		if err != nil {
+			return err
+		}
+
+		if err := auth.RevokeServiceKey(ctx, id); err != nil {
+			return err
+		}
+
+		fmt.Printf("revoked %s\n", id)
+
+		return nil
+	}
+
+	if flags.Rotate != "" {
+		id, err := uuid.Parse(flags.Rotate)
+		if err != nil {
+			return err
+		}
+
+		token, key, err := auth.RotateServiceKey(ctx, id, flags.TTL, auth.ServiceKeyGraceDefault)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("rotated %s, it expires in %s\nid: %s\ntoken: %s\n", id, auth.ServiceKeyGraceDefault, key.ID, token)
+
+		return nil
+	}
+
+	if flags.Name == "" || len(flags.Scopes) == 0 {
+		return errors.New("--key-name and --key-scopes are required to issue a service key")
+	}
+
+	token, key, err := auth.IssueServiceKey(ctx, flags.Name, flags.Scopes, flags.TTL)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("id: %s\ntoken: %s\n", key.ID, token)
+
+	return nil
+}
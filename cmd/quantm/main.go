@@ -12,6 +12,7 @@ import (
 	"go.breu.io/quantm/cmd/quantm/config"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/migrations"
+	"go.breu.io/quantm/internal/pulse"
 )
 
 func main() {
@@ -22,12 +23,74 @@ func main() {
 	conf.Parse()
 
 	if conf.Mode == config.ModeMigrate {
-		if err := migrations.Run(ctx, db.Get(db.WithConfig(conf.DB))); err != nil {
+		conn := db.Get(db.WithConfig(conf.DB))
+
+		if err := conn.Start(ctx); err != nil {
+			slog.Error("unable to connect to database", "error", err.Error())
+
+			os.Exit(1)
+		}
+
+		if err := migrations.Run(ctx, conn); err != nil {
 			slog.Error("unable to run migrations", "error", err.Error())
 
 			os.Exit(1)
 		}
 
+		// pulse tables are migrated per org, so the orgs must be read after the database migrations.
+		if err := pulse.MigrateAll(ctx, pulse.WithConfig(conf.Pulse)); err != nil {
+			slog.Error("unable to run pulse migrations", "error", err.Error())
+
+			os.Exit(1)
+		}
+
+		_ = conn.Stop(ctx)
+
+		os.Exit(0)
+	}
+
+	if conf.Mode == config.ModeKey {
+		conn := db.Get(db.WithConfig(conf.DB))
+
+		if err := conn.Start(ctx); err != nil {
+			slog.Error("unable to connect to database", "error", err.Error())
+
+			os.Exit(1)
+		}
+
+		if err := service_key(ctx, conf.ServiceKey); err != nil {
+			slog.Error("unable to manage service key", "error", err.Error())
+
+			os.Exit(1)
+		}
+
+		_ = conn.Stop(ctx)
+
+		os.Exit(0)
+	}
+
+	if conf.Mode == config.ModeRekey {
+		conn := db.Get(db.WithConfig(conf.DB))
+
+		if err := conn.Start(ctx); err != nil {
+			slog.Error("unable to connect to database", "error", err.Error())
+
+			os.Exit(1)
+		}
+
+		conf.SetupSecrets()
+
+		count, err := db.Rekey(ctx)
+		if err != nil {
+			slog.Error("unable to rekey sensitive fields", "error", err.Error(), "count", count)
+
+			os.Exit(1)
+		}
+
+		slog.Info("sensitive fields rekeyed", "count", count)
+
+		_ = conn.Stop(ctx)
+
 		os.Exit(0)
 	}
 
@@ -3,6 +3,8 @@ package workers
 import (
 	"go.breu.io/durex/queues"
 
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/digest"
 	"go.breu.io/quantm/internal/core/repos"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/pulse"
@@ -30,5 +32,13 @@ func Core() {
 		// Register branch workflows and activities
 		q.RegisterWorkflow(repos.BranchWorkflow)
 		q.RegisterActivity(repos.NewBranchActivities())
+
+		// Register digest workflow and activities
+		q.RegisterWorkflow(digest.Workflow)
+		q.RegisterActivity(&digest.Activities{})
+
+		// Register domain verification workflow and activities
+		q.RegisterWorkflow(auth.VerifyDomainWorkflow)
+		q.RegisterActivity(&auth.DomainActivities{})
 	}
 }
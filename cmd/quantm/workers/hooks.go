@@ -32,5 +32,14 @@ func Hooks() {
 		// Register github ref workflow and activity
 		q.RegisterWorkflow(github.RefWorkflow)
 		q.RegisterActivity(&github.RefActivity{})
+
+		// Register github release workflow and activity
+		q.RegisterWorkflow(github.ReleaseWorkflow)
+		q.RegisterActivity(&github.ReleaseActivity{})
+
+		// Register github deployment workflows and activity
+		q.RegisterWorkflow(github.DeploymentWorkflow)
+		q.RegisterWorkflow(github.DeploymentStatusWorkflow)
+		q.RegisterActivity(&github.DeploymentActivity{})
 	}
 }
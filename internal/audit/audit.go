@@ -0,0 +1,163 @@
+// Package audit records the administrative changes of the orgs in an append-only log. An entry is written with the
+// queries of the transaction of the change, so that the change and its entry are committed, or rolled back, together.
+// The actor, the request id and the ip of the change are read from the context, see WithActor and WithRequest.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	// Actor is the user, the key or the system making a change.
+	Actor struct {
+		Kind  string    // kind of the actor, see ActorUser
+		ID    string    // id of the actor within its kind
+		OrgID uuid.UUID // org of the actor, nil for the service keys and the system
+	}
+
+	// Request is the request making a change.
+	Request struct {
+		ID string // request id, from the X-Request-ID header or generated
+		IP string // ip of the client
+	}
+
+	// Entry is a change of the target within the org. Before and After are marshaled to JSON, protobuf messages with
+	// protojson. They must not hold secrets. Before is nil when the change created the target.
+	Entry struct {
+		OrgID      uuid.UUID
+		Action     string
+		TargetKind string
+		TargetID   string
+		Before     any
+		After      any
+	}
+
+	context_key string
+)
+
+// Kinds of the actors.
+const (
+	ActorUser       = "user"
+	ActorServiceKey = "service_key"
+	ActorAPIKey     = "api_key"
+	ActorGithub     = "github"
+	ActorSystem     = "system"
+)
+
+// Kinds of the targets.
+const (
+	TargetOrg                = "org"
+	TargetRepo               = "repo"
+	TargetUser               = "user"
+	TargetGithubInstallation = "github_installation"
+	TargetChatLink           = "chat_link"
+	TargetSession            = "session"
+)
+
+// Actions.
+const (
+	ActionOrgHooksSet              = "org.hooks.set"
+	ActionRepoCreate               = "repo.create"
+	ActionRepoActivate             = "repo.activate"
+	ActionRepoSuspend              = "repo.suspend"
+	ActionGithubInstallationCreate = "github.installation.create"
+	ActionGithubInstallationUpdate = "github.installation.update"
+	ActionSlackLinkCreate          = "slack.link.create"
+	ActionUserUpdate               = "user.update"
+	ActionUserActivate             = "user.activate"
+	ActionUserDeactivate           = "user.deactivate"
+	ActionSessionRevoke            = "session.revoke"
+	ActionUserSessionsRevoke       = "user.sessions.revoke"
+	ActionSessionSwitch            = "session.switch"
+	ActionOrgMemberRemove          = "org.member.remove"
+)
+
+var (
+	// System is the actor of the changes made by quantm itself.
+	System = Actor{Kind: ActorSystem, ID: "quantm"}
+)
+
+const (
+	actor_key   context_key = "audit_actor"
+	request_key context_key = "audit_request"
+)
+
+// WithActor returns the context of the changes made by the actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actor_key, actor)
+}
+
+// ActorFrom returns the actor of the context, and the system if there is none.
+func ActorFrom(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actor_key).(Actor); ok {
+		return actor
+	}
+
+	return System
+}
+
+// WithRequest returns the context of the changes made by the request.
+func WithRequest(ctx context.Context, id, ip string) context.Context {
+	return context.WithValue(ctx, request_key, Request{ID: id, IP: ip})
+}
+
+// RequestFrom returns the request of the context, empty outside of a request.
+func RequestFrom(ctx context.Context) Request {
+	request, _ := ctx.Value(request_key).(Request)
+
+	return request
+}
+
+// Record writes the entry with the queries, which must be the queries of the transaction of the change.
+func Record(ctx context.Context, q *entities.Queries, entry Entry) error {
+	before, err := marshal(entry.Before)
+	if err != nil {
+		return err
+	}
+
+	after, err := marshal(entry.After)
+	if err != nil {
+		return err
+	}
+
+	actor := ActorFrom(ctx)
+	request := RequestFrom(ctx)
+
+	return q.CreateAuditLog(ctx, entities.CreateAuditLogParams{
+		OrgID:      entry.OrgID,
+		ActorKind:  actor.Kind,
+		ActorID:    actor.ID,
+		Action:     entry.Action,
+		TargetKind: entry.TargetKind,
+		TargetID:   entry.TargetID,
+		Before:     before,
+		After:      after,
+		RequestID:  request.ID,
+		Ip:         request.IP,
+	})
+}
+
+// marshal returns the JSON of the value, nil for a nil value.
+func marshal(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case json.RawMessage:
+		if len(v) == 0 {
+			return nil, nil
+		}
+
+		return v, nil
+	case proto.Message:
+		return protojson.MarshalOptions{UseProtoNames: true}.Marshal(v)
+	default:
+		return json.Marshal(v)
+	}
+}
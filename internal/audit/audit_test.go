@@ -0,0 +1,84 @@
+package audit_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/audit"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	AuditTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *AuditTestSuite) TestContext() {
+	ctx := context.Background()
+
+	s.Equal(audit.System, audit.ActorFrom(ctx))
+	s.Equal(audit.Request{}, audit.RequestFrom(ctx))
+
+	actor := audit.Actor{Kind: audit.ActorUser, ID: uuid.NewString(), OrgID: uuid.New()}
+	ctx = audit.WithRequest(audit.WithActor(ctx, actor), "request", "10.0.0.1")
+
+	s.Equal(actor, audit.ActorFrom(ctx))
+	s.Equal(audit.Request{ID: "request", IP: "10.0.0.1"}, audit.RequestFrom(ctx))
+}
+
+func (s *AuditTestSuite) TestWriteJSONL() {
+	logs := []entities.AuditLog{
+		{
+			ID:         uuid.New(),
+			CreatedAt:  time.Now().UTC(),
+			OrgID:      uuid.New(),
+			ActorKind:  audit.ActorUser,
+			ActorID:    "user",
+			Action:     audit.ActionOrgHooksSet,
+			TargetKind: audit.TargetOrg,
+			TargetID:   "org",
+			Before:     []byte(`{"repo":1}`),
+			After:      []byte(`{"repo":2}`),
+			RequestID:  "request",
+			Ip:         "10.0.0.1",
+		},
+		{
+			ID:         uuid.New(),
+			Action:     audit.ActionRepoCreate,
+			TargetKind: audit.TargetRepo,
+			After:      []byte(`{"is_active":true}`),
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	s.Require().NoError(audit.WriteJSONL(buffer, logs))
+
+	scanner := bufio.NewScanner(buffer)
+	lines := make([]map[string]any, 0)
+
+	for scanner.Scan() {
+		line := make(map[string]any)
+		s.Require().NoError(json.Unmarshal(scanner.Bytes(), &line))
+
+		lines = append(lines, line)
+	}
+
+	s.Require().Len(lines, 2)
+	s.Equal(logs[0].ID.String(), lines[0]["id"])
+	s.Equal(map[string]any{"repo": float64(1)}, lines[0]["before"])
+	s.Equal("10.0.0.1", lines[0]["ip"])
+	s.NotContains(lines[1], "before")
+	s.Equal(map[string]any{"is_active": true}, lines[1]["after"])
+}
+
+func TestAudit(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
@@ -0,0 +1,52 @@
+package cast
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/db/entities"
+	auditv1 "go.breu.io/quantm/internal/proto/ctrlplane/audit/v1"
+)
+
+// LogToProto converts an audit log to its protobuf message.
+func LogToProto(log *entities.AuditLog) *auditv1.AuditLog {
+	return &auditv1.AuditLog{
+		Id:         log.ID.String(),
+		CreatedAt:  timestamppb.New(log.CreatedAt),
+		OrgId:      log.OrgID.String(),
+		ActorKind:  log.ActorKind,
+		ActorId:    log.ActorID,
+		Action:     log.Action,
+		TargetKind: log.TargetKind,
+		TargetId:   log.TargetID,
+		Before:     value(log.Before),
+		After:      value(log.After),
+		RequestId:  log.RequestID,
+		Ip:         log.Ip,
+	}
+}
+
+// LogsToProto converts audit logs to their protobuf messages.
+func LogsToProto(logs []entities.AuditLog) []*auditv1.AuditLog {
+	result := make([]*auditv1.AuditLog, 0, len(logs))
+	for i := range logs {
+		result = append(result, LogToProto(&logs[i]))
+	}
+
+	return result
+}
+
+// value converts JSON to a protobuf value, nil if there is no JSON.
+func value(raw []byte) *structpb.Value {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	v := &structpb.Value{}
+	if err := protojson.Unmarshal(raw, v); err != nil {
+		return nil
+	}
+
+	return v
+}
@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	// Line is an audit log of the JSON Lines export. Before and after are kept as JSON.
+	Line struct {
+		ID         uuid.UUID       `json:"id"`
+		CreatedAt  time.Time       `json:"created_at"`
+		OrgID      uuid.UUID       `json:"org_id"`
+		ActorKind  string          `json:"actor_kind"`
+		ActorID    string          `json:"actor_id"`
+		Action     string          `json:"action"`
+		TargetKind string          `json:"target_kind"`
+		TargetID   string          `json:"target_id"`
+		Before     json.RawMessage `json:"before,omitempty"`
+		After      json.RawMessage `json:"after,omitempty"`
+		RequestID  string          `json:"request_id"`
+		IP         string          `json:"ip"`
+	}
+)
+
+// WriteJSONL writes the audit logs as JSON Lines, one JSON object per line.
+func WriteJSONL(w io.Writer, logs []entities.AuditLog) error {
+	encoder := json.NewEncoder(w)
+
+	for _, log := range logs {
+		line := Line{
+			ID:         log.ID,
+			CreatedAt:  log.CreatedAt,
+			OrgID:      log.OrgID,
+			ActorKind:  log.ActorKind,
+			ActorID:    log.ActorID,
+			Action:     log.Action,
+			TargetKind: log.TargetKind,
+			TargetID:   log.TargetID,
+			Before:     log.Before,
+			After:      log.After,
+			RequestID:  log.RequestID,
+			IP:         log.Ip,
+		}
+
+		if err := encoder.Encode(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package nomad
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/audit"
+	"go.breu.io/quantm/internal/audit/cast"
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	auditv1 "go.breu.io/quantm/internal/proto/ctrlplane/audit/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/audit/v1/auditv1connect"
+)
+
+type (
+	// AuditService is the read path of the audit log. Audit logs are always read from the org of the authenticated user.
+	AuditService struct {
+		auditv1connect.UnimplementedAuditServiceHandler
+	}
+)
+
+var (
+	// AuditServicePolicy declares the permissions required by the procedures of the AuditService.
+	AuditServicePolicy = auth.Policy{
+		auditv1connect.AuditServiceListAuditLogsProcedure:   {auth.PermissionAuditRead},
+		auditv1connect.AuditServiceExportAuditLogsProcedure: {auth.PermissionAuditRead},
+	}
+)
+
+const (
+	PageSizeDefault = 50
+	PageSizeMax     = 500
+
+	ExportSizeDefault = 1000
+	ExportSizeMax     = 5000
+)
+
+// ListAuditLogs lists the audit logs of the org, newest first.
+func (s *AuditService) ListAuditLogs(
+	ctx context.Context, req *connect.Request[auditv1.ListAuditLogsRequest],
+) (*connect.Response[auditv1.ListAuditLogsResponse], error) {
+	params, err := list_params(ctx, req.Msg.GetFilter(), req.Msg.GetCursor(), req.Msg.GetPageSize(), PageSizeDefault, PageSizeMax)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := db.Queries().ListAuditLogs(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuditModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&auditv1.ListAuditLogsResponse{
+		Logs:       cast.LogsToProto(logs),
+		NextCursor: next_cursor(logs, params.RowLimit),
+	}), nil
+}
+
+// ExportAuditLogs exports the audit logs of the org as JSON Lines, newest first.
+func (s *AuditService) ExportAuditLogs(
+	ctx context.Context, req *connect.Request[auditv1.ExportAuditLogsRequest],
+) (*connect.Response[auditv1.ExportAuditLogsResponse], error) {
+	params, err := list_params(
+		ctx, req.Msg.GetFilter(), req.Msg.GetCursor(), req.Msg.GetPageSize(), ExportSizeDefault, ExportSizeMax,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := db.Queries().ListAuditLogs(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuditModule).Wrap(err)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := audit.WriteJSONL(buffer, logs); err != nil {
+		return nil, erratic.NewSystemError(erratic.AuditModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&auditv1.ExportAuditLogsResponse{
+		Jsonl:      buffer.Bytes(),
+		NextCursor: next_cursor(logs, params.RowLimit),
+	}), nil
+}
+
+// list_params returns the query of the filter within the org of the authenticated user. The audit logs are read until
+// now by default, so that the pages of an export do not move.
+func list_params(
+	ctx context.Context, filter *auditv1.AuditLogFilter, cursor string, size, fallback, max_size int32,
+) (entities.ListAuditLogsParams, error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	params := entities.ListAuditLogsParams{
+		OrgID:      org_id,
+		Action:     filter.GetAction(),
+		ActorID:    filter.GetActorId(),
+		TargetKind: filter.GetTargetKind(),
+		TargetID:   filter.GetTargetId(),
+		Until:      time.Now(),
+		RowLimit:   size,
+	}
+
+	if cursor != "" {
+		parsed, err := uuid.Parse(cursor)
+		if err != nil {
+			return params, erratic.NewBadRequestError(erratic.AuditModule).AddHint("cursor", cursor)
+		}
+
+		params.Cursor = parsed
+	}
+
+	if filter.GetSince() != nil {
+		params.Since = filter.GetSince().AsTime()
+	}
+
+	if filter.GetUntil() != nil {
+		params.Until = filter.GetUntil().AsTime()
+	}
+
+	if params.RowLimit <= 0 {
+		params.RowLimit = fallback
+	}
+
+	params.RowLimit = min(params.RowLimit, max_size)
+
+	return params, nil
+}
+
+// next_cursor returns the cursor of the next page, empty if the page is the last one.
+func next_cursor(logs []entities.AuditLog, limit int32) string {
+	if len(logs) == int(limit) {
+		return logs[len(logs)-1].ID.String()
+	}
+
+	return ""
+}
+
+// NewAuditServiceHandler creates a new AuditServiceHandler and returns the service name and handler.
+func NewAuditServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	auth.Declare(AuditServicePolicy)
+
+	return auditv1connect.NewAuditServiceHandler(&AuditService{}, opts...)
+}
@@ -2,18 +2,79 @@ package auth
 
 import (
 	"go.breu.io/quantm/internal/auth/config"
+	"go.breu.io/quantm/internal/auth/domains"
+	"go.breu.io/quantm/internal/auth/keys"
 	"go.breu.io/quantm/internal/auth/nomad"
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/auth/teams"
+)
+
+type (
+	DomainActivities = domains.Activities
+	Permission       = rbac.Permission
+	Policy           = rbac.Policy
+)
+
+const (
+	ServiceKeyTTLDefault   = keys.TTLDefault
+	ServiceKeyGraceDefault = keys.GraceDefault
+)
+
+const (
+	PermissionOrgRead       = rbac.PermissionOrgRead
+	PermissionOrgWrite      = rbac.PermissionOrgWrite
+	PermissionReposRead     = rbac.PermissionReposRead
+	PermissionReposWrite    = rbac.PermissionReposWrite
+	PermissionHooksWrite    = rbac.PermissionHooksWrite
+	PermissionWebhooksRead  = rbac.PermissionWebhooksRead
+	PermissionWebhooksWrite = rbac.PermissionWebhooksWrite
+	PermissionEventsRead    = rbac.PermissionEventsRead
+	PermissionTeamsRead     = rbac.PermissionTeamsRead
+	PermissionTeamsWrite    = rbac.PermissionTeamsWrite
+	PermissionAuditRead     = rbac.PermissionAuditRead
+	PermissionSessionsRead  = rbac.PermissionSessionsRead
+	PermissionSessionsWrite = rbac.PermissionSessionsWrite
+	PermissionUsersRead     = rbac.PermissionUsersRead
+	PermissionUsersWrite    = rbac.PermissionUsersWrite
+	PermissionAccountsRead  = rbac.PermissionAccountsRead
+	PermissionAccountsWrite = rbac.PermissionAccountsWrite
+)
+
+var (
+	Secret             = config.Secret
+	SetSecret          = config.SetSecret
+	SetPreviousSecrets = config.SetPreviousSecrets
+)
+
+var (
+	Declare = rbac.Declare
+)
+
+var (
+	ResolveTeam = teams.Resolve
+)
+
+var (
+	StubDomainLookup     = domains.Stub
+	VerifyDomainWorkflow = domains.VerifyWorkflow
 )
 
 var (
-	Secret    = config.Secret
-	SetSecret = config.SetSecret
+	IssueServiceKey  = keys.Issue
+	RotateServiceKey = keys.Rotate
+	RevokeServiceKey = keys.Revoke
 )
 
 var (
 	NomadAuthContext           = nomad.GetAuthContext
+	NomadServiceContext        = nomad.GetServiceContext
 	NomadInterceptor           = nomad.AuthInterceptor
+	NomadAuthzInterceptor      = nomad.AuthzInterceptor
 	NomadAccountServiceHandler = nomad.NewAccountSericeServiceHandler
 	NomadOrgServiceHandler     = nomad.NewOrgServiceServiceHandler
+	NomadTeamServiceHandler    = nomad.NewTeamServiceHandler
+	NomadSessionServiceHandler = nomad.NewSessionServiceHandler
+	NomadTokenServiceHandler   = nomad.NewTokenServiceHandler
 	NomadUserServiceHandler    = nomad.NewUserSericeServiceHandler
+	NomadSSOHandler            = nomad.NewSSOHandler
 )
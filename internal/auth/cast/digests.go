@@ -0,0 +1,94 @@
+package cast
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/db/entities"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+)
+
+const (
+	DigestFrequencyDaily  = "daily"
+	DigestFrequencyWeekly = "weekly"
+)
+
+func DigestFrequencyToProto(frequency string) authv1.DigestFrequency {
+	switch frequency {
+	case DigestFrequencyDaily:
+		return authv1.DigestFrequency_DIGEST_FREQUENCY_DAILY
+	case DigestFrequencyWeekly:
+		return authv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY
+	default:
+		return authv1.DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+	}
+}
+
+// ProtoToDigestFrequency converts the frequency to its database value. Unspecified frequencies default to weekly.
+func ProtoToDigestFrequency(proto authv1.DigestFrequency) string {
+	switch proto {
+	case authv1.DigestFrequency_DIGEST_FREQUENCY_DAILY:
+		return DigestFrequencyDaily
+	case authv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY:
+		return DigestFrequencyWeekly
+	case authv1.DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED:
+		return DigestFrequencyWeekly
+	default:
+		return DigestFrequencyWeekly
+	}
+}
+
+// UserDigestToProto converts a UserDigest entity to a DigestSubscription protobuf message. The last sent time is left
+// out until the first digest is sent.
+func UserDigestToProto(digest *entities.UserDigest) *authv1.DigestSubscription {
+	proto := &authv1.DigestSubscription{
+		UserId:       digest.UserID.String(),
+		Frequency:    DigestFrequencyToProto(digest.Frequency),
+		IncludeTeams: digest.IncludeTeams,
+		IsActive:     digest.IsActive,
+	}
+
+	if digest.LastSentAt.Unix() > 0 {
+		proto.LastSentAt = timestamppb.New(digest.LastSentAt)
+	}
+
+	return proto
+}
+
+// ProtoToSetUserDigestParams converts a SetDigestSubscriptionRequest protobuf message to SetUserDigestParams.
+func ProtoToSetUserDigestParams(proto *authv1.SetDigestSubscriptionRequest) entities.SetUserDigestParams {
+	return entities.SetUserDigestParams{
+		UserID:       uuid.MustParse(proto.GetUserId()),
+		Frequency:    ProtoToDigestFrequency(proto.GetFrequency()),
+		IncludeTeams: proto.GetIncludeTeams(),
+		IsActive:     proto.GetIsActive(),
+	}
+}
+
+// TeamDigestToProto converts a TeamDigest entity to a TeamDigestSubscription protobuf message. The last sent time is
+// left out until the first digest is sent.
+func TeamDigestToProto(digest *entities.TeamDigest) *authv1.TeamDigestSubscription {
+	proto := &authv1.TeamDigestSubscription{
+		TeamId:    digest.TeamID.String(),
+		Email:     digest.Email,
+		Frequency: DigestFrequencyToProto(digest.Frequency),
+		IsActive:  digest.IsActive,
+	}
+
+	if digest.LastSentAt.Unix() > 0 {
+		proto.LastSentAt = timestamppb.New(digest.LastSentAt)
+	}
+
+	return proto
+}
+
+// ProtoToSetTeamDigestParams converts a SetTeamDigestSubscriptionRequest protobuf message to SetTeamDigestParams for
+// the given team.
+func ProtoToSetTeamDigestParams(team_id uuid.UUID, proto *authv1.SetTeamDigestSubscriptionRequest) entities.SetTeamDigestParams {
+	return entities.SetTeamDigestParams{
+		TeamID:    team_id,
+		Email:     proto.GetEmail(),
+		Frequency: ProtoToDigestFrequency(proto.GetFrequency()),
+		IsActive:  proto.GetIsActive(),
+	}
+}
@@ -0,0 +1,92 @@
+package cast
+
+import (
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db/entities"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+)
+
+const (
+	NotificationKindLinesExceeded         = "lines_exceeded"
+	NotificationKindMergeConflict         = "merge_conflict"
+	NotificationKindMergeConflictResolved = "merge_conflict_resolved"
+)
+
+const (
+	NotificationChannelDM    = "dm"
+	NotificationChannelTeam  = "team"
+	NotificationChannelEmail = "email"
+)
+
+var (
+	notification_kinds = map[string]authv1.NotificationKind{
+		NotificationKindLinesExceeded:         authv1.NotificationKind_NOTIFICATION_KIND_LINES_EXCEEDED,
+		NotificationKindMergeConflict:         authv1.NotificationKind_NOTIFICATION_KIND_MERGE_CONFLICT,
+		NotificationKindMergeConflictResolved: authv1.NotificationKind_NOTIFICATION_KIND_MERGE_CONFLICT_RESOLVED,
+	}
+
+	notification_channels = map[string]authv1.NotificationChannel{
+		NotificationChannelDM:    authv1.NotificationChannel_NOTIFICATION_CHANNEL_DM,
+		NotificationChannelTeam:  authv1.NotificationChannel_NOTIFICATION_CHANNEL_TEAM,
+		NotificationChannelEmail: authv1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL,
+	}
+)
+
+// UserNotificationPreferenceToProto converts a UserNotificationPreference entity to a NotificationPreferences protobuf
+// message. Unknown kinds are left out.
+func UserNotificationPreferenceToProto(prefs *entities.UserNotificationPreference) *authv1.NotificationPreferences {
+	kinds := make([]authv1.NotificationKind, 0, len(prefs.Kinds))
+
+	for _, kind := range prefs.Kinds {
+		if proto, ok := notification_kinds[kind]; ok {
+			kinds = append(kinds, proto)
+		}
+	}
+
+	return &authv1.NotificationPreferences{
+		UserId:     prefs.UserID.String(),
+		Kinds:      kinds,
+		Channel:    notification_channels[prefs.Channel],
+		Timezone:   prefs.Timezone,
+		QuietStart: prefs.QuietStart,
+		QuietEnd:   prefs.QuietEnd,
+	}
+}
+
+// ProtoToSetUserNotificationPreferencesParams converts a SetNotificationPreferencesRequest protobuf message to
+// SetUserNotificationPreferencesParams. Unspecified kinds are dropped, an unspecified channel defaults to direct
+// messages and an empty time zone to UTC.
+func ProtoToSetUserNotificationPreferencesParams(
+	proto *authv1.SetNotificationPreferencesRequest,
+) entities.SetUserNotificationPreferencesParams {
+	prefs := proto.GetPreferences()
+	params := entities.SetUserNotificationPreferencesParams{
+		UserID:     uuid.MustParse(prefs.GetUserId()),
+		Kinds:      make([]string, 0, len(prefs.GetKinds())),
+		Channel:    NotificationChannelDM,
+		Timezone:   prefs.GetTimezone(),
+		QuietStart: prefs.GetQuietStart(),
+		QuietEnd:   prefs.GetQuietEnd(),
+	}
+
+	for _, kind := range prefs.GetKinds() {
+		for name, value := range notification_kinds {
+			if value == kind {
+				params.Kinds = append(params.Kinds, name)
+			}
+		}
+	}
+
+	for name, value := range notification_channels {
+		if value == prefs.GetChannel() {
+			params.Channel = name
+		}
+	}
+
+	if params.Timezone == "" {
+		params.Timezone = "UTC"
+	}
+
+	return params
+}
@@ -0,0 +1,93 @@
+package cast
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/auth/domains"
+	"go.breu.io/quantm/internal/auth/sso"
+	"go.breu.io/quantm/internal/db/entities"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+)
+
+// OrgToProto converts an Org entity to an Org protobuf message. Hooks that cannot be decoded are left unset.
+func OrgToProto(org *entities.Org) *authv1.Org {
+	proto := &authv1.Org{
+		Id:        org.ID.String(),
+		CreatedAt: timestamppb.New(org.CreatedAt),
+		UpdatedAt: timestamppb.New(org.UpdatedAt),
+		Name:      org.Name,
+		Domain:    org.Domain,
+		Slug:      org.Slug,
+	}
+
+	hooks := &authv1.OrgHooks{}
+	if err := json.Unmarshal(org.Hooks, hooks); err == nil {
+		proto.Hooks = hooks
+	}
+
+	return proto
+}
+
+// MembershipsToProto converts the orgs of a user, and the roles of the user within them, to Membership protobuf
+// messages.
+func MembershipsToProto(orgs []entities.Org, roles []entities.UserRole) []*authv1.Membership {
+	names := make(map[uuid.UUID][]string)
+	for _, role := range roles {
+		names[role.OrgID] = append(names[role.OrgID], role.Name)
+	}
+
+	protos := make([]*authv1.Membership, len(orgs))
+	for i := range orgs {
+		protos[i] = &authv1.Membership{Org: OrgToProto(&orgs[i]), Roles: names[orgs[i].ID]}
+	}
+
+	return protos
+}
+
+// InvitationToProto converts an OrgInvitation entity to an Invitation protobuf message. The hash is never sent.
+func InvitationToProto(invitation *entities.OrgInvitation) *authv1.Invitation {
+	return &authv1.Invitation{
+		Id:        invitation.ID.String(),
+		CreatedAt: timestamppb.New(invitation.CreatedAt),
+		OrgId:     invitation.OrgID.String(),
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		InvitedBy: invitation.InvitedBy.String(),
+		ExpiresAt: timestamppb.New(invitation.ExpiresAt),
+	}
+}
+
+// InvitationsToProto converts a slice of OrgInvitation entities to their protobuf representation.
+func InvitationsToProto(invitations []entities.OrgInvitation) []*authv1.Invitation {
+	protos := make([]*authv1.Invitation, len(invitations))
+	for i := range invitations {
+		protos[i] = InvitationToProto(&invitations[i])
+	}
+
+	return protos
+}
+
+// DomainToProto converts an OrgDomain entity to a Domain protobuf message, with the TXT record verifying the domain.
+func DomainToProto(domain *entities.OrgDomain) *authv1.Domain {
+	return &authv1.Domain{
+		Domain:      domain.Domain,
+		RecordName:  domains.Record(domain.Domain),
+		RecordValue: domains.Value(domain.Token),
+		IsVerified:  domain.IsVerified,
+		AutoJoin:    domain.AutoJoin,
+	}
+}
+
+// SSOToProto converts an OrgSsoConfig entity to an SSO protobuf message. The client secret is never sent.
+func SSOToProto(cfg *entities.OrgSsoConfig, slug string) *authv1.SSO {
+	return &authv1.SSO{
+		Issuer:         cfg.Issuer,
+		ClientId:       cfg.ClientID,
+		AllowedDomains: cfg.AllowedDomains,
+		IsRequired:     cfg.IsRequired,
+		LoginPath:      sso.LoginPath(slug),
+	}
+}
@@ -0,0 +1,36 @@
+package cast_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+func TestMembershipsToProto(t *testing.T) {
+	t.Parallel()
+
+	user_id := uuid.New()
+	acme := entities.Org{ID: uuid.New(), Name: "acme", Slug: "acme", Hooks: []byte(`{"repo": 1}`)}
+	other := entities.Org{ID: uuid.New(), Name: "other", Slug: "other"}
+
+	roles := []entities.UserRole{
+		{Name: "admin", UserID: user_id, OrgID: acme.ID},
+		{Name: "member", UserID: user_id, OrgID: other.ID},
+		{Name: "member", UserID: user_id, OrgID: uuid.New()},
+	}
+
+	memberships := cast.MembershipsToProto([]entities.Org{acme, other}, roles)
+
+	if assert.Len(t, memberships, 2) {
+		assert.Equal(t, acme.ID.String(), memberships[0].GetOrg().GetId())
+		assert.Equal(t, []string{"admin"}, memberships[0].GetRoles())
+		assert.NotNil(t, memberships[0].GetOrg().GetHooks())
+		assert.Equal(t, other.ID.String(), memberships[1].GetOrg().GetId())
+		assert.Equal(t, []string{"member"}, memberships[1].GetRoles())
+		assert.Nil(t, memberships[1].GetOrg().GetHooks())
+	}
+}
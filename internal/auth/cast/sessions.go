@@ -0,0 +1,32 @@
+package cast
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/db/entities"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+)
+
+// SessionToProto converts a session to its protobuf message. The jti of the session is left out, it identifies the
+// token of the session. Current is set when the session is the session of the request.
+func SessionToProto(session *entities.Session, current string) *authv1.Session {
+	return &authv1.Session{
+		Id:         session.ID.String(),
+		CreatedAt:  timestamppb.New(session.CreatedAt),
+		LastSeenAt: timestamppb.New(session.LastSeenAt),
+		ExpiresAt:  timestamppb.New(session.ExpiresAt),
+		Ip:         session.Ip,
+		UserAgent:  session.UserAgent,
+		Sso:        session.IsSso,
+		Current:    current != "" && session.Jti == current,
+	}
+}
+
+func SessionsToProto(sessions []entities.Session, current string) []*authv1.Session {
+	protos := make([]*authv1.Session, len(sessions))
+	for i := range sessions {
+		protos[i] = SessionToProto(&sessions[i], current)
+	}
+
+	return protos
+}
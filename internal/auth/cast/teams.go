@@ -45,3 +45,81 @@ func GetTeamBySlugRowToProto(team entities.GetTeamBySlugRow) *authv1.Team {
 		Name: team.Name,
 	}
 }
+
+// TeamsToProto converts a slice of Team entities to their protobuf representation.
+func TeamsToProto(teams []entities.Team) []*authv1.Team {
+	protos := make([]*authv1.Team, len(teams))
+	for i := range teams {
+		protos[i] = TeamToProto(&teams[i])
+	}
+
+	return protos
+}
+
+// TeamRoleToProto converts a team_role to its protobuf representation.
+func TeamRoleToProto(role entities.TeamRole) authv1.TeamRole {
+	switch role {
+	case entities.TeamRoleMember:
+		return authv1.TeamRole_TEAM_ROLE_MEMBER
+	case entities.TeamRoleAdmin:
+		return authv1.TeamRole_TEAM_ROLE_ADMIN
+	default:
+		return authv1.TeamRole_TEAM_ROLE_UNSPECIFIED
+	}
+}
+
+// ProtoToTeamRole converts the role to its database value. Unspecified roles are converted to an empty string.
+func ProtoToTeamRole(proto authv1.TeamRole) entities.TeamRole {
+	switch proto {
+	case authv1.TeamRole_TEAM_ROLE_MEMBER:
+		return entities.TeamRoleMember
+	case authv1.TeamRole_TEAM_ROLE_ADMIN:
+		return entities.TeamRoleAdmin
+	case authv1.TeamRole_TEAM_ROLE_UNSPECIFIED:
+		return ""
+	default:
+		return ""
+	}
+}
+
+// TeamMemberToProto converts a member of a team, and its user, to a TeamMember protobuf message.
+func TeamMemberToProto(member *entities.TeamUser, user *entities.User) *authv1.TeamMember {
+	return &authv1.TeamMember{
+		UserId:    member.UserID.String(),
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Picture:   user.Picture,
+		Role:      TeamRoleToProto(member.Role),
+		IsActive:  member.IsActive,
+	}
+}
+
+// TeamMembersToProto converts the rows of ListTeamMembers to their protobuf representation.
+func TeamMembersToProto(rows []entities.ListTeamMembersRow) []*authv1.TeamMember {
+	protos := make([]*authv1.TeamMember, len(rows))
+	for i := range rows {
+		protos[i] = TeamMemberToProto(&rows[i].TeamUser, &rows[i].User)
+	}
+
+	return protos
+}
+
+// TeamRepoToProto converts a TeamRepo entity to its protobuf representation.
+func TeamRepoToProto(owned *entities.TeamRepo) *authv1.TeamRepo {
+	return &authv1.TeamRepo{
+		TeamId: owned.TeamID.String(),
+		RepoId: owned.RepoID.String(),
+		Path:   owned.Path,
+	}
+}
+
+// TeamReposToProto converts a slice of TeamRepo entities to their protobuf representation.
+func TeamReposToProto(owned []entities.TeamRepo) []*authv1.TeamRepo {
+	protos := make([]*authv1.TeamRepo, len(owned))
+	for i := range owned {
+		protos[i] = TeamRepoToProto(&owned[i])
+	}
+
+	return protos
+}
@@ -0,0 +1,60 @@
+package cast
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/auth/keys"
+	"go.breu.io/quantm/internal/db/entities"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+)
+
+func TokenKindToProto(kind string) authv1.TokenKind {
+	switch kind {
+	case keys.TokenKindPersonal:
+		return authv1.TokenKind_TOKEN_KIND_PERSONAL
+	case keys.TokenKindOrg:
+		return authv1.TokenKind_TOKEN_KIND_ORG
+	default:
+		return authv1.TokenKind_TOKEN_KIND_UNSPECIFIED
+	}
+}
+
+// ProtoToTokenKind converts the kind to its database value. Unspecified kinds are converted to an empty string.
+func ProtoToTokenKind(proto authv1.TokenKind) string {
+	switch proto {
+	case authv1.TokenKind_TOKEN_KIND_PERSONAL:
+		return keys.TokenKindPersonal
+	case authv1.TokenKind_TOKEN_KIND_ORG:
+		return keys.TokenKindOrg
+	case authv1.TokenKind_TOKEN_KIND_UNSPECIFIED:
+		return ""
+	default:
+		return ""
+	}
+}
+
+// TokenToProto converts an ApiToken entity to a Token protobuf message. The hash is never sent.
+func TokenToProto(token *entities.ApiToken) *authv1.Token {
+	return &authv1.Token{
+		Id:         token.ID.String(),
+		CreatedAt:  timestamppb.New(token.CreatedAt),
+		Kind:       TokenKindToProto(token.Kind),
+		Name:       token.Name,
+		Prefix:     keys.TokenPrefix(token),
+		Scopes:     token.Scopes,
+		OrgId:      token.OrgID.String(),
+		UserId:     token.UserID.String(),
+		ExpiresAt:  timestamppb.New(token.ExpiresAt),
+		LastUsedAt: timestamppb.New(token.LastUsedAt),
+		IsRevoked:  token.IsRevoked,
+	}
+}
+
+func TokensToProto(tokens []entities.ApiToken) []*authv1.Token {
+	protos := make([]*authv1.Token, len(tokens))
+	for i := range tokens {
+		protos[i] = TokenToProto(&tokens[i])
+	}
+
+	return protos
+}
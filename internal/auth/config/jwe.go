@@ -3,8 +3,10 @@ package config
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -27,8 +29,9 @@ type (
 	// Claims represents the payload of the JWT token.
 	Claims struct {
 		jwt.Claims        // Standard JWT claims.
-		UserID     string `json:"user_id"` // User ID.
-		OrgID      string `json:"org_id"`  // Organization ID.
+		UserID     string `json:"user_id"`       // User ID.
+		OrgID      string `json:"org_id"`        // Organization ID.
+		SSO        bool   `json:"sso,omitempty"` // Set when the session was created by the single sign-on of the org.
 	}
 
 	// JWTEncodeParams contains the parameters for JWT encoding.
@@ -50,10 +53,10 @@ func info() string {
 // It generates a JWE key using the `Derive` function, creates an encrypter, marshals the payload to JSON, encrypts
 // the payload, serializes the JWE token, and returns the serialized token.
 func EncodeJWE(secret string, params JWTEncodeParams) (string, error) {
-	// Generate a JWE key.
+	// Generate a JWE key. The key id is the thumbprint of the key, as in Auth.js, it must never be the key itself.
 	key := jose.JSONWebKey{
 		Key:       Derive(secret),
-		KeyID:     base64.RawURLEncoding.EncodeToString(Derive(secret)),
+		KeyID:     Thumbprint(Derive(secret)),
 		Algorithm: alg,
 		Use:       "enc",
 	}
@@ -90,7 +93,42 @@ func EncodeJWE(secret string, params JWTEncodeParams) (string, error) {
 // It decrypts the token using the `Derive` function, unmarshals the payload, and validates the expiration time. If the
 // token is valid, it returns the decoded claims.
 func DecodeJWE(secret, token string) (*Claims, error) {
-	enc, err := jose.Decrypt([]byte(token), jose.WithAlg(string(jose.A256CBC_HS512)), jose.WithPassword(Derive(secret)))
+	return DecodeJWEWithSecrets([]string{secret}, token)
+}
+
+// DecodeJWEWithSecrets decodes and validates a JWE token encrypted with any of the secrets, so that the tokens issued
+// before a rotation of the secret stay valid until they expire. The secret of the key id of the token is tried first.
+func DecodeJWEWithSecrets(secrets []string, token string) (*Claims, error) {
+	if len(secrets) == 0 {
+		return nil, errors.New("no secret")
+	}
+
+	kid := ""
+	if header, err := jose.ParseEncrypted(token); err == nil {
+		kid = header.Header.KeyID
+	}
+
+	ordered := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		if kid != "" && Thumbprint(Derive(secret)) == kid {
+			ordered = append([]string{secret}, ordered...)
+		} else {
+			ordered = append(ordered, secret)
+		}
+	}
+
+	var (
+		enc []byte
+		err error
+	)
+
+	for _, secret := range ordered {
+		enc, err = jose.Decrypt([]byte(token), jose.WithAlg(string(jose.A256CBC_HS512)), jose.WithPassword(Derive(secret)))
+		if err == nil {
+			break
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -101,13 +139,21 @@ func DecodeJWE(secret, token string) (*Claims, error) {
 	}
 
 	// Validate expiration.
-	if time.Now().Unix() > int64(*claims.Expiry) {
+	if claims.Expiry == nil || time.Now().Unix() > int64(*claims.Expiry) {
 		return nil, erratic.NewAuthnError(erratic.AuthModule).WithReason("token expired")
 	}
 
 	return claims, nil
 }
 
+// Thumbprint returns the JWK thumbprint (RFC 7638) of a symmetric key with SHA-512, the key id of the JWEs of Auth.js.
+func Thumbprint(key []byte) string {
+	input := fmt.Sprintf(`{"k":"%s","kty":"oct"}`, base64.RawURLEncoding.EncodeToString(key))
+	sum := sha512.Sum512([]byte(input))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
 // Derive generates a derived key using HKDF.
 //
 // It uses the HMAC-SHA256 hash function, the secret key from the shared package, the salt, and the info string to
@@ -0,0 +1,54 @@
+package config_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/auth/config"
+)
+
+type (
+	JWETestSuite struct {
+		suite.Suite
+		token string
+	}
+)
+
+func (s *JWETestSuite) SetupTest() {
+	claims := config.Claims{
+		Claims: jwt.Claims{Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		UserID: "user",
+		OrgID:  "org",
+	}
+
+	token, err := config.EncodeJWE("old", config.JWTEncodeParams{Claims: claims})
+	s.Require().NoError(err)
+
+	s.token = token
+}
+
+func (s *JWETestSuite) TestKeyID() {
+	header, err := base64.RawURLEncoding.DecodeString(strings.Split(s.token, ".")[0])
+	s.Require().NoError(err)
+
+	s.Contains(string(header), config.Thumbprint(config.Derive("old")))
+	s.NotContains(string(header), base64.RawURLEncoding.EncodeToString(config.Derive("old")))
+}
+
+func (s *JWETestSuite) TestRotation() {
+	claims, err := config.DecodeJWEWithSecrets([]string{"new", "old"}, s.token)
+	s.Require().NoError(err)
+	s.Equal("user", claims.UserID)
+
+	_, err = config.DecodeJWEWithSecrets([]string{"new"}, s.token)
+	s.Error(err)
+}
+
+func TestJWE(t *testing.T) {
+	suite.Run(t, new(JWETestSuite))
+}
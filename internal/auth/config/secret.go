@@ -10,10 +10,14 @@ const (
 )
 
 var (
-	secret atomic.Value
+	secret   atomic.Value
+	previous atomic.Value
 )
 
-func init() { secret.Store(_default) }
+func init() {
+	secret.Store(_default)
+	previous.Store([]string{})
+}
 
 // Secret returns the configured secret value. It will log a warning if the secret is not set.
 func Secret() string {
@@ -33,3 +37,13 @@ func SetSecret(val string) {
 func IsValid() bool {
 	return secret.Load().(string) != _default
 }
+
+// SetPreviousSecrets sets the previous secrets, accepted to decrypt the JWEs issued before the rotation of the secret.
+func SetPreviousSecrets(vals ...string) {
+	previous.Store(vals)
+}
+
+// Secrets returns the current secret, followed by the previous secrets.
+func Secrets() []string {
+	return append([]string{Secret()}, previous.Load().([]string)...)
+}
@@ -0,0 +1,51 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db"
+)
+
+type (
+	// Activities verify the domains of the orgs.
+	Activities struct{}
+)
+
+// Verify looks up the TXT record of the domain of the org, and marks the domain as verified if the record matches. A
+// missing record is not an error, the domain is not verified.
+func (a *Activities) Verify(ctx context.Context, org_id uuid.UUID) (bool, error) {
+	domain, err := db.Queries().GetOrgDomain(ctx, org_id)
+	if err != nil {
+		return false, err
+	}
+
+	if domain.IsVerified {
+		return true, nil
+	}
+
+	if !stubbed {
+		records, err := lookup(ctx, Record(domain.Domain))
+		if err != nil {
+			var dns *net.DNSError
+			if errors.As(err, &dns) && dns.IsNotFound {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		if !Matches(records, domain.Token) {
+			return false, nil
+		}
+	}
+
+	if err := db.Queries().SetOrgDomainVerified(ctx, org_id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
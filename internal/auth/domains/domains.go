@@ -0,0 +1,103 @@
+// Package domains verifies the ownership of the domain of an org.
+//
+// The admins of the org publish a TXT record named _quantm-verification.<domain>, with the value
+// quantm-verification=<token>. The record is looked up by an activity, on the core queue, so that a slow resolver does
+// not hold the request. For local development, the lookup is stubbed and every domain is verified, see Stub.
+package domains
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+const (
+	RecordPrefix = "_quantm-verification."
+	ValuePrefix  = "quantm-verification="
+)
+
+var (
+	lookup  = net.DefaultResolver.LookupTXT
+	stubbed = false
+)
+
+// Stub replaces the DNS lookup, every domain is verified. It must be called before the workers start.
+func Stub() {
+	slog.Warn("auth/domains: dns lookup is stubbed, every domain is verified")
+
+	stubbed = true
+}
+
+// Record returns the name of the TXT record verifying the domain.
+func Record(domain string) string {
+	return RecordPrefix + domain
+}
+
+// Value returns the value of the TXT record verifying the domain.
+func Value(token string) string {
+	return ValuePrefix + token
+}
+
+// Matches reports whether one of the TXT records is the value of the token.
+func Matches(records []string, token string) bool {
+	for _, record := range records {
+		if strings.TrimSpace(record) == Value(token) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ensure returns the domain of the org, creating its verification token on first use.
+func Ensure(ctx context.Context, org *entities.Org) (*entities.OrgDomain, error) {
+	token, err := generate()
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.AuthModule).Wrap(err)
+	}
+
+	params := entities.EnsureOrgDomainParams{OrgID: org.ID, Domain: org.Domain, Token: token}
+
+	domain, err := db.Queries().EnsureOrgDomain(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to get domain").Wrap(err)
+	}
+
+	return &domain, nil
+}
+
+// AutoJoin reports whether users signing up with an email of the domain of the org join the org. Orgs without a
+// domain setting predate the setting, and keep joining by domain.
+func AutoJoin(ctx context.Context, q *entities.Queries, org_id uuid.UUID) (bool, error) {
+	domain, err := q.GetOrgDomain(ctx, org_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return true, nil
+		}
+
+		return false, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return domain.AutoJoin, nil
+}
+
+// generate returns a random token of 256 bits, hex encoded.
+func generate() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
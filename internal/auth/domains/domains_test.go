@@ -0,0 +1,31 @@
+package domains_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/auth/domains"
+)
+
+type (
+	DomainsTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *DomainsTestSuite) TestRecord() {
+	s.Equal("_quantm-verification.example.com", domains.Record("example.com"))
+}
+
+func (s *DomainsTestSuite) TestMatches() {
+	token := "0123456789abcdef"
+
+	s.True(domains.Matches([]string{"v=spf1 -all", " quantm-verification=" + token + " "}, token))
+	s.False(domains.Matches([]string{"quantm-verification=other"}, token))
+	s.False(domains.Matches(nil, token))
+}
+
+func TestDomains(t *testing.T) {
+	suite.Run(t, new(DomainsTestSuite))
+}
@@ -0,0 +1,40 @@
+package domains
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.breu.io/durex/dispatch"
+	"go.breu.io/durex/workflows"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/durable"
+)
+
+// VerifyWorkflowOptions returns the options of the workflow verifying the domain of the org.
+func VerifyWorkflowOptions(org_id uuid.UUID) workflows.Options {
+	return durable.NewWorkflowOptions(
+		durable.WithOrg(org_id.String()),
+		durable.WithSubject("domain"),
+		durable.WithScope("verify"),
+	)
+}
+
+// VerifyWorkflow verifies the domain of the org. The lookup is retried a few times, resolvers fail transiently.
+func VerifyWorkflow(ctx workflow.Context, org_id uuid.UUID) (bool, error) {
+	acts := &Activities{}
+	opts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+	}
+
+	ctx = dispatch.WithCustomActivityContext(ctx, opts)
+	verified := false
+
+	if err := workflow.ExecuteActivity(ctx, acts.Verify, org_id).Get(ctx, &verified); err != nil {
+		return false, err
+	}
+
+	return verified, nil
+}
@@ -0,0 +1,147 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+// Invitations are single-use tokens of the form qinv_<id>_<secret>, given by the admins of an org to the invited user,
+// usually as a link. An invitation is bound to an email, and is accepted once.
+
+const (
+	PrefixInvitation = "qinv_"
+
+	// InvitationTTLDefault is the lifetime of an invitation created without ttl.
+	InvitationTTLDefault = 7 * 24 * time.Hour
+
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+var (
+	ErrAccepted = errors.New("invitation already accepted")
+	ErrMismatch = errors.New("email does not match the invitation")
+)
+
+type (
+	// InvitationParams are the parameters of a new invitation.
+	InvitationParams struct {
+		OrgID     uuid.UUID
+		Email     string
+		Role      string
+		InvitedBy uuid.UUID
+		TTL       time.Duration
+	}
+)
+
+// IssueInvitation creates an invitation, and returns its token. The token cannot be recovered afterwards.
+func IssueInvitation(ctx context.Context, params InvitationParams) (string, *entities.OrgInvitation, error) {
+	if params.Role != RoleAdmin && params.Role != RoleMember {
+		return "", nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid role").WithHint("role", params.Role)
+	}
+
+	if params.TTL <= 0 {
+		params.TTL = InvitationTTLDefault
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", nil, erratic.NewSystemError(erratic.AuthModule).Wrap(err)
+	}
+
+	secret, err := generate()
+	if err != nil {
+		return "", nil, erratic.NewSystemError(erratic.AuthModule).Wrap(err)
+	}
+
+	create := entities.CreateOrgInvitationParams{
+		ID:        id,
+		OrgID:     params.OrgID,
+		Email:     strings.ToLower(params.Email),
+		Role:      params.Role,
+		Hash:      digest(secret),
+		InvitedBy: params.InvitedBy,
+		ExpiresAt: time.Now().Add(params.TTL),
+	}
+
+	invitation, err := db.Queries().CreateOrgInvitation(ctx, create)
+	if err != nil {
+		return "", nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to create invitation").Wrap(err)
+	}
+
+	return encode(PrefixInvitation, id, secret), &invitation, nil
+}
+
+// VerifyInvitation returns the invitation of the token, if the token is valid for the email. The invitation is not
+// accepted, see AcceptInvitation.
+func VerifyInvitation(ctx context.Context, token, email string) (*entities.OrgInvitation, error) {
+	id, secret, err := decode(PrefixInvitation, token)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := db.Queries().GetOrgInvitation(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalid
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := CheckInvitation(&invitation, secret, email, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &invitation, nil
+}
+
+// CheckInvitation validates the secret and the email against the invitation at the given time.
+func CheckInvitation(invitation *entities.OrgInvitation, secret, email string, now time.Time) error {
+	if err := check(invitation.Hash, invitation.IsRevoked, invitation.ExpiresAt, secret, now); err != nil {
+		return err
+	}
+
+	if invitation.IsAccepted {
+		return ErrAccepted
+	}
+
+	if !strings.EqualFold(invitation.Email, email) {
+		return ErrMismatch
+	}
+
+	return nil
+}
+
+// AcceptInvitation marks the invitation as accepted, within the transaction of the caller. It fails if the
+// invitation was accepted, revoked or expired since it was verified.
+func AcceptInvitation(ctx context.Context, q *entities.Queries, id uuid.UUID) error {
+	accepted, err := q.AcceptOrgInvitation(ctx, id)
+	if err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to accept invitation").Wrap(err)
+	}
+
+	if accepted == 0 {
+		return ErrAccepted
+	}
+
+	return nil
+}
+
+// RevokeInvitation invalidates the invitation immediately.
+func RevokeInvitation(ctx context.Context, id uuid.UUID) error {
+	if err := db.Queries().RevokeOrgInvitation(ctx, id); err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to revoke invitation").Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+// Package keys manages the long-lived credentials of the nomad API.
+//
+// Service keys are the pre-shared credentials of the services calling the nomad API on behalf of no user, e.g. the
+// backend of the web app. Personal access tokens and org API keys are created by the users, for the CLI and
+// automation, see tokens.go. Invitations to join an org are single-use tokens, see invitations.go.
+//
+// A service key is given to the service once, as a token of the form qsk_<id>_<secret>. Only the SHA-256 of the
+// secret is stored. The secret has 256 bits of entropy, so a fast hash is enough. A key is rotated by issuing a new
+// key with the same name and scopes, the previous key remains valid for a grace period, so that the service can be
+// redeployed with the new key.
+package keys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+const (
+	// Prefix marks a bearer token as a service key.
+	Prefix = "qsk_"
+
+	// TTLDefault is the lifetime of an issued key.
+	TTLDefault = 365 * 24 * time.Hour
+
+	// GraceDefault is the time a rotated key remains valid.
+	GraceDefault = 24 * time.Hour
+)
+
+var (
+	ErrMalformed = errors.New("malformed key")
+	ErrInvalid   = errors.New("invalid key")
+	ErrExpired   = errors.New("key expired")
+	ErrRevoked   = errors.New("key revoked")
+)
+
+// IsServiceKey reports whether the bearer token is a service key.
+func IsServiceKey(token string) bool {
+	return strings.HasPrefix(token, Prefix)
+}
+
+// Issue creates a service key with the given scopes, and returns its token. The token cannot be recovered afterwards.
+func Issue(ctx context.Context, name string, scopes []string, ttl time.Duration) (string, *entities.ServiceKey, error) {
+	for _, scope := range scopes {
+		if !rbac.IsScope(rbac.Permission(scope)) {
+			return "", nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid scope").WithHint("scope", scope)
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", nil, erratic.NewSystemError(erratic.AuthModule).Wrap(err)
+	}
+
+	encoded, err := generate()
+	if err != nil {
+		return "", nil, erratic.NewSystemError(erratic.AuthModule).Wrap(err)
+	}
+
+	params := entities.CreateServiceKeyParams{
+		ID:        id,
+		Name:      name,
+		Hash:      digest(encoded),
+		Scopes:    scopes,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+
+	key, err := db.Queries().CreateServiceKey(ctx, params)
+	if err != nil {
+		return "", nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to create service key").Wrap(err)
+	}
+
+	return Format(id, encoded), &key, nil
+}
+
+// Rotate issues a new key with the name and the scopes of the key, and expires the key after the grace period.
+func Rotate(ctx context.Context, id uuid.UUID, ttl, grace time.Duration) (string, *entities.ServiceKey, error) {
+	previous, err := db.Queries().GetServiceKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil, erratic.NewNotFoundError(erratic.AuthModule).WithResource("service_key")
+		}
+
+		return "", nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	token, key, err := Issue(ctx, previous.Name, previous.Scopes, ttl)
+	if err != nil {
+		return "", nil, err
+	}
+
+	params := entities.ExpireServiceKeyParams{ID: id, ExpiresAt: time.Now().Add(grace)}
+	if err := db.Queries().ExpireServiceKey(ctx, params); err != nil {
+		return "", nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to expire service key").Wrap(err)
+	}
+
+	return token, key, nil
+}
+
+// Revoke invalidates the key immediately.
+func Revoke(ctx context.Context, id uuid.UUID) error {
+	if err := db.Queries().RevokeServiceKey(ctx, id); err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to revoke service key").Wrap(err)
+	}
+
+	return nil
+}
+
+// Verify returns the key of the token, if the token is valid.
+func Verify(ctx context.Context, token string) (*entities.ServiceKey, error) {
+	id, secret, err := Parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := db.Queries().GetServiceKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalid
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := Check(&key, secret, time.Now()); err != nil {
+		return nil, err
+	}
+
+	_ = db.Queries().TouchServiceKey(ctx, key.ID)
+
+	return &key, nil
+}
+
+// Check validates the secret against the key at the given time.
+func Check(key *entities.ServiceKey, secret string, now time.Time) error {
+	return check(key.Hash, key.IsRevoked, key.ExpiresAt, secret, now)
+}
+
+// Format returns the token of a key.
+func Format(id uuid.UUID, secret string) string {
+	return encode(Prefix, id, secret)
+}
+
+// Parse splits the token into the id of the key and its secret.
+func Parse(token string) (uuid.UUID, string, error) {
+	return decode(Prefix, token)
+}
+
+// generate returns a random secret of 256 bits.
+func generate() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// encode returns a token of the form <prefix><id>_<secret>.
+func encode(prefix string, id uuid.UUID, secret string) string {
+	return prefix + hex.EncodeToString(id[:]) + "_" + secret
+}
+
+// decode splits a token of the form <prefix><id>_<secret>.
+func decode(prefix, token string) (uuid.UUID, string, error) {
+	rest, ok := strings.CutPrefix(token, prefix)
+	if !ok {
+		return uuid.Nil, "", ErrMalformed
+	}
+
+	encoded, secret, ok := strings.Cut(rest, "_")
+	if !ok || secret == "" {
+		return uuid.Nil, "", ErrMalformed
+	}
+
+	raw, err := hex.DecodeString(encoded)
+	if err != nil {
+		return uuid.Nil, "", ErrMalformed
+	}
+
+	id, err := uuid.FromBytes(raw)
+	if err != nil {
+		return uuid.Nil, "", ErrMalformed
+	}
+
+	return id, secret, nil
+}
+
+// check validates the secret against the stored hash, then the state of the key.
+func check(hash string, revoked bool, expires time.Time, secret string, now time.Time) error {
+	if subtle.ConstantTimeCompare([]byte(digest(secret)), []byte(hash)) != 1 {
+		return ErrInvalid
+	}
+
+	if revoked {
+		return ErrRevoked
+	}
+
+	if now.After(expires) {
+		return ErrExpired
+	}
+
+	return nil
+}
+
+// digest returns the hex encoded SHA-256 of the secret.
+func digest(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
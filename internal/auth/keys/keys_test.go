@@ -0,0 +1,110 @@
+package keys_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/auth/keys"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	KeysTestSuite struct {
+		suite.Suite
+		key    *entities.ServiceKey
+		secret string
+	}
+)
+
+func (s *KeysTestSuite) SetupTest() {
+	s.secret = "c2VjcmV0X3dpdGhfdW5kZXJzY29yZQ_-"
+	sum := sha256.Sum256([]byte(s.secret))
+
+	s.key = &entities.ServiceKey{
+		ID:        uuid.New(),
+		Hash:      hex.EncodeToString(sum[:]),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func (s *KeysTestSuite) TestFormatParse() {
+	token := keys.Format(s.key.ID, s.secret)
+
+	s.True(keys.IsServiceKey(token))
+
+	id, secret, err := keys.Parse(token)
+
+	s.Require().NoError(err)
+	s.Equal(s.key.ID, id)
+	s.Equal(s.secret, secret)
+}
+
+func (s *KeysTestSuite) TestParseMalformed() {
+	for _, token := range []string{"", "qsk_", "qsk_nothex_secret", "qsk_" + hex.EncodeToString(s.key.ID[:]), "eyJhbGciOi"} {
+		_, _, err := keys.Parse(token)
+		s.ErrorIs(err, keys.ErrMalformed, token)
+	}
+}
+
+func (s *KeysTestSuite) TestCheck() {
+	s.NoError(keys.Check(s.key, s.secret, time.Now()))
+	s.ErrorIs(keys.Check(s.key, "wrong", time.Now()), keys.ErrInvalid)
+	s.ErrorIs(keys.Check(s.key, s.secret, time.Now().Add(2*time.Hour)), keys.ErrExpired)
+
+	s.key.IsRevoked = true
+
+	s.ErrorIs(keys.Check(s.key, s.secret, time.Now()), keys.ErrRevoked)
+}
+
+func (s *KeysTestSuite) TestTokenPrefix() {
+	token := &entities.ApiToken{ID: uuid.MustParse("0191a9b4-6d1d-7b7e-8a32-8e2b0a6a2f10"), Kind: keys.TokenKindPersonal}
+
+	s.Equal("qpat_0191a9b46d1d", keys.TokenPrefix(token))
+	s.True(strings.HasPrefix("qpat_0191a9b46d1d7b7e8a328e2b0a6a2f10_secret", keys.TokenPrefix(token)))
+	s.True(keys.IsToken("qpat_0191a9b46d1d7b7e8a328e2b0a6a2f10_secret"))
+	s.True(keys.IsToken("qoak_0191a9b46d1d7b7e8a328e2b0a6a2f10_secret"))
+	s.False(keys.IsToken(keys.Format(token.ID, s.secret)))
+
+	token.Kind = keys.TokenKindOrg
+	token.Hash = s.key.Hash
+	token.ExpiresAt = s.key.ExpiresAt
+
+	s.Equal("qoak_0191a9b46d1d", keys.TokenPrefix(token))
+	s.NoError(keys.CheckToken(token, s.secret, time.Now()))
+}
+
+func (s *KeysTestSuite) TestTokenPrefixUnique() {
+	// the prefix is unique by the api_tokens_prefix_idx index, on the first 13 characters of the id.
+	for range 100 {
+		token := &entities.ApiToken{ID: uuid.New(), Kind: keys.TokenKindPersonal}
+
+		s.Equal(keys.PrefixPersonal+strings.ReplaceAll(token.ID.String()[:13], "-", ""), keys.TokenPrefix(token))
+	}
+}
+
+func (s *KeysTestSuite) TestCheckInvitation() {
+	invitation := &entities.OrgInvitation{
+		ID:        uuid.New(),
+		Email:     "jane@example.com",
+		Hash:      s.key.Hash,
+		ExpiresAt: s.key.ExpiresAt,
+	}
+
+	s.NoError(keys.CheckInvitation(invitation, s.secret, "Jane@Example.com", time.Now()))
+	s.ErrorIs(keys.CheckInvitation(invitation, s.secret, "john@example.com", time.Now()), keys.ErrMismatch)
+	s.ErrorIs(keys.CheckInvitation(invitation, "wrong", "jane@example.com", time.Now()), keys.ErrInvalid)
+
+	invitation.IsAccepted = true
+
+	s.ErrorIs(keys.CheckInvitation(invitation, s.secret, "jane@example.com", time.Now()), keys.ErrAccepted)
+}
+
+func TestKeys(t *testing.T) {
+	suite.Run(t, new(KeysTestSuite))
+}
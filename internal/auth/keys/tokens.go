@@ -0,0 +1,182 @@
+package keys
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+// Personal access tokens act as the user who created them, org API keys act for the org. Both are restricted to their
+// scopes, and are given to the user once, as a token of the form <prefix><id>_<secret>. The prefix tells the kind of
+// the token apart, and identifies it in the list of tokens.
+
+const (
+	PrefixPersonal = "qpat_"
+	PrefixOrg      = "qoak_"
+
+	TokenKindPersonal = "personal"
+	TokenKindOrg      = "org"
+
+	// TokenTTLDefault is the lifetime of a token created without ttl.
+	TokenTTLDefault = 90 * 24 * time.Hour
+
+	// token_attempts is the number of ids tried when the prefix of a new token is already taken.
+	token_attempts = 3
+
+	// unique_violation is the postgres error code of a unique constraint violation.
+	unique_violation = "23505"
+)
+
+type (
+	// TokenParams are the parameters of a new token.
+	TokenParams struct {
+		Kind   string
+		Name   string
+		Scopes []string
+		OrgID  uuid.UUID
+		UserID uuid.UUID
+		TTL    time.Duration
+	}
+)
+
+// IsToken reports whether the bearer token is a personal access token or an org API key.
+func IsToken(token string) bool {
+	return strings.HasPrefix(token, PrefixPersonal) || strings.HasPrefix(token, PrefixOrg)
+}
+
+// IssueToken creates a token, and returns its value. The value cannot be recovered afterwards.
+func IssueToken(ctx context.Context, params TokenParams) (string, *entities.ApiToken, error) {
+	prefix, ok := token_prefix(params.Kind)
+	if !ok {
+		return "", nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid token kind")
+	}
+
+	for _, scope := range params.Scopes {
+		if !rbac.IsTokenScope(rbac.Permission(scope)) {
+			return "", nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid scope").WithHint("scope", scope)
+		}
+	}
+
+	if params.TTL <= 0 {
+		params.TTL = TokenTTLDefault
+	}
+
+	secret, err := generate()
+	if err != nil {
+		return "", nil, erratic.NewSystemError(erratic.AuthModule).Wrap(err)
+	}
+
+	create := entities.CreateAPITokenParams{
+		Kind:      params.Kind,
+		Name:      params.Name,
+		Hash:      digest(secret),
+		Scopes:    params.Scopes,
+		OrgID:     params.OrgID,
+		UserID:    params.UserID,
+		ExpiresAt: time.Now().Add(params.TTL),
+	}
+
+	// the id is random, the token starts with the head of the id and never tells the time it was created. the prefix is
+	// unique, see TokenPrefix, on the rare collision the token is created again with another id.
+	for attempt := 1; ; attempt++ {
+		create.ID, err = uuid.NewRandom()
+		if err != nil {
+			return "", nil, erratic.NewSystemError(erratic.AuthModule).Wrap(err)
+		}
+
+		token, err := db.Queries().CreateAPIToken(ctx, create)
+		if err == nil {
+			return encode(prefix, create.ID, secret), &token, nil
+		}
+
+		if !is_unique_violation(err) || attempt == token_attempts {
+			return "", nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to create token").Wrap(err)
+		}
+	}
+}
+
+// VerifyToken returns the token of the value, if the value is valid.
+func VerifyToken(ctx context.Context, value string) (*entities.ApiToken, error) {
+	kind := TokenKindPersonal
+	if strings.HasPrefix(value, PrefixOrg) {
+		kind = TokenKindOrg
+	}
+
+	prefix, _ := token_prefix(kind)
+
+	id, secret, err := decode(prefix, value)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := db.Queries().GetAPIToken(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalid
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if token.Kind != kind {
+		return nil, ErrInvalid
+	}
+
+	if err := CheckToken(&token, secret, time.Now()); err != nil {
+		return nil, err
+	}
+
+	_ = db.Queries().TouchAPIToken(ctx, token.ID)
+
+	return &token, nil
+}
+
+// CheckToken validates the secret against the token at the given time.
+func CheckToken(token *entities.ApiToken, secret string, now time.Time) error {
+	return check(token.Hash, token.IsRevoked, token.ExpiresAt, secret, now)
+}
+
+// RevokeToken invalidates the token immediately.
+func RevokeToken(ctx context.Context, id uuid.UUID) error {
+	if err := db.Queries().RevokeAPIToken(ctx, id); err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to revoke token").Wrap(err)
+	}
+
+	return nil
+}
+
+// TokenPrefix returns the identifying prefix of the token, e.g. qpat_6f1c09b46d1d. The value of the token starts with
+// the prefix, the head of its random id. The prefix is unique across tokens, see api_tokens_prefix_idx.
+func TokenPrefix(token *entities.ApiToken) string {
+	prefix, _ := token_prefix(token.Kind)
+
+	return prefix + hex.EncodeToString(token.ID[:6])
+}
+
+func token_prefix(kind string) (string, bool) {
+	switch kind {
+	case TokenKindPersonal:
+		return PrefixPersonal, true
+	case TokenKindOrg:
+		return PrefixOrg, true
+	default:
+		return "", false
+	}
+}
+
+func is_unique_violation(err error) bool {
+	pgerr := &pgconn.PgError{}
+
+	return errors.As(err, &pgerr) && pgerr.Code == unique_violation
+}
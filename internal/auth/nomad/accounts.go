@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/auth/rbac"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/erratic"
 	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
@@ -21,6 +22,17 @@ type (
 	}
 )
 
+var (
+	// AccountServicePolicy declares the permissions required by the procedures of the AccountService. The
+	// AccountService is called by the backend of the web app, with a service key.
+	AccountServicePolicy = rbac.Policy{
+		authv1connect.AccountServiceGetAccountByProviderAccountIDProcedure: {rbac.PermissionAccountsRead},
+		authv1connect.AccountServiceGetAccountsByUserIDProcedure:           {rbac.PermissionAccountsRead},
+		authv1connect.AccountServiceCreateAccountProcedure:                 {rbac.PermissionAccountsWrite},
+		authv1connect.AccountServiceGetAccountByIDProcedure:                {rbac.PermissionAccountsRead},
+	}
+)
+
 func (s *AccountService) GetAccountByProviderAccountID(
 	ctx context.Context,
 	req *connect.Request[authv1.GetAccountByProviderAccountIDRequest],
@@ -106,6 +118,8 @@ func (s *AccountService) GetAccountByID(
 }
 
 func NewAccountSericeServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	rbac.Declare(AccountServicePolicy)
+
 	return authv1connect.NewAccountServiceHandler(
 		&AccountService{},
 		opts...,
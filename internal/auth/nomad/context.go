@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/auth/rbac"
 )
 
 type (
@@ -11,8 +13,14 @@ type (
 )
 
 const (
-	AuthContextUser AuthContext = "user_id"
-	AuthContextOrg  AuthContext = "org_id"
+	AuthContextUser    AuthContext = "user_id"
+	AuthContextOrg     AuthContext = "org_id"
+	AuthContextService AuthContext = "service_key_id"
+	AuthContextScopes  AuthContext = "scopes"
+	AuthContextToken   AuthContext = "token_id"
+	AuthContextKind    AuthContext = "token_kind"
+	AuthContextSSO     AuthContext = "sso"
+	AuthContextSession AuthContext = "session_id"
 )
 
 func GetAuthContext(ctx context.Context) (uuid.UUID, uuid.UUID) {
@@ -21,3 +29,53 @@ func GetAuthContext(ctx context.Context) (uuid.UUID, uuid.UUID) {
 
 	return uuid.MustParse(user_id.(string)), uuid.MustParse(org_id.(string))
 }
+
+// GetServiceContext returns the id and the scopes of the service key of the request, and false if the request was
+// authenticated with a user token.
+func GetServiceContext(ctx context.Context) (uuid.UUID, []rbac.Permission, bool) {
+	id, ok := ctx.Value(AuthContextService).(uuid.UUID)
+	if !ok {
+		return uuid.Nil, nil, false
+	}
+
+	scopes, _ := ctx.Value(AuthContextScopes).([]rbac.Permission)
+
+	return id, scopes, true
+}
+
+// IsServiceContext reports whether the request was authenticated with a service key.
+func IsServiceContext(ctx context.Context) bool {
+	_, _, ok := GetServiceContext(ctx)
+
+	return ok
+}
+
+// GetTokenContext returns the id, the kind and the scopes of the personal access token or the org API key of the
+// request, and false if the request was authenticated otherwise. The user and the org of the token are in the auth
+// context, see GetAuthContext.
+func GetTokenContext(ctx context.Context) (uuid.UUID, string, []rbac.Permission, bool) {
+	id, ok := ctx.Value(AuthContextToken).(uuid.UUID)
+	if !ok {
+		return uuid.Nil, "", nil, false
+	}
+
+	kind, _ := ctx.Value(AuthContextKind).(string)
+	scopes, _ := ctx.Value(AuthContextScopes).([]rbac.Permission)
+
+	return id, kind, scopes, true
+}
+
+// IsSSOContext reports whether the request was authenticated with a session created by the single sign-on of the org.
+func IsSSOContext(ctx context.Context) bool {
+	sso, _ := ctx.Value(AuthContextSSO).(bool)
+
+	return sso
+}
+
+// GetSessionContext returns the session id, the jti claim, of the JWE of the request, and false if the request was not
+// authenticated with a JWE.
+func GetSessionContext(ctx context.Context) (string, bool) {
+	jti, ok := ctx.Value(AuthContextSession).(string)
+
+	return jti, ok && jti != ""
+}
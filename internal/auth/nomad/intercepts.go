@@ -6,10 +6,25 @@ import (
 	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 
+	"go.breu.io/quantm/internal/audit"
 	"go.breu.io/quantm/internal/auth/config"
+	"go.breu.io/quantm/internal/auth/keys"
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/auth/sessions"
+	"go.breu.io/quantm/internal/auth/sso"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
 )
 
+// AuthInterceptor authenticates the bearer token of the request. A user token is a JWE issued by the web app, or by the
+// single sign-on of the org, the user, the org and the session are put into the context. An org requiring the single
+// sign-on rejects the other JWEs. The session of the JWE must not be revoked, and its user must be active and a member
+// of its org, see the sessions package. A service key (see keys.Prefix) is verified against the database, its id and
+// its scopes are put into the context. A personal access token or an org API key is verified against the database, its
+// user and its org are put into the context, along with its id, its kind and its scopes. The user of a personal access
+// token must be active and a member of its org.
 func AuthInterceptor() connect.UnaryInterceptorFunc {
 	intercept := func(next connect.UnaryFunc) connect.UnaryFunc {
 		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
@@ -18,15 +33,66 @@ func AuthInterceptor() connect.UnaryInterceptorFunc {
 			if strings.HasPrefix(header, "Bearer ") {
 				token := strings.TrimPrefix(header, "Bearer ")
 
-				cliams, err := config.DecodeJWE(config.Secret(), token)
+				if keys.IsServiceKey(token) {
+					key, err := keys.Verify(ctx, token)
+					if err != nil {
+						return nil, connect.NewError(connect.CodeUnauthenticated, err)
+					}
+
+					ctx = context.WithValue(ctx, AuthContextService, key.ID)
+					ctx = context.WithValue(ctx, AuthContextScopes, permissions(key.Scopes))
+					ctx = audit.WithActor(ctx, audit.Actor{Kind: audit.ActorServiceKey, ID: key.ID.String()})
+
+					return next(ctx, req)
+				}
+
+				if keys.IsToken(token) {
+					pat, err := keys.VerifyToken(ctx, token)
+					if err != nil {
+						return nil, connect.NewError(connect.CodeUnauthenticated, err)
+					}
+
+					if pat.Kind == keys.TokenKindPersonal {
+						if err := sessions.Active(ctx, pat.UserID, pat.OrgID); err != nil {
+							return nil, session_error(err)
+						}
+					}
+
+					ctx = context.WithValue(ctx, AuthContextUser, pat.UserID.String())
+					ctx = context.WithValue(ctx, AuthContextOrg, pat.OrgID.String())
+					ctx = context.WithValue(ctx, AuthContextToken, pat.ID)
+					ctx = context.WithValue(ctx, AuthContextKind, pat.Kind)
+					ctx = context.WithValue(ctx, AuthContextScopes, permissions(pat.Scopes))
+					ctx = audit.WithActor(ctx, token_actor(pat))
+
+					return next(ctx, req)
+				}
+
+				cliams, err := config.DecodeJWEWithSecrets(config.Secrets(), token)
 				if err != nil {
 					return nil, connect.NewError(connect.CodeUnauthenticated, err)
 				}
 
-				if cliams != nil {
-					ctx = context.WithValue(ctx, AuthContextUser, cliams.UserID)
-					ctx = context.WithValue(ctx, AuthContextOrg, cliams.OrgID)
+				if cliams == nil {
+					return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid bearer token"))
+				}
+
+				if !cliams.SSO {
+					if err := require_sso(ctx, cliams.OrgID); err != nil {
+						return nil, err
+					}
+				}
+
+				client := sessions.Client{IP: audit.RequestFrom(ctx).IP, UserAgent: req.Header().Get("User-Agent")}
+				if err := sessions.Validate(ctx, cliams, client); err != nil {
+					return nil, session_error(err)
 				}
+
+				ctx = context.WithValue(ctx, AuthContextUser, cliams.UserID)
+				ctx = context.WithValue(ctx, AuthContextOrg, cliams.OrgID)
+				ctx = context.WithValue(ctx, AuthContextSSO, cliams.SSO)
+				ctx = context.WithValue(ctx, AuthContextSession, cliams.ID)
+				ctx = audit.WithActor(ctx, user_actor(cliams.UserID, cliams.OrgID))
 			} else {
 				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
 			}
@@ -37,3 +103,108 @@ func AuthInterceptor() connect.UnaryInterceptorFunc {
 
 	return connect.UnaryInterceptorFunc(intercept)
 }
+
+// AuthzInterceptor authorizes the requests authenticated by AuthInterceptor. The roles of the user within the org, or
+// the scopes of the service key, are checked against the permissions declared for the procedure with rbac.Declare. It
+// must be chained after AuthInterceptor.
+func AuthzInterceptor() connect.UnaryInterceptorFunc {
+	intercept := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if _, scopes, ok := GetServiceContext(ctx); ok {
+				if err := rbac.AuthorizeScopes(req.Spec().Procedure, scopes...); err != nil {
+					return nil, err
+				}
+
+				return next(ctx, req)
+			}
+
+			_, kind, scopes, is_token := GetTokenContext(ctx)
+
+			// org API keys act as an org admin, restricted to their scopes.
+			if is_token && kind == keys.TokenKindOrg {
+				if err := rbac.AuthorizeToken(req.Spec().Procedure, scopes, rbac.RoleOrgAdmin); err != nil {
+					return nil, err
+				}
+
+				return next(ctx, req)
+			}
+
+			user_id, org_id := GetAuthContext(ctx)
+
+			roles, err := rbac.Roles(ctx, user_id, org_id)
+			if err != nil {
+				return nil, err
+			}
+
+			if is_token {
+				if err := rbac.AuthorizeToken(req.Spec().Procedure, scopes, roles...); err != nil {
+					return nil, err
+				}
+
+				return next(ctx, req)
+			}
+
+			if err := rbac.Authorize(req.Spec().Procedure, roles...); err != nil {
+				return nil, err
+			}
+
+			return next(ctx, req)
+		})
+	}
+
+	return connect.UnaryInterceptorFunc(intercept)
+}
+
+// require_sso rejects the sessions not created by the single sign-on of an org requiring it.
+func require_sso(ctx context.Context, org string) error {
+	org_id, err := uuid.Parse(org)
+	if err != nil {
+		return nil
+	}
+
+	required, err := sso.Required(ctx, org_id)
+	if err != nil {
+		return err
+	}
+
+	if required {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("org requires single sign-on"))
+	}
+
+	return nil
+}
+
+// session_error rejects the revoked sessions, the users deactivated, and the users removed from the org.
+func session_error(err error) error {
+	switch {
+	case errors.Is(err, sessions.ErrNoSession), errors.Is(err, sessions.ErrRevoked),
+		errors.Is(err, sessions.ErrInactive), errors.Is(err, sessions.ErrNotMember):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	return erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+}
+
+// token_actor returns the actor of a token, the user of a personal access token, or the org API key.
+func token_actor(token *entities.ApiToken) audit.Actor {
+	if token.Kind == keys.TokenKindOrg {
+		return audit.Actor{Kind: audit.ActorAPIKey, ID: token.ID.String(), OrgID: token.OrgID}
+	}
+
+	return audit.Actor{Kind: audit.ActorUser, ID: token.UserID.String(), OrgID: token.OrgID}
+}
+
+func user_actor(user_id, org_id string) audit.Actor {
+	org, _ := uuid.Parse(org_id)
+
+	return audit.Actor{Kind: audit.ActorUser, ID: user_id, OrgID: org}
+}
+
+func permissions(scopes []string) []rbac.Permission {
+	result := make([]rbac.Permission, len(scopes))
+	for i, scope := range scopes {
+		result[i] = rbac.Permission(scope)
+	}
+
+	return result
+}
@@ -3,14 +3,28 @@ package nomad
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"go.breu.io/quantm/internal/audit"
+	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/auth/domains"
+	"go.breu.io/quantm/internal/auth/keys"
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/auth/sessions"
+	"go.breu.io/quantm/internal/auth/sso"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/db/fields"
+	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/erratic"
 	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
 	"go.breu.io/quantm/internal/proto/ctrlplane/auth/v1/authv1connect"
@@ -22,6 +36,27 @@ type (
 	}
 )
 
+var (
+	// OrgServicePolicy declares the permissions required by the procedures of the OrgService.
+	OrgServicePolicy = rbac.Policy{
+		authv1connect.OrgServiceCreateOrgProcedure:   {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceGetOrgByIDProcedure:  {rbac.PermissionOrgRead},
+		authv1connect.OrgServiceSetOrgHooksProcedure: {rbac.PermissionOrgWrite},
+
+		authv1connect.OrgServiceCreateInvitationProcedure:  {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceListInvitationsProcedure:   {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceRevokeInvitationProcedure:  {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceGetDomainProcedure:         {rbac.PermissionOrgRead},
+		authv1connect.OrgServiceVerifyDomainProcedure:      {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceSetDomainAutoJoinProcedure: {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceTransferAdminProcedure:     {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceRemoveMemberProcedure:      {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceGetSSOProcedure:            {rbac.PermissionOrgRead},
+		authv1connect.OrgServiceSetSSOProcedure:            {rbac.PermissionOrgWrite},
+		authv1connect.OrgServiceDeleteSSOProcedure:         {rbac.PermissionOrgWrite},
+	}
+)
+
 func (s *OrgService) SetOrgHooks(
 	ctx context.Context, req *connect.Request[authv1.SetOrgHooksRequest],
 ) (*connect.Response[emptypb.Empty], error) {
@@ -30,19 +65,532 @@ func (s *OrgService) SetOrgHooks(
 		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("unable to detect hook").Wrap(err)
 	}
 
-	params := entities.SetOrgHooksParams{ID: uuid.MustParse(req.Msg.GetOrgId()), Hooks: hooks}
+	org_id, err := uuid.Parse(req.Msg.GetOrgId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid org id").Wrap(err)
+	}
+
+	// service keys act for any org, users only for their own.
+	if !IsServiceContext(ctx) {
+		if _, authenticated := GetAuthContext(ctx); authenticated != org_id {
+			return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("org does not match the authenticated org")
+		}
+	}
 
-	err = db.Queries().SetOrgHooks(ctx, params)
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	org, err := qtx.GetOrg(ctx, org_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "org_id", req.Msg.GetOrgId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	params := entities.SetOrgHooksParams{ID: org_id, Hooks: hooks}
+
+	err = qtx.SetOrgHooks(ctx, params)
 	if err != nil {
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to set org hooks").Wrap(err)
 	}
 
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      org_id,
+		Action:     audit.ActionOrgHooksSet,
+		TargetKind: audit.TargetOrg,
+		TargetID:   org_id.String(),
+		Before:     json.RawMessage(org.Hooks),
+		After:      json.RawMessage(hooks),
+	})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// CreateInvitation invites a user to the org by email. The token is returned once, the caller sends it to the user.
+func (s *OrgService) CreateInvitation(
+	ctx context.Context, req *connect.Request[authv1.CreateInvitationRequest],
+) (*connect.Response[authv1.CreateInvitationResponse], error) {
+	user_id, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetEmail() == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("email is required")
+	}
+
+	params := keys.InvitationParams{
+		OrgID:     org_id,
+		Email:     req.Msg.GetEmail(),
+		Role:      req.Msg.GetRole(),
+		InvitedBy: user_id,
+		TTL:       req.Msg.GetTtl().AsDuration(),
+	}
+
+	token, invitation, err := keys.IssueInvitation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&authv1.CreateInvitationResponse{Invitation: cast.InvitationToProto(invitation), Token: token}), nil
+}
+
+// ListInvitations lists the pending invitations of the org, newest first.
+func (s *OrgService) ListInvitations(
+	ctx context.Context, _ *connect.Request[authv1.ListInvitationsRequest],
+) (*connect.Response[authv1.ListInvitationsResponse], error) {
+	_, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := db.Queries().ListOrgInvitations(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.ListInvitationsResponse{Invitations: cast.InvitationsToProto(invitations)}), nil
+}
+
+// RevokeInvitation revokes an invitation of the org.
+func (s *OrgService) RevokeInvitation(
+	ctx context.Context, req *connect.Request[authv1.RevokeInvitationRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	_, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parse("id", req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := db.Queries().GetOrgInvitation(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "invitation").AddHint("id", req.Msg.GetId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if invitation.OrgID != org_id {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "invitation").AddHint("id", req.Msg.GetId())
+	}
+
+	if err := keys.RevokeInvitation(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// GetDomain returns the verification state of the domain of the org.
+func (s *OrgService) GetDomain(
+	ctx context.Context, _ *connect.Request[authv1.GetDomainRequest],
+) (*connect.Response[authv1.GetDomainResponse], error) {
+	domain, err := domain(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&authv1.GetDomainResponse{Domain: cast.DomainToProto(domain)}), nil
+}
+
+// VerifyDomain looks up the TXT record of the domain of the org. The lookup runs on the core queue, the request waits
+// for its result.
+func (s *OrgService) VerifyDomain(
+	ctx context.Context, _ *connect.Request[authv1.VerifyDomainRequest],
+) (*connect.Response[authv1.VerifyDomainResponse], error) {
+	existing, err := domain(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !existing.IsVerified {
+		run, err := durable.
+			OnCore().
+			ExecuteWorkflow(ctx, domains.VerifyWorkflowOptions(existing.OrgID), domains.VerifyWorkflow, existing.OrgID)
+		if err != nil {
+			return nil, erratic.NewSystemError(erratic.AuthModule).WithReason("unable to verify domain").Wrap(err)
+		}
+
+		if err := run.Get(ctx, &existing.IsVerified); err != nil {
+			return nil, erratic.NewSystemError(erratic.AuthModule).WithReason("unable to verify domain").Wrap(err)
+		}
+	}
+
+	return connect.NewResponse(&authv1.VerifyDomainResponse{Domain: cast.DomainToProto(existing)}), nil
+}
+
+// SetDomainAutoJoin enables or disables joining the org by the domain of the email on sign up. Auto-join is enabled
+// by default, so that existing orgs keep working, but enabling it again requires a verified domain.
+func (s *OrgService) SetDomainAutoJoin(
+	ctx context.Context, req *connect.Request[authv1.SetDomainAutoJoinRequest],
+) (*connect.Response[authv1.SetDomainAutoJoinResponse], error) {
+	existing, err := domain(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetAutoJoin() && !existing.AutoJoin && !existing.IsVerified {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("domain must be verified to enable auto-join")
+	}
+
+	params := entities.SetOrgDomainAutoJoinParams{OrgID: existing.OrgID, AutoJoin: req.Msg.GetAutoJoin()}
+
+	updated, err := db.Queries().SetOrgDomainAutoJoin(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to set auto-join").Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.SetDomainAutoJoinResponse{Domain: cast.DomainToProto(&updated)}), nil
+}
+
+// TransferAdmin makes another member of the org its admin, and the authenticated admin a member.
+func (s *OrgService) TransferAdmin(
+	ctx context.Context, req *connect.Request[authv1.TransferAdminRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// org API keys act as an admin, the admin role is transferred by the admin only.
+	if _, _, _, ok := GetTokenContext(ctx); ok {
+		return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("admin role cannot be transferred with a token")
+	}
+
+	names, err := db.Queries().ListUserRoleNames(ctx, entities.ListUserRoleNamesParams{UserID: user_id, OrgID: org_id})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if !slices.Contains(names, keys.RoleAdmin) {
+		return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("admin role is transferred by an admin")
+	}
+
+	target_id, err := parse("user_id", req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if target_id == user_id {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("admin role cannot be transferred to oneself")
+	}
+
+	target, err := db.Queries().GetUserByID(ctx, target_id)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	member := false
+	if err == nil {
+		if member, err = is_member(ctx, target.ID, org_id); err != nil {
+			return nil, err
+		}
+	}
+
+	// users of other orgs are not found, so that their ids are not disclosed.
+	if !member {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "user").AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for id, role := range map[uuid.UUID]string{target.ID: keys.RoleAdmin, user_id: keys.RoleMember} {
+		if err := qtx.DeleteUserRoles(ctx, entities.DeleteUserRolesParams{UserID: id, OrgID: org_id}); err != nil {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to transfer admin").Wrap(err)
+		}
+
+		if _, err := qtx.CreateUserRole(ctx, entities.CreateUserRoleParams{Name: role, UserID: id, OrgID: org_id}); err != nil {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to transfer admin").Wrap(err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to transfer admin").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// RemoveMember removes a member from the org, with their roles and teams within the org. The member keeps their other
+// orgs, and the default org of a member removed from it is another of their orgs. The sessions and the personal access
+// tokens of the member within the org are rejected. The admin is removed once the admin role is transferred.
+func (s *OrgService) RemoveMember(
+	ctx context.Context, req *connect.Request[authv1.RemoveMemberRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target_id, err := parse("user_id", req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if target_id == user_id {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("members cannot remove themselves")
+	}
+
+	member, err := is_member(ctx, target_id, org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	// users of other orgs are not found, so that their ids are not disclosed.
+	if !member {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "user").AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	names, err := db.Queries().ListUserRoleNames(ctx, entities.ListUserRoleNamesParams{UserID: target_id, OrgID: org_id})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if slices.Contains(names, keys.RoleAdmin) {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("admin is removed once the admin role is transferred")
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	target, err := qtx.GetUserByID(ctx, target_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := qtx.DeleteOrgMember(ctx, entities.DeleteOrgMemberParams{UserID: target_id, OrgID: org_id}); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to remove member").Wrap(err)
+	}
+
+	if err := qtx.DeleteUserRoles(ctx, entities.DeleteUserRolesParams{UserID: target_id, OrgID: org_id}); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to remove member").Wrap(err)
+	}
+
+	err = qtx.DeleteOrgTeamMembers(ctx, entities.DeleteOrgTeamMembersParams{UserID: target_id, OrgID: org_id})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to remove member").Wrap(err)
+	}
+
+	if target.OrgID == org_id {
+		orgs, err := qtx.ListUserOrgs(ctx, target_id)
+		if err != nil {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+
+		fallback := NoOrgUUID
+		if len(orgs) > 0 {
+			fallback = orgs[0].ID
+		}
+
+		if _, err := qtx.SetUserOrg(ctx, entities.SetUserOrgParams{ID: target_id, OrgID: fallback}); err != nil {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to remove member").Wrap(err)
+		}
+	}
+
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      org_id,
+		Action:     audit.ActionOrgMemberRemove,
+		TargetKind: audit.TargetUser,
+		TargetID:   target_id.String(),
+		Before:     map[string]any{"roles": names},
+	})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to remove member").Wrap(err)
+	}
+
+	sessions.Invalidate(target_id)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// GetSSO returns the single sign-on of the org.
+func (s *OrgService) GetSSO(
+	ctx context.Context, _ *connect.Request[authv1.GetSSORequest],
+) (*connect.Response[authv1.GetSSOResponse], error) {
+	_, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := db.Queries().GetOrgSSOConfig(ctx, org_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "sso")
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	slug, err := db.Queries().GetOrgSlugByID(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.GetSSOResponse{Sso: cast.SSOToProto(&cfg, slug)}), nil
+}
+
+// SetSSO configures the single sign-on of the org. The issuer must be discoverable. Requiring the single sign-on is
+// allowed from a session created by it only, so that the admin cannot lock out the org with a broken configuration.
+func (s *OrgService) SetSSO(
+	ctx context.Context, req *connect.Request[authv1.SetSSORequest],
+) (*connect.Response[authv1.SetSSOResponse], error) {
+	_, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := url.Parse(req.Msg.GetIssuer())
+	if err != nil || issuer.Host == "" || (issuer.Scheme != "https" && issuer.Hostname() != "localhost") {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).
+			WithReason("issuer must be an https url").
+			AddHint("issuer", req.Msg.GetIssuer())
+	}
+
+	if req.Msg.GetClientId() == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("client id is required")
+	}
+
+	if req.Msg.GetIsRequired() && !IsSSOContext(ctx) {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).
+			WithReason("sign in with the single sign-on before requiring it")
+	}
+
+	params := entities.SetOrgSSOConfigParams{
+		OrgID:          org_id,
+		Issuer:         req.Msg.GetIssuer(),
+		ClientID:       req.Msg.GetClientId(),
+		ClientSecret:   fields.Sensitive(req.Msg.GetClientSecret()),
+		AllowedDomains: make([]string, 0),
+		IsRequired:     req.Msg.GetIsRequired(),
+	}
+
+	for _, domain := range req.Msg.GetAllowedDomains() {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain != "" && !slices.Contains(params.AllowedDomains, domain) {
+			params.AllowedDomains = append(params.AllowedDomains, domain)
+		}
+	}
+
+	// an empty client secret keeps the current one.
+	if params.ClientSecret == "" {
+		existing, err := db.Queries().GetOrgSSOConfig(ctx, org_id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("client secret is required")
+			}
+
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+
+		params.ClientSecret = existing.ClientSecret
+	}
+
+	if _, err := sso.Discover(ctx, params.Issuer); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason(err.Error()).AddHint("issuer", params.Issuer)
+	}
+
+	cfg, err := db.Queries().SetOrgSSOConfig(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to set sso").Wrap(err)
+	}
+
+	slug, err := db.Queries().GetOrgSlugByID(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.SetSSOResponse{Sso: cast.SSOToProto(&cfg, slug)}), nil
+}
+
+// DeleteSSO removes the single sign-on of the org. Its members sign in with the web app again.
+func (s *OrgService) DeleteSSO(
+	ctx context.Context, _ *connect.Request[authv1.DeleteSSORequest],
+) (*connect.Response[emptypb.Empty], error) {
+	_, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Queries().DeleteOrgSSOConfig(ctx, org_id); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to delete sso").Wrap(err)
+	}
+
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
 func NewOrgServiceServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	rbac.Declare(OrgServicePolicy)
+
 	return authv1connect.NewOrgServiceHandler(
 		&OrgService{},
 		opts...,
 	)
 }
+
+// principal returns the user and the org of the request. Service keys act on behalf of no user, so they cannot call
+// the procedures acting as a user.
+func principal(ctx context.Context) (uuid.UUID, uuid.UUID, error) {
+	if IsServiceContext(ctx) {
+		return uuid.Nil, uuid.Nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("procedure requires a user")
+	}
+
+	user_id, org_id := GetAuthContext(ctx)
+
+	return user_id, org_id, nil
+}
+
+// is_member reports whether the user is a member of the org.
+func is_member(ctx context.Context, user_id, org_id uuid.UUID) (bool, error) {
+	member, err := db.Queries().IsOrgMember(ctx, entities.IsOrgMemberParams{UserID: user_id, OrgID: org_id})
+	if err != nil {
+		return false, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return member, nil
+}
+
+// domain returns the domain of the org of the request.
+func domain(ctx context.Context) (*entities.OrgDomain, error) {
+	_, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := db.Queries().GetOrg(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return domains.Ensure(ctx, &org)
+}
@@ -0,0 +1,344 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/audit"
+	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/auth/sessions"
+	"go.breu.io/quantm/internal/auth/sso"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/auth/v1/authv1connect"
+)
+
+type (
+	// SessionService manages the sessions of the authenticated user. The admins of the org, and the backend of the web
+	// app with a service key, revoke the sessions of the members.
+	SessionService struct {
+		authv1connect.UnimplementedSessionServiceHandler
+	}
+)
+
+var (
+	// SessionServicePolicy declares the permissions required by the procedures of the SessionService.
+	SessionServicePolicy = rbac.Policy{
+		authv1connect.SessionServiceListSessionsProcedure:       {rbac.PermissionSessionsRead},
+		authv1connect.SessionServiceRevokeSessionProcedure:      {rbac.PermissionSessionsWrite},
+		authv1connect.SessionServiceLogoutProcedure:             {rbac.PermissionSessionsWrite},
+		authv1connect.SessionServiceRevokeUserSessionsProcedure: {rbac.PermissionSessionsWrite},
+		authv1connect.SessionServiceSwitchOrgProcedure:          {rbac.PermissionSessionsWrite},
+	}
+)
+
+// ListSessions lists the active sessions of the user, most recently used first.
+func (s *SessionService) ListSessions(
+	ctx context.Context, _ *connect.Request[authv1.ListSessionsRequest],
+) (*connect.Response[authv1.ListSessionsResponse], error) {
+	user_id, _, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := db.Queries().ListUserSessions(ctx, user_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	current, _ := GetSessionContext(ctx)
+
+	return connect.NewResponse(&authv1.ListSessionsResponse{Sessions: cast.SessionsToProto(list, current)}), nil
+}
+
+// RevokeSession revokes a session of the user.
+func (s *SessionService) RevokeSession(
+	ctx context.Context, req *connect.Request[authv1.RevokeSessionRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("id", req.Msg.GetId())
+	}
+
+	session, err := db.Queries().GetSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "id", req.Msg.GetId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if session.UserID != user_id {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "id", req.Msg.GetId())
+	}
+
+	if err := revoke_session(ctx, org_id, session.ID); err != nil {
+		return nil, err
+	}
+
+	sessions.Invalidate(user_id)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// Logout revokes the session of the request.
+func (s *SessionService) Logout(
+	ctx context.Context, _ *connect.Request[emptypb.Empty],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jti, ok := GetSessionContext(ctx)
+	if !ok {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("request has no session")
+	}
+
+	session, err := db.Queries().GetSessionByJti(ctx, jti)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := revoke_session(ctx, org_id, session.ID); err != nil {
+		return nil, err
+	}
+
+	sessions.Invalidate(user_id)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// RevokeUserSessions revokes all the sessions of a user. Users revoke their own sessions, the admins of the org the
+// sessions of its members, and service keys the sessions of any user.
+func (s *SessionService) RevokeUserSessions(
+	ctx context.Context, req *connect.Request[authv1.RevokeUserSessionsRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	target, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	user, err := db.Queries().GetUserByID(ctx, target)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "user_id", req.Msg.GetUserId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	// the revocation is recorded in the org of the request, or in the default org of the user for service keys.
+	org := user.OrgID
+
+	if !IsServiceContext(ctx) {
+		user_id, org_id := GetAuthContext(ctx)
+		org = org_id
+
+		if target != user_id {
+			perms, err := granted(ctx, user_id, org_id)
+			if err != nil {
+				return nil, err
+			}
+
+			member, err := is_member(ctx, target, org_id)
+			if err != nil {
+				return nil, err
+			}
+
+			if !perms[rbac.PermissionOrgWrite] || !member {
+				return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("sessions of members are revoked by the admins")
+			}
+		}
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := sessions.RevokeAll(ctx, qtx, target, time.Now()); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      org,
+		Action:     audit.ActionUserSessionsRevoke,
+		TargetKind: audit.TargetUser,
+		TargetID:   target.String(),
+	})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	sessions.Invalidate(target)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// SwitchOrg switches the session of the request to another org of the user, and makes it the default org of the user.
+// The new session expires with the session of the request, which is revoked. The sessions created by the single
+// sign-on of an org are bound to the org, and an org requiring the single sign-on is switched to by signing in with it.
+func (s *SessionService) SwitchOrg(
+	ctx context.Context, req *connect.Request[authv1.SwitchOrgRequest],
+) (*connect.Response[authv1.SwitchOrgResponse], error) {
+	user_id, current, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jti, ok := GetSessionContext(ctx)
+	if !ok {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("request has no session")
+	}
+
+	if IsSSOContext(ctx) {
+		return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("single sign-on sessions are bound to their org")
+	}
+
+	org_id, err := uuid.Parse(req.Msg.GetOrgId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("org_id", req.Msg.GetOrgId())
+	}
+
+	// orgs of other users are not found, so that their ids are not disclosed.
+	member, err := is_member(ctx, user_id, org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !member {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "org_id", req.Msg.GetOrgId())
+	}
+
+	required, err := sso.Required(ctx, org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if required {
+		return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("org requires single sign-on")
+	}
+
+	org, err := db.Queries().GetOrg(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	session, err := db.Queries().GetSessionByJti(ctx, jti)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	token, err := sessions.Issue(user_id, org_id, false, time.Now(), session.ExpiresAt)
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.AuthModule).WithReason("unable to create session").Wrap(err)
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := qtx.SetUserOrg(ctx, entities.SetUserOrgParams{ID: user_id, OrgID: org_id}); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := qtx.RevokeSession(ctx, session.ID); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	// the switch is recorded in both orgs.
+	orgs := []uuid.UUID{current}
+	if org_id != current {
+		orgs = append(orgs, org_id)
+	}
+
+	for _, id := range orgs {
+		err := audit.Record(ctx, qtx, audit.Entry{
+			OrgID:      id,
+			Action:     audit.ActionSessionSwitch,
+			TargetKind: audit.TargetSession,
+			TargetID:   session.ID.String(),
+			Before:     map[string]any{"org_id": current.String()},
+			After:      map[string]any{"org_id": org_id.String()},
+		})
+		if err != nil {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	sessions.Invalidate(user_id)
+
+	return connect.NewResponse(&authv1.SwitchOrgResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		Org:       cast.OrgToProto(&org),
+	}), nil
+}
+
+// revoke_session revokes the session, and records it in the audit log of the org.
+func revoke_session(ctx context.Context, org_id, id uuid.UUID) error {
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := qtx.RevokeSession(ctx, id); err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      org_id,
+		Action:     audit.ActionSessionRevoke,
+		TargetKind: audit.TargetSession,
+		TargetID:   id.String(),
+	})
+	if err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// NewSessionServiceHandler creates a new SessionServiceHandler and returns the service name and handler.
+func NewSessionServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	rbac.Declare(SessionServicePolicy)
+
+	return authv1connect.NewSessionServiceHandler(&SessionService{}, opts...)
+}
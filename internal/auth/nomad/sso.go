@@ -0,0 +1,316 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth/config"
+	"go.breu.io/quantm/internal/auth/keys"
+	"go.breu.io/quantm/internal/auth/sessions"
+	"go.breu.io/quantm/internal/auth/sso"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+const (
+	// SSOCallbackPath is the path of the callback of the single sign-on, registered at the providers of the orgs.
+	SSOCallbackPath = "/auth/sso/callback"
+
+	// cookies of the single sign-on. The session cookie is the cookie of Auth.js, so that the web app reads it when
+	// served from the same site as the nomad API.
+	sso_state_cookie   = "quantm-sso-state"
+	sso_session_cookie = "__Secure-authjs.session-token"
+)
+
+type (
+	// sso_site is the nomad API as seen by the browsers.
+	sso_site struct {
+		callback string // callback is the redirect uri registered at the providers.
+		secure   bool   // secure sends the state cookie over https only.
+	}
+)
+
+var (
+	ErrSSOMember      = errors.New("user is not a member of the org")
+	ErrSSOExternalURL = errors.New("external url must be an absolute http or https url")
+)
+
+// NewSSOHandler returns the handler of the single sign-on of the orgs. The login at /auth/sso/{slug}/login redirects to
+// the provider of the org. The callback verifies the ID token of the provider, signs in the user, creating the user or
+// adding the user to the org on first sign in, sets the session cookie and redirects to the given path of the web app.
+//
+// The external url is the url of the nomad API as seen by the browsers, e.g. https://api.quantm.io. It gives the
+// callback registered at the providers, and whether the cookies are secure. The Host and X-Forwarded-Proto headers are
+// never used, any client can set them. Without a valid external url, the single sign-on is unavailable.
+func NewSSOHandler(external string) (string, http.Handler) {
+	mux := http.NewServeMux()
+
+	site, err := new_sso_site(external)
+	if err != nil {
+		slog.Warn("auth/sso: single sign-on is disabled, configure the environment variable 'NOMAD__EXTERNAL_URL'",
+			"error", err.Error())
+
+		mux.HandleFunc("/auth/sso/", func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "sso is not configured", http.StatusServiceUnavailable)
+		})
+
+		return "/auth/sso/", mux
+	}
+
+	mux.HandleFunc("GET /auth/sso/{slug}/login", func(w http.ResponseWriter, r *http.Request) { sso_login(w, r, site) })
+	mux.HandleFunc("GET "+SSOCallbackPath, func(w http.ResponseWriter, r *http.Request) { sso_callback(w, r, site) })
+
+	return "/auth/sso/", mux
+}
+
+// new_sso_site parses the external url of the nomad API.
+func new_sso_site(external string) (*sso_site, error) {
+	parsed, err := url.Parse(strings.TrimSuffix(external, "/"))
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return nil, ErrSSOExternalURL
+	}
+
+	return &sso_site{callback: parsed.String() + SSOCallbackPath, secure: parsed.Scheme == "https"}, nil
+}
+
+func sso_login(w http.ResponseWriter, r *http.Request, site *sso_site) {
+	ctx := r.Context()
+
+	cfg, err := db.Queries().GetOrgSSOConfigBySlug(ctx, r.PathValue("slug"))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.NotFound(w, r)
+			return
+		}
+
+		sso_error(w, "unable to get sso", err, http.StatusInternalServerError)
+
+		return
+	}
+
+	provider, err := sso.NewProvider(ctx, &cfg, site.callback)
+	if err != nil {
+		sso_error(w, "unable to discover provider", err, http.StatusBadGateway)
+		return
+	}
+
+	state, err := sso.NewState(cfg.OrgID, r.URL.Query().Get("redirect"), time.Now())
+	if err != nil {
+		sso_error(w, "unable to create state", err, http.StatusInternalServerError)
+		return
+	}
+
+	encoded := state.Encode(config.Secret())
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sso_state_cookie,
+		Value:    encoded,
+		Path:     "/auth/sso/",
+		MaxAge:   int(sso.StateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   site.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, provider.AuthCodeURL(encoded, state.Nonce), http.StatusFound)
+}
+
+func sso_callback(w http.ResponseWriter, r *http.Request, site *sso_site) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	if reason := query.Get("error"); reason != "" {
+		sso_error(w, "sign in failed at the provider", errors.New(reason), http.StatusUnauthorized)
+		return
+	}
+
+	// the state must be the one given to this browser.
+	cookie, err := r.Cookie(sso_state_cookie)
+	if err != nil || cookie.Value != query.Get("state") {
+		sso_error(w, "invalid state", sso.ErrState, http.StatusBadRequest)
+		return
+	}
+
+	state, err := sso.DecodeState(config.Secret(), cookie.Value, time.Now())
+	if err != nil {
+		sso_error(w, "invalid state", err, http.StatusBadRequest)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: sso_state_cookie, Path: "/auth/sso/", MaxAge: -1})
+
+	cfg, err := db.Queries().GetOrgSSOConfig(ctx, state.OrgID)
+	if err != nil {
+		sso_error(w, "unable to get sso", err, http.StatusBadRequest)
+		return
+	}
+
+	provider, err := sso.NewProvider(ctx, &cfg, site.callback)
+	if err != nil {
+		sso_error(w, "unable to discover provider", err, http.StatusBadGateway)
+		return
+	}
+
+	raw, err := provider.Exchange(ctx, query.Get("code"))
+	if err != nil {
+		sso_error(w, "unable to exchange code", err, http.StatusUnauthorized)
+		return
+	}
+
+	identity, err := provider.Verify(ctx, raw, state.Nonce, time.Now())
+	if err != nil {
+		sso_error(w, "unable to verify id token", err, http.StatusUnauthorized)
+		return
+	}
+
+	user, err := sso_signin(ctx, provider, &cfg, identity)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrSSOMember) || errors.Is(err, sessions.ErrInactive) || errors.Is(err, sso.ErrUnlinked) {
+			status = http.StatusForbidden
+		}
+
+		sso_error(w, "unable to sign in", err, status)
+
+		return
+	}
+
+	session, err := sso.Session(user.ID, cfg.OrgID, time.Now())
+	if err != nil {
+		sso_error(w, "unable to create session", err, http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sso_session_cookie,
+		Value:    session,
+		Path:     "/",
+		MaxAge:   int(sso.SessionTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, state.Redirect, http.StatusFound)
+}
+
+// sso_signin returns the user of the identity. On first sign in, the account of the provider is linked to the user
+// with the email of the identity, or to a new member of the org, only if the email is within the verified domain of the
+// org, see sso.Linkable. A user without an org joins the org as a member, the members of other orgs join with an
+// invitation. A deactivated user cannot sign in.
+func sso_signin(
+	ctx context.Context, provider *sso.Provider, cfg *entities.OrgSsoConfig, identity *sso.Identity,
+) (*entities.User, error) {
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var user entities.User
+
+	account, err := qtx.GetOAuthAccountByProviderAccountID(ctx, entities.GetOAuthAccountByProviderAccountIDParams{
+		ProviderAccountID: identity.Subject,
+		Provider:          provider.AccountProvider(),
+	})
+
+	switch {
+	case err == nil:
+		user, err = qtx.GetUserByID(ctx, account.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+	case errors.Is(err, pgx.ErrNoRows):
+		if err := sso_linkable(ctx, qtx, cfg.OrgID, identity); err != nil {
+			return nil, err
+		}
+
+		user, err = qtx.GetUserByEmail(ctx, identity.Email)
+		if errors.Is(err, pgx.ErrNoRows) {
+			user, err = qtx.CreateUser(ctx, entities.CreateUserParams{
+				FirstName: identity.GivenName,
+				LastName:  identity.FamilyName,
+				Lower:     identity.Email,
+				OrgID:     NoOrgUUID,
+			})
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = qtx.CreateOAuthAccount(ctx, entities.CreateOAuthAccountParams{
+			UserID:            user.ID,
+			Provider:          provider.AccountProvider(),
+			ProviderAccountID: identity.Subject,
+			ExpiresAt:         identity.Expiry.Time(),
+			Type:              sso.AccountType,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, err
+	}
+
+	if user.OrgID == NoOrgUUID {
+		user, err = qtx.SetUserOrg(ctx, entities.SetUserOrgParams{ID: user.ID, OrgID: cfg.OrgID})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := join_org(ctx, qtx, user.ID, cfg.OrgID, keys.RoleMember); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err := qtx.IsOrgMember(ctx, entities.IsOrgMemberParams{UserID: user.ID, OrgID: cfg.OrgID})
+	if err != nil {
+		return nil, err
+	}
+
+	if !member {
+		return nil, ErrSSOMember
+	}
+
+	if !user.IsActive {
+		return nil, sessions.ErrInactive
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// sso_linkable checks the identity against the verified domain of the org.
+func sso_linkable(ctx context.Context, qtx *entities.Queries, org_id uuid.UUID, identity *sso.Identity) error {
+	domain, err := qtx.GetOrgDomain(ctx, org_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sso.Linkable(nil, identity)
+		}
+
+		return err
+	}
+
+	return sso.Linkable(&domain, identity)
+}
+
+func sso_error(w http.ResponseWriter, msg string, err error, status int) {
+	slog.Warn("auth/sso: "+msg, "error", err.Error())
+
+	http.Error(w, msg, status)
+}
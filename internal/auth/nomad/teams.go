@@ -0,0 +1,489 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/auth/teams"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/auth/v1/authv1connect"
+)
+
+type (
+	// TeamService manages the teams of the org of the authenticated user, their members, and the repos they own. Teams
+	// are created and deleted by the admins of the org. The members and the repos of a team are also managed by the
+	// admins of the team.
+	TeamService struct {
+		authv1connect.UnimplementedTeamServiceHandler
+	}
+)
+
+var (
+	// TeamServicePolicy declares the permissions required by the procedures of the TeamService.
+	TeamServicePolicy = rbac.Policy{
+		authv1connect.TeamServiceCreateTeamProcedure:       {rbac.PermissionTeamsWrite},
+		authv1connect.TeamServiceGetTeamProcedure:          {rbac.PermissionTeamsRead},
+		authv1connect.TeamServiceListTeamsProcedure:        {rbac.PermissionTeamsRead},
+		authv1connect.TeamServiceUpdateTeamProcedure:       {rbac.PermissionTeamsWrite},
+		authv1connect.TeamServiceDeleteTeamProcedure:       {rbac.PermissionTeamsWrite},
+		authv1connect.TeamServiceAddTeamMemberProcedure:    {rbac.PermissionTeamsWrite},
+		authv1connect.TeamServiceRemoveTeamMemberProcedure: {rbac.PermissionTeamsWrite},
+		authv1connect.TeamServiceListTeamMembersProcedure:  {rbac.PermissionTeamsRead},
+		authv1connect.TeamServiceAssignRepoProcedure:       {rbac.PermissionTeamsWrite, rbac.PermissionReposWrite},
+		authv1connect.TeamServiceUnassignRepoProcedure:     {rbac.PermissionTeamsWrite, rbac.PermissionReposWrite},
+		authv1connect.TeamServiceListTeamReposProcedure:    {rbac.PermissionTeamsRead},
+
+		authv1connect.TeamServiceGetTeamDigestSubscriptionProcedure: {rbac.PermissionTeamsRead},
+		authv1connect.TeamServiceSetTeamDigestSubscriptionProcedure: {rbac.PermissionTeamsWrite},
+	}
+)
+
+// CreateTeam creates a team within the org of the user.
+func (s *TeamService) CreateTeam(
+	ctx context.Context, req *connect.Request[authv1.CreateTeamRequest],
+) (*connect.Response[authv1.CreateTeamResponse], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	if req.Msg.GetOrgId() != "" && req.Msg.GetOrgId() != org_id.String() {
+		return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("org does not match the authenticated org")
+	}
+
+	if req.Msg.GetName() == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("name is required")
+	}
+
+	if err := administers(ctx, user_id, org_id); err != nil {
+		return nil, err
+	}
+
+	params := entities.CreateTeamParams{Name: req.Msg.GetName(), OrgID: org_id, Slug: db.CreateSlug(req.Msg.GetName())}
+
+	created, err := db.Queries().CreateTeam(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to create team").Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.CreateTeamResponse{Team: cast.TeamToProto(&created)}), nil
+}
+
+// GetTeam gets a team of the org.
+func (s *TeamService) GetTeam(
+	ctx context.Context, req *connect.Request[authv1.GetTeamRequest],
+) (*connect.Response[authv1.GetTeamResponse], error) {
+	_, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&authv1.GetTeamResponse{Team: cast.TeamToProto(existing)}), nil
+}
+
+// ListTeams lists the teams of the org.
+func (s *TeamService) ListTeams(
+	ctx context.Context, _ *connect.Request[authv1.ListTeamsRequest],
+) (*connect.Response[authv1.ListTeamsResponse], error) {
+	_, org_id := GetAuthContext(ctx)
+
+	list, err := db.Queries().ListTeamsByOrgID(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.ListTeamsResponse{Teams: cast.TeamsToProto(list)}), nil
+}
+
+// UpdateTeam renames a team. The slug of the team does not change.
+func (s *TeamService) UpdateTeam(
+	ctx context.Context, req *connect.Request[authv1.UpdateTeamRequest],
+) (*connect.Response[authv1.UpdateTeamResponse], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	if req.Msg.GetName() == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("name is required")
+	}
+
+	existing, err := team(ctx, req.Msg.GetId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manages(ctx, user_id, org_id, existing.ID); err != nil {
+		return nil, err
+	}
+
+	updated, err := db.Queries().UpdateTeam(ctx, entities.UpdateTeamParams{ID: existing.ID, Name: req.Msg.GetName()})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to update team").Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.UpdateTeamResponse{Team: cast.TeamToProto(&updated)}), nil
+}
+
+// DeleteTeam deletes a team, with its memberships and the ownership of its repos.
+func (s *TeamService) DeleteTeam(
+	ctx context.Context, req *connect.Request[authv1.DeleteTeamRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := administers(ctx, user_id, org_id); err != nil {
+		return nil, err
+	}
+
+	if err := db.Queries().DeleteTeam(ctx, existing.ID); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to delete team").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// AddTeamMember adds a user of the org to a team, or changes the role of a member.
+func (s *TeamService) AddTeamMember(
+	ctx context.Context, req *connect.Request[authv1.AddTeamMemberRequest],
+) (*connect.Response[authv1.AddTeamMemberResponse], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	role := cast.ProtoToTeamRole(req.Msg.GetRole())
+	if role == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid team role")
+	}
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manages(ctx, user_id, org_id, existing.ID); err != nil {
+		return nil, err
+	}
+
+	member_id, err := parse("user_id", req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := db.Queries().GetUserByID(ctx, member_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "user").AddHint("user_id", req.Msg.GetUserId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	// users of other orgs are not found, so that their ids are not disclosed.
+	in_org, err := is_member(ctx, user.ID, org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !in_org {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "user").AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	member, err := db.Queries().GetTeamMember(ctx, entities.GetTeamMemberParams{TeamID: existing.ID, UserID: user.ID})
+	if err == nil {
+		params := entities.UpdateTeamMemberParams{
+			TeamID: existing.ID, UserID: user.ID, Role: role, IsAdmin: role == entities.TeamRoleAdmin,
+		}
+
+		member, err = db.Queries().UpdateTeamMember(ctx, params)
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		params := entities.CreateTeamUserParams{
+			TeamID: existing.ID, UserID: user.ID, Role: role, IsActive: true, IsAdmin: role == entities.TeamRoleAdmin,
+		}
+
+		member, err = db.Queries().CreateTeamUser(ctx, params)
+	}
+
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to add team member").Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.AddTeamMemberResponse{Member: cast.TeamMemberToProto(&member, &user)}), nil
+}
+
+// RemoveTeamMember removes a member from a team.
+func (s *TeamService) RemoveTeamMember(
+	ctx context.Context, req *connect.Request[authv1.RemoveTeamMemberRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manages(ctx, user_id, org_id, existing.ID); err != nil {
+		return nil, err
+	}
+
+	member_id, err := parse("user_id", req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := entities.DeleteTeamMemberParams{TeamID: existing.ID, UserID: member_id}
+	if err := db.Queries().DeleteTeamMember(ctx, params); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to remove team member").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ListTeamMembers lists the members of a team, ordered by email.
+func (s *TeamService) ListTeamMembers(
+	ctx context.Context, req *connect.Request[authv1.ListTeamMembersRequest],
+) (*connect.Response[authv1.ListTeamMembersResponse], error) {
+	_, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Queries().ListTeamMembers(ctx, existing.ID)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.ListTeamMembersResponse{Members: cast.TeamMembersToProto(rows)}), nil
+}
+
+// AssignRepo assigns a repo of the org, or a path within the repo, to a team. A path owned by another team is
+// reassigned.
+func (s *TeamService) AssignRepo(
+	ctx context.Context, req *connect.Request[authv1.AssignRepoRequest],
+) (*connect.Response[authv1.AssignRepoResponse], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manages(ctx, user_id, org_id, existing.ID); err != nil {
+		return nil, err
+	}
+
+	repo_id, err := repo(ctx, req.Msg.GetRepoId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := entities.AssignTeamRepoParams{TeamID: existing.ID, RepoID: repo_id, Path: teams.NormalizePath(req.Msg.GetPath())}
+
+	owned, err := db.Queries().AssignTeamRepo(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to assign repo").Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.AssignRepoResponse{Repo: cast.TeamRepoToProto(&owned)}), nil
+}
+
+// UnassignRepo removes the ownership of a repo, or of a path within the repo, from a team.
+func (s *TeamService) UnassignRepo(
+	ctx context.Context, req *connect.Request[authv1.UnassignRepoRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manages(ctx, user_id, org_id, existing.ID); err != nil {
+		return nil, err
+	}
+
+	repo_id, err := parse("repo_id", req.Msg.GetRepoId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := entities.UnassignTeamRepoParams{TeamID: existing.ID, RepoID: repo_id, Path: teams.NormalizePath(req.Msg.GetPath())}
+	if err := db.Queries().UnassignTeamRepo(ctx, params); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to unassign repo").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ListTeamRepos lists the repos, and the paths within repos, owned by a team.
+func (s *TeamService) ListTeamRepos(
+	ctx context.Context, req *connect.Request[authv1.ListTeamReposRequest],
+) (*connect.Response[authv1.ListTeamReposResponse], error) {
+	_, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	owned, err := db.Queries().ListTeamReposByTeamID(ctx, existing.ID)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.ListTeamReposResponse{Repos: cast.TeamReposToProto(owned)}), nil
+}
+
+// GetTeamDigestSubscription retrieves the email digest subscription of a team. If the team never subscribed, the
+// default subscription is returned, which is opted out.
+func (s *TeamService) GetTeamDigestSubscription(
+	ctx context.Context, req *connect.Request[authv1.GetTeamDigestSubscriptionRequest],
+) (*connect.Response[authv1.GetTeamDigestSubscriptionResponse], error) {
+	_, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := db.Queries().GetTeamDigest(ctx, existing.ID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+
+		digest = entities.TeamDigest{TeamID: existing.ID, Frequency: cast.DigestFrequencyWeekly}
+	}
+
+	return connect.NewResponse(&authv1.GetTeamDigestSubscriptionResponse{Subscription: cast.TeamDigestToProto(&digest)}), nil
+}
+
+// SetTeamDigestSubscription opts the team in or out of the email digest. The subscription is managed by the admins of
+// the team.
+func (s *TeamService) SetTeamDigestSubscription(
+	ctx context.Context, req *connect.Request[authv1.SetTeamDigestSubscriptionRequest],
+) (*connect.Response[authv1.SetTeamDigestSubscriptionResponse], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	existing, err := team(ctx, req.Msg.GetTeamId(), org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manages(ctx, user_id, org_id, existing.ID); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetIsActive() && req.Msg.GetEmail() == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("email is required").AddHint("email", "")
+	}
+
+	digest, err := db.Queries().SetTeamDigest(ctx, cast.ProtoToSetTeamDigestParams(existing.ID, req.Msg))
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.SetTeamDigestSubscriptionResponse{Subscription: cast.TeamDigestToProto(&digest)}), nil
+}
+
+// NewTeamServiceHandler creates a new TeamServiceHandler and returns the service name and handler.
+func NewTeamServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	rbac.Declare(TeamServicePolicy)
+
+	return authv1connect.NewTeamServiceHandler(&TeamService{}, opts...)
+}
+
+// administers checks that the user is an admin of the org.
+func administers(ctx context.Context, user_id, org_id uuid.UUID) error {
+	granted, err := granted(ctx, user_id, org_id)
+	if err != nil {
+		return err
+	}
+
+	if !granted[rbac.PermissionOrgWrite] {
+		return erratic.NewAuthzError(erratic.AuthModule).WithReason("teams are managed by the admins of the org")
+	}
+
+	return nil
+}
+
+// manages checks that the user is an admin of the org, or an admin of the team.
+func manages(ctx context.Context, user_id, org_id, team_id uuid.UUID) error {
+	member, err := db.Queries().GetTeamMember(ctx, entities.GetTeamMemberParams{TeamID: team_id, UserID: user_id})
+	if err == nil && member.IsActive && member.Role == entities.TeamRoleAdmin {
+		return nil
+	}
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return administers(ctx, user_id, org_id)
+}
+
+// team returns the team of the org. Teams of other orgs are not found, so that their ids are not disclosed.
+func team(ctx context.Context, id string, org_id uuid.UUID) (*entities.Team, error) {
+	team_id, err := parse("team_id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := db.Queries().GetTeam(ctx, team_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "team").AddHint("team_id", id)
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if team.OrgID != org_id {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "team").AddHint("team_id", id)
+	}
+
+	return &team, nil
+}
+
+// repo returns the id of the repo of the org. Repos of other orgs are not found.
+func repo(ctx context.Context, id string, org_id uuid.UUID) (uuid.UUID, error) {
+	repo_id, err := parse("repo_id", id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	repo, err := db.Queries().GetRepoByID(ctx, repo_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, erratic.NewNotFoundError(erratic.AuthModule, "repo").AddHint("repo_id", id)
+		}
+
+		return uuid.Nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if repo.OrgID != org_id {
+		return uuid.Nil, erratic.NewNotFoundError(erratic.AuthModule, "repo").AddHint("repo_id", id)
+	}
+
+	return repo.ID, nil
+}
+
+// parse parses the uuid of a field of the request.
+func parse(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid "+field).AddHint(field, value)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,183 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/auth/keys"
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/auth/v1/authv1connect"
+)
+
+type (
+	// TokenService manages the personal access tokens of the authenticated user, and the API keys of the org. Org API
+	// keys are managed by the admins of the org.
+	TokenService struct {
+		authv1connect.UnimplementedTokenServiceHandler
+	}
+)
+
+var (
+	// TokenServicePolicy declares the permissions required by the procedures of the TokenService.
+	TokenServicePolicy = rbac.Policy{
+		authv1connect.TokenServiceCreateTokenProcedure: {rbac.PermissionTokensWrite},
+		authv1connect.TokenServiceListTokensProcedure:  {rbac.PermissionTokensRead},
+		authv1connect.TokenServiceRevokeTokenProcedure: {rbac.PermissionTokensWrite},
+	}
+)
+
+// CreateToken creates a token. The scopes of the token must be granted to the user, and only the admins of the org can
+// create org API keys.
+func (s *TokenService) CreateToken(
+	ctx context.Context, req *connect.Request[authv1.CreateTokenRequest],
+) (*connect.Response[authv1.CreateTokenResponse], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	kind := cast.ProtoToTokenKind(req.Msg.GetKind())
+	if kind == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid token kind")
+	}
+
+	if req.Msg.GetName() == "" || len(req.Msg.GetScopes()) == 0 {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("name and scopes are required")
+	}
+
+	granted, err := granted(ctx, user_id, org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind == keys.TokenKindOrg && !granted[rbac.PermissionOrgWrite] {
+		return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("org API keys are managed by the admins of the org")
+	}
+
+	for _, scope := range req.Msg.GetScopes() {
+		if !granted[rbac.Permission(scope)] {
+			return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("scope not granted to the user").WithHint("scope", scope)
+		}
+	}
+
+	params := keys.TokenParams{
+		Kind:   kind,
+		Name:   req.Msg.GetName(),
+		Scopes: req.Msg.GetScopes(),
+		OrgID:  org_id,
+		UserID: user_id,
+		TTL:    req.Msg.GetTtl().AsDuration(),
+	}
+
+	value, token, err := keys.IssueToken(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&authv1.CreateTokenResponse{Token: cast.TokenToProto(token), Value: value}), nil
+}
+
+// ListTokens lists the personal access tokens of the user, or the API keys of the org.
+func (s *TokenService) ListTokens(
+	ctx context.Context, req *connect.Request[authv1.ListTokensRequest],
+) (*connect.Response[authv1.ListTokensResponse], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	var (
+		tokens []entities.ApiToken
+		err    error
+	)
+
+	switch cast.ProtoToTokenKind(req.Msg.GetKind()) {
+	case keys.TokenKindPersonal:
+		tokens, err = db.Queries().ListPersonalAPITokens(ctx, entities.ListPersonalAPITokensParams{UserID: user_id, OrgID: org_id})
+	case keys.TokenKindOrg:
+		granted, gerr := granted(ctx, user_id, org_id)
+		if gerr != nil {
+			return nil, gerr
+		}
+
+		if !granted[rbac.PermissionOrgWrite] {
+			return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("org API keys are managed by the admins of the org")
+		}
+
+		tokens, err = db.Queries().ListOrgAPITokens(ctx, org_id)
+	default:
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("invalid token kind")
+	}
+
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.ListTokensResponse{Tokens: cast.TokensToProto(tokens)}), nil
+}
+
+// RevokeToken revokes a token. Users revoke their personal access tokens, the admins of the org revoke any token of
+// the org.
+func (s *TokenService) RevokeToken(
+	ctx context.Context, req *connect.Request[authv1.RevokeTokenRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	user_id, org_id := GetAuthContext(ctx)
+
+	id, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("id", req.Msg.GetId())
+	}
+
+	token, err := db.Queries().GetAPIToken(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "token").AddHint("id", req.Msg.GetId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	// tokens of other orgs are not found, so that their ids are not disclosed.
+	if token.OrgID != org_id {
+		return nil, erratic.NewNotFoundError(erratic.AuthModule, "token").AddHint("id", req.Msg.GetId())
+	}
+
+	if token.Kind != keys.TokenKindPersonal || token.UserID != user_id {
+		granted, err := granted(ctx, user_id, org_id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !granted[rbac.PermissionOrgWrite] {
+			return nil, erratic.NewAuthzError(erratic.AuthModule).WithReason("token is not owned by the user")
+		}
+	}
+
+	if err := keys.RevokeToken(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// NewTokenServiceHandler creates a new TokenServiceHandler and returns the service name and handler.
+func NewTokenServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	rbac.Declare(TokenServicePolicy)
+
+	return authv1connect.NewTokenServiceHandler(&TokenService{}, opts...)
+}
+
+// granted returns the permissions granted to the user by its roles within the org.
+func granted(ctx context.Context, user_id, org_id uuid.UUID) (map[rbac.Permission]bool, error) {
+	roles, err := rbac.Roles(ctx, user_id, org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	return rbac.Grants(roles...), nil
+}
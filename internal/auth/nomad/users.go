@@ -2,14 +2,22 @@ package nomad
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
+	"go.breu.io/quantm/internal/audit"
 	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/auth/domains"
+	"go.breu.io/quantm/internal/auth/keys"
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/auth/sessions"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/erratic"
@@ -25,13 +33,39 @@ type (
 	}
 )
 
+const (
+	// MinutesPerDay bounds the quiet hours of the notification preferences, given in minutes since midnight.
+	MinutesPerDay = 24 * 60
+)
+
 var (
 	NoOrgUUID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
 )
 
+var (
+	// UserServicePolicy declares the permissions required by the procedures of the UserService. The UserService is
+	// called by the backend of the web app, with a service key.
+	UserServicePolicy = rbac.Policy{
+		authv1connect.UserServiceCreateUserProcedure:                 {rbac.PermissionUsersWrite},
+		authv1connect.UserServiceGetUserByProviderAccountProcedure:   {rbac.PermissionUsersRead},
+		authv1connect.UserServiceGetUserByEmailProcedure:             {rbac.PermissionUsersRead},
+		authv1connect.UserServiceGetUserByIDProcedure:                {rbac.PermissionUsersRead},
+		authv1connect.UserServiceUpdateUserProcedure:                 {rbac.PermissionUsersWrite},
+		authv1connect.UserServiceGetDigestSubscriptionProcedure:      {rbac.PermissionUsersRead},
+		authv1connect.UserServiceSetDigestSubscriptionProcedure:      {rbac.PermissionUsersWrite},
+		authv1connect.UserServiceGetNotificationPreferencesProcedure: {rbac.PermissionUsersRead},
+		authv1connect.UserServiceSetNotificationPreferencesProcedure: {rbac.PermissionUsersWrite},
+		authv1connect.UserServiceAcceptInvitationProcedure:           {rbac.PermissionUsersWrite},
+		authv1connect.UserServiceSetUserActiveProcedure:              {rbac.PermissionUsersWrite},
+	}
+)
+
 // CreateUser creates a new user on the platform.
-// If the organization with the given domain does not exist, it is created.
-// The first user of an organization is an administrator, subsequent users are assigned the "member" role.
+// A user with an invitation joins the organization of the invitation, with the role of the invitation. The organization
+// is the default organization of the user, see AuthUser.
+// Otherwise, if the organization with the given domain does not exist, it is created.
+// The first user of an organization is an administrator, subsequent users are assigned the "member" role, unless the
+// organization disabled joining by domain, in which case the user is created without an organization.
 func (s *UserService) CreateUser(
 	ctx context.Context, req *connect.Request[authv1.CreateUserRequest],
 ) (*connect.Response[authv1.AuthUser], error) { // Default public value for new users.
@@ -47,41 +81,64 @@ func (s *UserService) CreateUser(
 
 	defer func() { _ = tx.Rollback(ctx) }() // Rollback is deferred to ensure rollback on error.
 
-	// User sign-up without an organization domain.
-	if domain == "" {
-		params.OrgID = NoOrgUUID
-		user, err := qtx.CreateUser(ctx, params)
+	var org entities.Org
 
+	switch {
+	// User sign-up with an invitation, the invitation is accepted within the transaction.
+	case req.Msg.GetInvitation() != "":
+		invitation, err := keys.VerifyInvitation(ctx, req.Msg.GetInvitation(), req.Msg.GetEmail())
 		if err != nil {
-			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+			return nil, invitation_error(err)
 		}
 
-		return connect.NewResponse(&authv1.AuthUser{User: cast.UserToProto(&user)}), nil
-	}
-
-	// Retrieve the organization associated with the provided domain.
-	org, err := qtx.GetOrgByDomain(ctx, domain)
-	if err != nil {
-		if err != pgx.ErrNoRows {
-			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		if err := keys.AcceptInvitation(ctx, qtx, invitation.ID); err != nil {
+			return nil, invitation_error(err)
 		}
 
-		// Generate a unique slug for the organization based on the domain name.
-		slug := db.CreateSlug(domain)
+		org.ID = invitation.OrgID
+		role = invitation.Role
+
+	// User sign-up without an organization domain.
+	case domain == "":
+		return create_without_org(ctx, tx, qtx, params)
 
-		// Create the organization in the database.
-		org, err = qtx.CreateOrg(ctx, entities.CreateOrgParams{Name: domain, Lower: domain, Slug: slug})
+	default:
+		// Retrieve the organization associated with the provided domain.
+		org, err = qtx.GetOrgByDomain(ctx, domain)
 		if err != nil {
-			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+			if err != pgx.ErrNoRows {
+				return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+			}
+
+			// Generate a unique slug for the organization based on the domain name.
+			slug := db.CreateSlug(domain)
+
+			// Create the organization in the database.
+			org, err = qtx.CreateOrg(ctx, entities.CreateOrgParams{Name: domain, Lower: domain, Slug: slug})
+			if err != nil {
+				return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+			}
+
+			// Create Tables in Clickhouse
+			err = pulse.CreateEventsTable(ctx, slug)
+			if err != nil {
+				return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+			}
+
+			role = "admin" // Assign the "admin" role to the first user of the organization.
+
+			break
 		}
 
-		// Create Tables in Clickhouse
-		err = pulse.CreateEventsTable(ctx, slug)
+		// The organization may only accept invited users.
+		join, err := domains.AutoJoin(ctx, qtx, org.ID)
 		if err != nil {
-			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+			return nil, err
 		}
 
-		role = "admin" // Assign the "admin" role to the first user of the organization.
+		if !join {
+			return create_without_org(ctx, tx, qtx, params)
+		}
 	}
 
 	// Update the user creation parameters with the organization ID.
@@ -93,13 +150,8 @@ func (s *UserService) CreateUser(
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
 	}
 
-	// Assign the appropriate role to the user within the organization.
-	_, err = qtx.CreateUserRole(ctx, entities.CreateUserRoleParams{
-		Name:   role,
-		UserID: user.ID,
-		OrgID:  org.ID,
-	})
-	if err != nil {
+	// Make the user a member of the organization, with the appropriate role.
+	if err := join_org(ctx, qtx, user.ID, org.ID, role); err != nil {
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
 	}
 
@@ -122,6 +174,10 @@ func (s *UserService) CreateUser(
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
 	}
 
+	if err := with_memberships(ctx, proto); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
 	// Return a successful response containing the created user information as a protobuf struct.
 	return connect.NewResponse(proto), nil
 }
@@ -162,6 +218,10 @@ func (s *UserService) GetUserByProviderAccount(
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
 	}
 
+	if err := with_memberships(ctx, proto); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
 	return connect.NewResponse(proto), nil
 }
 
@@ -185,6 +245,10 @@ func (s *UserService) GetUserByEmail(
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
 	}
 
+	if err := with_memberships(ctx, proto); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
 	return connect.NewResponse(proto), nil
 }
 
@@ -208,6 +272,10 @@ func (s *UserService) GetUserByID(
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
 	}
 
+	if err := with_memberships(ctx, proto); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
 	return connect.NewResponse(proto), nil
 }
 
@@ -217,15 +285,362 @@ func (s *UserService) UpdateUser(
 ) (*connect.Response[authv1.UpdateUserResponse], error) {
 	params := cast.ProtoToUpdateUserParams(req.Msg)
 
-	user, err := db.Queries().UpdateUser(ctx, params)
+	tx, qtx, err := db.Transaction(ctx)
 	if err != nil {
 		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
 	}
 
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	before, err := qtx.GetUserByID(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "user_id", params.ID.String())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	user, err := qtx.UpdateUser(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	// the default org of a user is one of their orgs. a change of the default org is recorded in both orgs.
+	orgs := []uuid.UUID{before.OrgID}
+	if user.OrgID != before.OrgID {
+		orgs = append(orgs, user.OrgID)
+
+		if user.OrgID != NoOrgUUID {
+			err := qtx.CreateOrgMember(ctx, entities.CreateOrgMemberParams{UserID: user.ID, OrgID: user.OrgID})
+			if err != nil {
+				return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+			}
+		}
+	}
+
+	for _, org_id := range orgs {
+		err := audit.Record(ctx, qtx, audit.Entry{
+			OrgID:      org_id,
+			Action:     audit.ActionUserUpdate,
+			TargetKind: audit.TargetUser,
+			TargetID:   user.ID.String(),
+			Before:     cast.UserToProto(&before),
+			After:      cast.UserToProto(&user),
+		})
+		if err != nil {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
 	return connect.NewResponse(&authv1.UpdateUserResponse{User: cast.UserToProto(&user)}), nil
 }
 
+// SetUserActive activates or deactivates a user. A deactivated user is rejected by the AuthInterceptor, and their
+// sessions are revoked, so that they stay revoked once the user is activated again.
+func (s *UserService) SetUserActive(
+	ctx context.Context, req *connect.Request[authv1.SetUserActiveRequest],
+) (*connect.Response[authv1.SetUserActiveResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	before, err := qtx.GetUserByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule, "user_id", req.Msg.GetUserId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	user, err := qtx.SetUserActive(ctx, entities.SetUserActiveParams{ID: id, IsActive: req.Msg.GetIsActive()})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	action := audit.ActionUserActivate
+
+	if !user.IsActive {
+		action = audit.ActionUserDeactivate
+
+		if err := sessions.RevokeAll(ctx, qtx, id, time.Now()); err != nil {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+	}
+
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      user.OrgID,
+		Action:     action,
+		TargetKind: audit.TargetUser,
+		TargetID:   id.String(),
+		Before:     cast.UserToProto(&before),
+		After:      cast.UserToProto(&user),
+	})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	sessions.Invalidate(id)
+
+	return connect.NewResponse(&authv1.SetUserActiveResponse{User: cast.UserToProto(&user)}), nil
+}
+
+// AcceptInvitation makes an existing user a member of the organization of the invitation, with the role of the
+// invitation. The organization becomes the default organization of the user, who stays a member of their other
+// organizations.
+func (s *UserService) AcceptInvitation(
+	ctx context.Context, req *connect.Request[authv1.AcceptInvitationRequest],
+) (*connect.Response[authv1.AuthUser], error) {
+	id, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	user, err := db.Queries().GetUserByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, erratic.NewNotFoundError(erratic.AuthModule).AddHint("user_id", req.Msg.GetUserId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	invitation, err := keys.VerifyInvitation(ctx, req.Msg.GetInvitation(), user.Email)
+	if err != nil {
+		return nil, invitation_error(err)
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := keys.AcceptInvitation(ctx, qtx, invitation.ID); err != nil {
+		return nil, invitation_error(err)
+	}
+
+	if err := join_org(ctx, qtx, user.ID, invitation.OrgID, invitation.Role); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if _, err := qtx.SetUserOrg(ctx, entities.SetUserOrgParams{ID: user.ID, OrgID: invitation.OrgID}); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	details, err := db.Queries().GetAuthUserByID(ctx, user.ID)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	proto, err := cast.AuthUserQueryResponseToProto(
+		details.User, details.Org, details.Roles, details.OauthAccounts, details.Teams,
+	)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := with_memberships(ctx, proto); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(proto), nil
+}
+
+// GetDigestSubscription retrieves the email digest subscription of a user. If the user never subscribed, the default
+// subscription is returned, which is opted out.
+func (s *UserService) GetDigestSubscription(
+	ctx context.Context, req *connect.Request[authv1.GetDigestSubscriptionRequest],
+) (*connect.Response[authv1.GetDigestSubscriptionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	digest, err := db.Queries().GetUserDigest(ctx, id)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+
+		digest = entities.UserDigest{UserID: id, Frequency: cast.DigestFrequencyWeekly}
+	}
+
+	return connect.NewResponse(&authv1.GetDigestSubscriptionResponse{Subscription: cast.UserDigestToProto(&digest)}), nil
+}
+
+// SetDigestSubscription opts the user in or out of the email digest.
+func (s *UserService) SetDigestSubscription(
+	ctx context.Context, req *connect.Request[authv1.SetDigestSubscriptionRequest],
+) (*connect.Response[authv1.SetDigestSubscriptionResponse], error) {
+	if _, err := uuid.Parse(req.Msg.GetUserId()); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	digest, err := db.Queries().SetUserDigest(ctx, cast.ProtoToSetUserDigestParams(req.Msg))
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.SetDigestSubscriptionResponse{Subscription: cast.UserDigestToProto(&digest)}), nil
+}
+
+// GetNotificationPreferences retrieves the notification preferences of a user. If the user never saved any, the
+// defaults are returned.
+func (s *UserService) GetNotificationPreferences(
+	ctx context.Context, req *connect.Request[authv1.GetNotificationPreferencesRequest],
+) (*connect.Response[authv1.GetNotificationPreferencesResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("user_id", req.Msg.GetUserId())
+	}
+
+	prefs, err := db.Queries().GetUserNotificationPreferences(ctx, id)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+		}
+
+		prefs = entities.UserNotificationPreference{
+			UserID:   id,
+			Kinds:    []string{cast.NotificationKindLinesExceeded, cast.NotificationKindMergeConflict, cast.NotificationKindMergeConflictResolved},
+			Channel:  cast.NotificationChannelDM,
+			Timezone: "UTC",
+		}
+	}
+
+	return connect.NewResponse(
+		&authv1.GetNotificationPreferencesResponse{Preferences: cast.UserNotificationPreferenceToProto(&prefs)},
+	), nil
+}
+
+// SetNotificationPreferences updates the notification preferences of a user.
+func (s *UserService) SetNotificationPreferences(
+	ctx context.Context, req *connect.Request[authv1.SetNotificationPreferencesRequest],
+) (*connect.Response[authv1.SetNotificationPreferencesResponse], error) {
+	prefs := req.Msg.GetPreferences()
+
+	if _, err := uuid.Parse(prefs.GetUserId()); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("user_id", prefs.GetUserId())
+	}
+
+	if _, err := time.LoadLocation(prefs.GetTimezone()); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("timezone", prefs.GetTimezone())
+	}
+
+	if !is_minute_of_day(prefs.GetQuietStart()) {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("quiet_start", strconv.Itoa(int(prefs.GetQuietStart())))
+	}
+
+	if !is_minute_of_day(prefs.GetQuietEnd()) {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).AddHint("quiet_end", strconv.Itoa(int(prefs.GetQuietEnd())))
+	}
+
+	saved, err := db.Queries().SetUserNotificationPreferences(ctx, cast.ProtoToSetUserNotificationPreferencesParams(req.Msg))
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(
+		&authv1.SetNotificationPreferencesResponse{Preferences: cast.UserNotificationPreferenceToProto(&saved)},
+	), nil
+}
+
+// is_minute_of_day returns true if the minute is a valid minute since midnight, i.e. the start or the end of quiet hours.
+func is_minute_of_day(minute int32) bool {
+	return minute >= 0 && minute < MinutesPerDay
+}
+
+// create_without_org creates the user without an organization, and commits the transaction.
+func create_without_org(
+	ctx context.Context, tx pgx.Tx, qtx *entities.Queries, params entities.CreateUserParams,
+) (*connect.Response[authv1.AuthUser], error) {
+	params.OrgID = NoOrgUUID
+
+	user, err := qtx.CreateUser(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.AuthUser{User: cast.UserToProto(&user)}), nil
+}
+
+// join_org makes the user a member of the org, with the role. The roles of an existing member are replaced.
+func join_org(ctx context.Context, qtx *entities.Queries, user_id, org_id uuid.UUID, role string) error {
+	if err := qtx.CreateOrgMember(ctx, entities.CreateOrgMemberParams{UserID: user_id, OrgID: org_id}); err != nil {
+		return err
+	}
+
+	if err := qtx.DeleteUserRoles(ctx, entities.DeleteUserRolesParams{UserID: user_id, OrgID: org_id}); err != nil {
+		return err
+	}
+
+	_, err := qtx.CreateUserRole(ctx, entities.CreateUserRoleParams{Name: role, UserID: user_id, OrgID: org_id})
+
+	return err
+}
+
+// with_memberships sets the orgs of the user, and the roles of the user within them.
+func with_memberships(ctx context.Context, proto *authv1.AuthUser) error {
+	id, err := uuid.Parse(proto.GetUser().GetId())
+	if err != nil {
+		return err
+	}
+
+	orgs, err := db.Queries().ListUserOrgs(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	roles, err := db.Queries().ListUserRoles(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	proto.Memberships = cast.MembershipsToProto(orgs, roles)
+
+	return nil
+}
+
+// invitation_error maps the errors of an invitation to a bad request. Database errors are returned as is.
+func invitation_error(err error) error {
+	var qe *erratic.QuantmError
+	if errors.As(err, &qe) {
+		return qe
+	}
+
+	return erratic.NewBadRequestError(erratic.AuthModule).WithReason(err.Error())
+}
+
 // NewUserSericeServiceHandler creates a new UserServiceHandler instance and returns the service name and handler.
 func NewUserSericeServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	rbac.Declare(UserServicePolicy)
+
 	return authv1connect.NewUserServiceHandler(&UserService{}, opts...)
 }
@@ -0,0 +1,123 @@
+package rbac
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+var (
+	policy = make(Policy)
+	mu     sync.RWMutex
+)
+
+// Declare adds the permissions required by the procedures of a service. It is called by the handler constructors, so
+// that the policy of a service lives next to its handler.
+func Declare(declared Policy) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for procedure, permissions := range declared {
+		policy[procedure] = permissions
+	}
+}
+
+// Required returns the permissions required by the procedure, and false if the procedure has no declared policy.
+func Required(procedure string) ([]Permission, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	permissions, ok := policy[procedure]
+
+	return permissions, ok
+}
+
+// Authorize checks that the roles grant all the permissions required by the procedure.
+func Authorize(procedure string, roles ...Role) error {
+	return authorize(procedure, Grants(roles...))
+}
+
+// AuthorizeScopes checks that the scopes of a service key include all the permissions required by the procedure.
+func AuthorizeScopes(procedure string, scopes ...Permission) error {
+	granted := make(map[Permission]bool)
+
+	for _, scope := range scopes {
+		if IsScope(scope) {
+			granted[scope] = true
+		}
+	}
+
+	return authorize(procedure, granted)
+}
+
+// AuthorizeToken checks a personal access token or an org API key. The permissions granted by the roles are
+// restricted to the scopes of the token. An org API key acts with the roles of an org admin.
+func AuthorizeToken(procedure string, scopes []Permission, roles ...Role) error {
+	granted := Grants(roles...)
+
+	for permission := range granted {
+		if !IsTokenScope(permission) || !slices.Contains(scopes, permission) {
+			delete(granted, permission)
+		}
+	}
+
+	return authorize(procedure, granted)
+}
+
+// Roles resolves the roles of the user within the org.
+func Roles(ctx context.Context, user_id, org_id uuid.UUID) ([]Role, error) {
+	names, err := db.Queries().ListUserRoleNames(ctx, entities.ListUserRoleNamesParams{UserID: user_id, OrgID: org_id})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to get user roles").Wrap(err)
+	}
+
+	teams, err := db.Queries().ListTeamRolesByUserID(ctx, entities.ListTeamRolesByUserIDParams{UserID: user_id, OrgID: org_id})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to get team roles").Wrap(err)
+	}
+
+	roles := make([]Role, 0, len(names)+len(teams))
+
+	for _, name := range names {
+		roles = append(roles, OrgRole(name))
+	}
+
+	for _, role := range teams {
+		roles = append(roles, TeamRole(role))
+	}
+
+	return roles, nil
+}
+
+func authorize(procedure string, granted map[Permission]bool) error {
+	required, ok := Required(procedure)
+	if !ok {
+		return erratic.NewAuthzError(erratic.AuthModule).
+			WithResource(procedure).
+			WithReason("no policy declared for procedure")
+	}
+
+	missing := make([]string, 0)
+
+	for _, permission := range required {
+		if !granted[permission] {
+			missing = append(missing, string(permission))
+		}
+	}
+
+	if len(missing) > 0 {
+		return erratic.NewAuthzError(erratic.AuthModule).
+			WithResource(procedure).
+			WithReason("missing permission").
+			WithHint("missing", strings.Join(missing, ","))
+	}
+
+	return nil
+}
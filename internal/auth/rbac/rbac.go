@@ -0,0 +1,162 @@
+// Package rbac is the authorization layer of the nomad API.
+//
+// Each service declares the permissions required by its procedures, next to its handler. The roles of the
+// authenticated user are resolved from the user_roles and team_users tables, and mapped to permissions. A procedure
+// without declared permissions is denied.
+package rbac
+
+import (
+	"slices"
+
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	// Permission is an action on a kind of resource of the org, e.g. repos:write.
+	Permission string
+
+	// Role is a role of a user within the org. Org roles come from user_roles, team roles from the team_role enum.
+	Role string
+
+	// Policy maps a connect procedure to the permissions it requires. All the permissions are required.
+	Policy map[string][]Permission
+)
+
+const (
+	PermissionOrgRead       Permission = "org:read"
+	PermissionOrgWrite      Permission = "org:write"
+	PermissionReposRead     Permission = "repos:read"
+	PermissionReposWrite    Permission = "repos:write"
+	PermissionHooksWrite    Permission = "hooks:write"
+	PermissionWebhooksRead  Permission = "webhooks:read"
+	PermissionWebhooksWrite Permission = "webhooks:write"
+	PermissionEventsRead    Permission = "events:read"
+	PermissionTeamsRead     Permission = "teams:read"
+	PermissionTeamsWrite    Permission = "teams:write"
+	PermissionAuditRead     Permission = "audit:read"
+)
+
+// Permissions to manage the personal access tokens of the user, and the API keys of the org. They cannot be given to a
+// token, so that a token cannot create tokens.
+const (
+	PermissionTokensRead  Permission = "tokens:read"
+	PermissionTokensWrite Permission = "tokens:write"
+)
+
+// Permissions to manage the sessions of the user. They cannot be given to a token, a token is not a session.
+const (
+	PermissionSessionsRead  Permission = "sessions:read"
+	PermissionSessionsWrite Permission = "sessions:write"
+)
+
+// Permissions of the procedures called by the backend of the web app. They are not granted to any role, only service
+// keys carry them as scopes.
+const (
+	PermissionUsersRead     Permission = "users:read"
+	PermissionUsersWrite    Permission = "users:write"
+	PermissionAccountsRead  Permission = "accounts:read"
+	PermissionAccountsWrite Permission = "accounts:write"
+)
+
+const (
+	RoleOrgAdmin   Role = "org:admin"
+	RoleOrgMember  Role = "org:member"
+	RoleTeamAdmin  Role = "team:admin"
+	RoleTeamMember Role = "team:member"
+)
+
+var (
+	// member is granted to every member of the org.
+	member = []Permission{
+		PermissionOrgRead,
+		PermissionReposRead,
+		PermissionEventsRead,
+		PermissionTeamsRead,
+		PermissionTokensRead,
+		PermissionTokensWrite,
+		PermissionSessionsRead,
+		PermissionSessionsWrite,
+	}
+
+	// grants maps the roles to their permissions. The grants apply to the whole org, so a team admin is granted no more
+	// than a member: write access to repos and teams would reach the repos and teams of the other teams too.
+	grants = map[Role][]Permission{
+		RoleOrgAdmin: {
+			PermissionOrgRead,
+			PermissionOrgWrite,
+			PermissionReposRead,
+			PermissionReposWrite,
+			PermissionHooksWrite,
+			PermissionWebhooksRead,
+			PermissionWebhooksWrite,
+			PermissionEventsRead,
+			PermissionTeamsRead,
+			PermissionTeamsWrite,
+			PermissionAuditRead,
+			PermissionTokensRead,
+			PermissionTokensWrite,
+			PermissionSessionsRead,
+			PermissionSessionsWrite,
+		},
+		RoleOrgMember:  member,
+		RoleTeamAdmin:  member,
+		RoleTeamMember: member,
+	}
+
+	// scopes are the permissions that can be given to a service key. Service keys act on behalf of no user, so they
+	// cannot be scoped to procedures that read the user or the org from the auth context.
+	scopes = []Permission{
+		PermissionUsersRead,
+		PermissionUsersWrite,
+		PermissionAccountsRead,
+		PermissionAccountsWrite,
+		PermissionOrgWrite,
+		PermissionSessionsWrite,
+	}
+)
+
+// OrgRole maps the name of a user_roles row to a role. Unknown names are org members.
+func OrgRole(name string) Role {
+	if name == "admin" {
+		return RoleOrgAdmin
+	}
+
+	return RoleOrgMember
+}
+
+// TeamRole maps a team_role to a role.
+func TeamRole(role entities.TeamRole) Role {
+	if role == entities.TeamRoleAdmin {
+		return RoleTeamAdmin
+	}
+
+	return RoleTeamMember
+}
+
+// Grants returns the set of permissions granted by the roles.
+func Grants(roles ...Role) map[Permission]bool {
+	granted := make(map[Permission]bool)
+
+	for _, role := range roles {
+		for _, permission := range grants[role] {
+			granted[permission] = true
+		}
+	}
+
+	return granted
+}
+
+// IsScope reports whether the permission can be given to a service key.
+func IsScope(permission Permission) bool {
+	return slices.Contains(scopes, permission)
+}
+
+// IsTokenScope reports whether the permission can be given to a personal access token or an org API key.
+func IsTokenScope(permission Permission) bool {
+	switch permission {
+	case PermissionTokensRead, PermissionTokensWrite, PermissionSessionsRead, PermissionSessionsWrite:
+		return false
+	}
+
+	return slices.Contains(grants[RoleOrgAdmin], permission)
+}
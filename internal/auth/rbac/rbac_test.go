@@ -0,0 +1,101 @@
+package rbac_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/auth/rbac"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+type (
+	RBACTestSuite struct {
+		suite.Suite
+	}
+)
+
+const (
+	read  = "/test.v1.Service/Read"
+	write = "/test.v1.Service/Write"
+	admin = "/test.v1.Service/Admin"
+)
+
+func (s *RBACTestSuite) SetupSuite() {
+	rbac.Declare(rbac.Policy{
+		read:  {rbac.PermissionReposRead},
+		write: {rbac.PermissionReposRead, rbac.PermissionReposWrite},
+		admin: {rbac.PermissionOrgWrite},
+	})
+}
+
+func (s *RBACTestSuite) TestRoles() {
+	s.Equal(rbac.RoleOrgAdmin, rbac.OrgRole("admin"))
+	s.Equal(rbac.RoleOrgMember, rbac.OrgRole("member"))
+	s.Equal(rbac.RoleTeamAdmin, rbac.TeamRole(entities.TeamRoleAdmin))
+	s.Equal(rbac.RoleTeamMember, rbac.TeamRole(entities.TeamRoleMember))
+}
+
+func (s *RBACTestSuite) TestOrgAdmin() {
+	s.NoError(rbac.Authorize(read, rbac.RoleOrgAdmin))
+	s.NoError(rbac.Authorize(write, rbac.RoleOrgAdmin))
+	s.NoError(rbac.Authorize(admin, rbac.RoleOrgAdmin))
+}
+
+func (s *RBACTestSuite) TestTeamAdmin() {
+	// the grants are org wide, a team admin cannot write to the repos of the other teams.
+	s.NoError(rbac.Authorize(read, rbac.RoleOrgMember, rbac.RoleTeamAdmin))
+	s.Error(rbac.Authorize(write, rbac.RoleOrgMember, rbac.RoleTeamAdmin))
+	s.Error(rbac.Authorize(admin, rbac.RoleOrgMember, rbac.RoleTeamAdmin))
+}
+
+func (s *RBACTestSuite) TestMember() {
+	s.NoError(rbac.Authorize(read, rbac.RoleOrgMember, rbac.RoleTeamMember))
+
+	err := rbac.Authorize(write, rbac.RoleOrgMember, rbac.RoleTeamMember)
+	qerr := &erratic.QuantmError{}
+
+	s.Require().True(errors.As(err, &qerr))
+
+	module, code := erratic.Decompose(qerr.Code)
+
+	s.Equal(erratic.AuthModule, module)
+	s.Equal(erratic.CodePermissionDenied, code)
+	s.Equal("repos:write", qerr.Hints["missing"])
+	s.Equal(write, qerr.Hints["resource"])
+}
+
+func (s *RBACTestSuite) TestScopes() {
+	s.NoError(rbac.AuthorizeScopes(admin, rbac.PermissionOrgWrite))
+	s.Error(rbac.AuthorizeScopes(read, rbac.PermissionUsersRead))
+
+	// repos:read is not a scope, service keys cannot act on behalf of a user.
+	s.Error(rbac.AuthorizeScopes(read, rbac.PermissionReposRead))
+}
+
+func (s *RBACTestSuite) TestToken() {
+	// a personal access token is restricted to the roles of the user, and to its scopes.
+	s.NoError(rbac.AuthorizeToken(read, []rbac.Permission{rbac.PermissionReposRead}, rbac.RoleOrgMember))
+	s.Error(rbac.AuthorizeToken(write, []rbac.Permission{rbac.PermissionReposRead, rbac.PermissionReposWrite}, rbac.RoleOrgMember))
+	s.Error(rbac.AuthorizeToken(write, []rbac.Permission{rbac.PermissionReposRead}, rbac.RoleOrgAdmin))
+
+	// tokens cannot manage tokens, nor sessions.
+	s.False(rbac.IsTokenScope(rbac.PermissionTokensWrite))
+	s.False(rbac.IsTokenScope(rbac.PermissionSessionsWrite))
+	s.False(rbac.IsTokenScope(rbac.PermissionUsersRead))
+	s.True(rbac.IsTokenScope(rbac.PermissionReposRead))
+}
+
+func (s *RBACTestSuite) TestNoRoles() {
+	s.Error(rbac.Authorize(read))
+}
+
+func (s *RBACTestSuite) TestUndeclared() {
+	s.Error(rbac.Authorize("/test.v1.Service/Undeclared", rbac.RoleOrgAdmin))
+}
+
+func TestRBAC(t *testing.T) {
+	suite.Run(t, new(RBACTestSuite))
+}
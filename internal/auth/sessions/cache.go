@@ -0,0 +1,76 @@
+package sessions
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// cached is the result of a validation, kept until it expires.
+	cached struct {
+		user_id uuid.UUID
+		err     error
+		expires time.Time
+	}
+
+	// cache is a bounded set of the recent validations, by key. The validations of a user are dropped together, see
+	// Invalidate.
+	cache struct {
+		mu      sync.RWMutex
+		entries map[string]cached
+		size    int
+		ttl     time.Duration
+	}
+)
+
+func newcache(size int, ttl time.Duration) *cache {
+	return &cache{entries: make(map[string]cached), size: size, ttl: ttl}
+}
+
+// get returns the validation of the key, and false if it is not cached or expired.
+func (c *cache) get(key string, now time.Time) (cached, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || now.After(entry.expires) {
+		return cached{}, false
+	}
+
+	return entry, true
+}
+
+// set caches the result of the validation of the key. When the cache is full, the expired entries are dropped, and
+// all the entries if none has expired.
+func (c *cache) set(key string, user_id uuid.UUID, err error, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= c.size {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+
+		if len(c.entries) >= c.size {
+			clear(c.entries)
+		}
+	}
+
+	c.entries[key] = cached{user_id: user_id, err: err, expires: now.Add(c.ttl)}
+}
+
+// drop removes the entries of the user.
+func (c *cache) drop(user_id uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, entry := range c.entries {
+		if entry.user_id == user_id {
+			delete(c.entries, k)
+		}
+	}
+}
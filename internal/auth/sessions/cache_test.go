@@ -0,0 +1,75 @@
+package sessions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	CacheTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *CacheTestSuite) TestExpiry() {
+	c := newcache(10, time.Minute)
+	now := time.Now()
+
+	c.set("session:a", uuid.New(), ErrRevoked, now)
+
+	entry, ok := c.get("session:a", now.Add(time.Second))
+	s.True(ok)
+	s.ErrorIs(entry.err, ErrRevoked)
+
+	_, ok = c.get("session:a", now.Add(2*time.Minute))
+	s.False(ok)
+}
+
+func (s *CacheTestSuite) TestDrop() {
+	c := newcache(10, time.Minute)
+	now := time.Now()
+	user, other := uuid.New(), uuid.New()
+
+	c.set("session:a", user, nil, now)
+	c.set("user:"+user.String(), user, nil, now)
+	c.set("session:b", other, nil, now)
+
+	c.drop(user)
+
+	_, ok := c.get("session:a", now)
+	s.False(ok)
+
+	_, ok = c.get("user:"+user.String(), now)
+	s.False(ok)
+
+	_, ok = c.get("session:b", now)
+	s.True(ok)
+}
+
+func (s *CacheTestSuite) TestBounded() {
+	c := newcache(2, time.Minute)
+	now := time.Now()
+
+	c.set("a", uuid.New(), nil, now)
+	c.set("b", uuid.New(), nil, now.Add(2*time.Minute))
+
+	// a has expired, it makes room for c.
+	c.set("c", uuid.New(), nil, now.Add(2*time.Minute))
+	s.Len(c.entries, 2)
+
+	// none has expired, the cache is reset.
+	c.set("d", uuid.New(), nil, now.Add(2*time.Minute))
+	s.Len(c.entries, 1)
+}
+
+func (s *CacheTestSuite) TestTruncate() {
+	s.Equal("abc", truncate("abc", 5))
+	s.Equal("ab", truncate("abé", 3))
+}
+
+func TestCache(t *testing.T) {
+	suite.Run(t, new(CacheTestSuite))
+}
@@ -0,0 +1,232 @@
+// Package sessions is the registry of the sessions of the users. A session is a JWE issued by the web app, or by the
+// single sign-on of an org, identified by its jti claim. Sessions are registered on first use, and can be revoked one
+// by one, or all together for a user, including the sessions issued before the revocation but not used yet. A session
+// is within an org of its user, and is rejected once the user is removed from the org.
+//
+// The validations are cached for CacheTTL, so that the registry is not queried on every request. A revocation is
+// applied at once on the replica revoking the session, see Invalidate, and within CacheTTL on the other replicas.
+package sessions
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth/config"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+const (
+	// CacheTTL is the duration a validation is cached for.
+	CacheTTL = 30 * time.Second
+
+	// cache_size is the maximum number of cached validations.
+	cache_size = 10000
+)
+
+var (
+	ErrNoSession = errors.New("token has no session id")
+	ErrRevoked   = errors.New("session revoked")
+	ErrInactive  = errors.New("user is inactive")
+	ErrNotMember = errors.New("user is not a member of the org")
+)
+
+var (
+	validations = newcache(cache_size, CacheTTL)
+)
+
+type (
+	// Client is the client using a session, recorded when the session is registered.
+	Client struct {
+		IP        string
+		UserAgent string
+	}
+)
+
+// Validate registers the session of the claims on first use, and returns an error if the session has been revoked, if
+// its user has been deactivated, or if its user is not a member of its org.
+func Validate(ctx context.Context, claims *config.Claims, client Client) error {
+	if claims.ID == "" {
+		return ErrNoSession
+	}
+
+	user_id, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return ErrNoSession
+	}
+
+	now := time.Now()
+
+	if entry, ok := validations.get("session:"+claims.ID, now); ok {
+		return entry.err
+	}
+
+	err = validate(ctx, claims, user_id, client)
+	if err != nil && !rejected(err) {
+		return err
+	}
+
+	validations.set("session:"+claims.ID, user_id, err, now)
+
+	return err
+}
+
+// Active returns ErrInactive if the user has been deactivated, and ErrNotMember if the user is not a member of the org.
+// It is the validation of the personal access tokens, which act as their user within their org.
+func Active(ctx context.Context, user_id, org_id uuid.UUID) error {
+	now := time.Now()
+	key := "user:" + user_id.String() + ":" + org_id.String()
+
+	if entry, ok := validations.get(key, now); ok {
+		return entry.err
+	}
+
+	err := active(ctx, user_id, org_id)
+	if err != nil && !rejected(err) {
+		return err
+	}
+
+	validations.set(key, user_id, err, now)
+
+	return err
+}
+
+// Issue returns a new session of the user within the org, expiring at the expiry. The session is registered on first
+// use, like the sessions of the web app.
+func Issue(user_id, org_id uuid.UUID, sso bool, now, expiry time.Time) (string, error) {
+	claims := config.Claims{
+		Claims: jwt.Claims{
+			ID:       uuid.NewString(),
+			Subject:  user_id.String(),
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(expiry),
+		},
+		UserID: user_id.String(),
+		OrgID:  org_id.String(),
+		SSO:    sso,
+	}
+
+	return config.EncodeJWE(config.Secret(), config.JWTEncodeParams{Claims: claims, MaxAge: expiry.Sub(now)})
+}
+
+// RevokeAll revokes the sessions of the user, and the sessions issued before now but not registered yet. It must be
+// called with the queries of a transaction, and followed by Invalidate once committed.
+func RevokeAll(ctx context.Context, q *entities.Queries, user_id uuid.UUID, now time.Time) error {
+	if err := q.RevokeUserSessions(ctx, user_id); err != nil {
+		return err
+	}
+
+	return q.SetSessionRevocation(ctx, entities.SetSessionRevocationParams{UserID: user_id, RevokedBefore: now})
+}
+
+// Invalidate drops the cached validations of the user. It must be called when a session of the user is revoked, when
+// the user is deactivated, or when the user is removed from an org.
+func Invalidate(user_id uuid.UUID) {
+	validations.drop(user_id)
+}
+
+func validate(ctx context.Context, claims *config.Claims, user_id uuid.UUID, client Client) error {
+	org_id, err := uuid.Parse(claims.OrgID)
+	if err != nil {
+		return ErrNotMember
+	}
+
+	if err := active(ctx, user_id, org_id); err != nil {
+		return err
+	}
+
+	params := entities.RegisterSessionParams{
+		Jti:       claims.ID,
+		UserID:    user_id,
+		OrgID:     org_id,
+		IssuedAt:  time.Unix(0, 0),
+		ExpiresAt: claims.Expiry.Time(),
+		Ip:        client.IP,
+		UserAgent: truncate(client.UserAgent, 512),
+		IsSso:     claims.SSO,
+	}
+
+	if claims.IssuedAt != nil {
+		params.IssuedAt = claims.IssuedAt.Time()
+	}
+
+	session, err := db.Queries().RegisterSession(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if session.IsRevoked {
+		return ErrRevoked
+	}
+
+	revoked_before, err := db.Queries().GetSessionRevocation(ctx, user_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	if session.IssuedAt.Before(revoked_before) {
+		if err := db.Queries().RevokeSession(ctx, session.ID); err != nil {
+			return err
+		}
+
+		return ErrRevoked
+	}
+
+	return nil
+}
+
+// active checks that the user is active, and a member of the org. The default org of a user is one of their orgs, or
+// the placeholder of the users without an org, so that it is not looked up.
+func active(ctx context.Context, user_id, org_id uuid.UUID) error {
+	user, err := db.Queries().GetUserByID(ctx, user_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInactive
+		}
+
+		return err
+	}
+
+	if !user.IsActive {
+		return ErrInactive
+	}
+
+	if user.OrgID == org_id {
+		return nil
+	}
+
+	member, err := db.Queries().IsOrgMember(ctx, entities.IsOrgMemberParams{UserID: user_id, OrgID: org_id})
+	if err != nil {
+		return err
+	}
+
+	if !member {
+		return ErrNotMember
+	}
+
+	return nil
+}
+
+// rejected reports whether the error rejects the session, rather than failing to validate it.
+func rejected(err error) bool {
+	return errors.Is(err, ErrRevoked) || errors.Is(err, ErrInactive) || errors.Is(err, ErrNotMember)
+}
+
+// truncate cuts the value to the size, without splitting a character.
+func truncate(value string, size int) string {
+	if len(value) > size {
+		return strings.ToValidUTF8(value[:size], "")
+	}
+
+	return value
+}
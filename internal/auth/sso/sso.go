@@ -0,0 +1,308 @@
+// Package sso implements the single sign-on of the members of an org with the OIDC provider of the org.
+//
+// The login is the authorization code flow. The provider is discovered from its issuer, the code is exchanged for an
+// ID token with the client credentials of the org, and the ID token is verified against the keys of the provider. The
+// session is the JWE of the web app, marked as created by the single sign-on, see config.Claims. An org requiring the
+// single sign-on rejects the other sessions of its members.
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth/sessions"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+const (
+	// SessionTTL is the lifetime of a session, the default max age of the sessions of Auth.js.
+	SessionTTL = 30 * 24 * time.Hour
+
+	// AccountType is the type of the oauth_accounts of the users signed in with the single sign-on.
+	AccountType = "oidc"
+)
+
+var (
+	ErrDiscovery = errors.New("unable to discover the provider")
+	ErrExchange  = errors.New("unable to exchange the code")
+	ErrToken     = errors.New("invalid id token")
+	ErrNonce     = errors.New("nonce does not match")
+	ErrEmail     = errors.New("email is not verified")
+	ErrDomain    = errors.New("email domain is not allowed")
+	ErrUnlinked  = errors.New("email domain is not verified for the org")
+)
+
+var (
+	// algorithms are the signature algorithms accepted for ID tokens.
+	algorithms = []jose.SignatureAlgorithm{jose.RS256, jose.RS384, jose.RS512, jose.ES256, jose.ES384, jose.PS256}
+
+	client = &http.Client{Timeout: 10 * time.Second}
+)
+
+type (
+	// Discovery is the subset of the OpenID provider metadata used for the login.
+	Discovery struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+
+	// Provider is the OIDC provider of an org.
+	Provider struct {
+		config    *entities.OrgSsoConfig
+		discovery *Discovery
+		redirect  string
+	}
+
+	// Identity are the claims of a verified ID token.
+	Identity struct {
+		jwt.Claims
+		Nonce         string `json:"nonce"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		GivenName     string `json:"given_name"`
+		FamilyName    string `json:"family_name"`
+	}
+)
+
+// Discover fetches the metadata of the provider of the issuer.
+func Discover(ctx context.Context, issuer string) (*Discovery, error) {
+	endpoint := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+
+	discovery := &Discovery{}
+	if err := fetch(ctx, endpoint, discovery); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDiscovery, err)
+	}
+
+	if discovery.Issuer != issuer {
+		return nil, fmt.Errorf("%w: issuer %q does not match %q", ErrDiscovery, discovery.Issuer, issuer)
+	}
+
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: incomplete metadata", ErrDiscovery)
+	}
+
+	return discovery, nil
+}
+
+// NewProvider discovers the provider of the org. The redirect is the URL of the callback, registered at the provider.
+func NewProvider(ctx context.Context, cfg *entities.OrgSsoConfig, redirect string) (*Provider, error) {
+	discovery, err := Discover(ctx, cfg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{config: cfg, discovery: discovery, redirect: redirect}, nil
+}
+
+// AuthCodeURL returns the URL of the login at the provider.
+func (p *Provider) AuthCodeURL(state, nonce string) string {
+	params := url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.config.ClientID},
+		"redirect_uri":  {p.redirect},
+		"scope":         {"openid email profile"},
+		"state":         {state},
+		"nonce":         {nonce},
+	}
+
+	sep := "?"
+	if strings.Contains(p.discovery.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+
+	return p.discovery.AuthorizationEndpoint + sep + params.Encode()
+}
+
+// Exchange exchanges the authorization code for the raw ID token.
+func (p *Provider) Exchange(ctx context.Context, code string) (string, error) {
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {p.redirect},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExchange, err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret.String()))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExchange, err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w: status %d", ErrExchange, res.StatusCode)
+	}
+
+	body := struct {
+		IDToken string `json:"id_token"`
+	}{}
+
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(&body); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExchange, err)
+	}
+
+	if body.IDToken == "" {
+		return "", fmt.Errorf("%w: no id token", ErrExchange)
+	}
+
+	return body.IDToken, nil
+}
+
+// Verify verifies the signature, the issuer, the audience, the expiry and the nonce of the ID token, and returns its
+// claims.
+func (p *Provider) Verify(ctx context.Context, raw, nonce string, now time.Time) (*Identity, error) {
+	token, err := jwt.ParseSigned(raw, algorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrToken, err)
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	if err := fetch(ctx, p.discovery.JWKSURI, keys); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrToken, err)
+	}
+
+	identity := &Identity{}
+	if err := token.Claims(keys, identity); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrToken, err)
+	}
+
+	expected := jwt.Expected{
+		Issuer:      p.discovery.Issuer,
+		AnyAudience: jwt.Audience{p.config.ClientID},
+		Time:        now,
+	}
+
+	if err := identity.ValidateWithLeeway(expected, jwt.DefaultLeeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrToken, err)
+	}
+
+	if identity.Expiry == nil {
+		return nil, fmt.Errorf("%w: no expiry", ErrToken)
+	}
+
+	if identity.Nonce != nonce {
+		return nil, ErrNonce
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, ErrEmail
+	}
+
+	if !Allowed(p.config, identity.Email) {
+		return nil, ErrDomain
+	}
+
+	return identity, nil
+}
+
+// AccountProvider returns the provider of the oauth_accounts of the users signed in with the provider.
+func (p *Provider) AccountProvider() string {
+	return "sso:" + p.config.OrgID.String()
+}
+
+// Linkable returns an error unless the identity may be linked to the user with its email, or create the user. The email
+// must be verified by the provider, and its domain must be the verified domain of the org, see the domains package. The
+// provider is configured by the admins of the org, it only vouches for the emails of the domain of the org.
+func Linkable(domain *entities.OrgDomain, identity *Identity) error {
+	if identity.Email == "" || !identity.EmailVerified {
+		return ErrEmail
+	}
+
+	if domain == nil || !domain.IsVerified || domain.Domain == "" {
+		return ErrUnlinked
+	}
+
+	at := strings.LastIndex(identity.Email, "@")
+	if at < 0 || !strings.EqualFold(identity.Email[at+1:], domain.Domain) {
+		return ErrUnlinked
+	}
+
+	return nil
+}
+
+// LoginPath returns the path of the login to the org, relative to the nomad API.
+func LoginPath(slug string) string {
+	return "/auth/sso/" + slug + "/login"
+}
+
+// Allowed reports whether the domain of the email is allowed to sign in. Every domain is allowed if the org allows
+// none.
+func Allowed(cfg *entities.OrgSsoConfig, email string) bool {
+	if len(cfg.AllowedDomains) == 0 {
+		return true
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+
+	return slices.Contains(cfg.AllowedDomains, strings.ToLower(email[at+1:]))
+}
+
+// Required reports whether the org requires its members to sign in with the single sign-on.
+func Required(ctx context.Context, org_id uuid.UUID) (bool, error) {
+	required, err := db.Queries().IsOrgSSORequired(ctx, org_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return required, nil
+}
+
+// Session returns the session of the user within the org, see sessions.Issue.
+func Session(user_id, org_id uuid.UUID, now time.Time) (string, error) {
+	return sessions.Issue(user_id, org_id, true, now, now.Add(SessionTTL))
+}
+
+// fetch gets the JSON document at the url.
+func fetch(ctx context.Context, endpoint string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d from %s", res.StatusCode, endpoint)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(out)
+}
@@ -0,0 +1,248 @@
+package sso_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/auth/sso"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	// mock is a local OIDC provider. The token endpoint returns the ID token of the claims of the code.
+	mock struct {
+		server *httptest.Server
+		key    *rsa.PrivateKey
+		codes  map[string]map[string]any
+	}
+
+	SSOTestSuite struct {
+		suite.Suite
+		mock     *mock
+		config   *entities.OrgSsoConfig
+		provider *sso.Provider
+	}
+)
+
+func newmock(t *testing.T) *mock {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &mock{key: key, codes: make(map[string]map[string]any)}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &m.key.PublicKey, KeyID: "mock", Algorithm: "RS256", Use: "sig"}}}
+		_ = json.NewEncoder(w).Encode(set)
+	})
+
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		claims, ok := m.codes[r.FormValue("code")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": m.sign(t, claims)})
+	})
+
+	m.server = httptest.NewServer(mux)
+
+	return m
+}
+
+func (m *mock) sign(t *testing.T, claims map[string]any) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: m.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "mock"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+// code registers the claims of an ID token for the nonce, and returns the code exchanging them.
+func (m *mock) code(nonce string, overrides map[string]any) string {
+	claims := map[string]any{
+		"iss":            m.server.URL,
+		"sub":            "user-1",
+		"aud":            "client",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "jane@example.com",
+		"email_verified": true,
+	}
+
+	for k, v := range overrides {
+		claims[k] = v
+	}
+
+	code := uuid.NewString()
+	m.codes[code] = claims
+
+	return code
+}
+
+func (s *SSOTestSuite) SetupSuite() {
+	s.mock = newmock(s.T())
+}
+
+func (s *SSOTestSuite) TearDownSuite() {
+	s.mock.server.Close()
+}
+
+func (s *SSOTestSuite) SetupTest() {
+	s.config = &entities.OrgSsoConfig{
+		OrgID:          uuid.New(),
+		Issuer:         s.mock.server.URL,
+		ClientID:       "client",
+		ClientSecret:   "secret",
+		AllowedDomains: []string{"example.com"},
+	}
+
+	provider, err := sso.NewProvider(context.Background(), s.config, "https://nomad.example.com/auth/sso/callback")
+	s.Require().NoError(err)
+
+	s.provider = provider
+}
+
+func (s *SSOTestSuite) TestAuthCodeURL() {
+	parsed, err := url.Parse(s.provider.AuthCodeURL("state", "nonce"))
+	s.Require().NoError(err)
+
+	s.Equal("/authorize", parsed.Path)
+	s.Equal("client", parsed.Query().Get("client_id"))
+	s.Equal("nonce", parsed.Query().Get("nonce"))
+	s.Equal("https://nomad.example.com/auth/sso/callback", parsed.Query().Get("redirect_uri"))
+}
+
+func (s *SSOTestSuite) TestExchangeVerify() {
+	ctx := context.Background()
+
+	raw, err := s.provider.Exchange(ctx, s.mock.code("nonce", nil))
+	s.Require().NoError(err)
+
+	identity, err := s.provider.Verify(ctx, raw, "nonce", time.Now())
+	s.Require().NoError(err)
+
+	s.Equal("user-1", identity.Subject)
+	s.Equal("jane@example.com", identity.Email)
+}
+
+func (s *SSOTestSuite) TestVerifyRejects() {
+	ctx := context.Background()
+
+	cases := []struct {
+		overrides map[string]any
+		nonce     string
+		err       error
+	}{
+		{overrides: nil, nonce: "other", err: sso.ErrNonce},
+		{overrides: map[string]any{"aud": "another"}, nonce: "nonce", err: sso.ErrToken},
+		{overrides: map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}, nonce: "nonce", err: sso.ErrToken},
+		{overrides: map[string]any{"email_verified": false}, nonce: "nonce", err: sso.ErrEmail},
+		{overrides: map[string]any{"email": "jane@elsewhere.com"}, nonce: "nonce", err: sso.ErrDomain},
+	}
+
+	for _, c := range cases {
+		raw, err := s.provider.Exchange(ctx, s.mock.code("nonce", c.overrides))
+		s.Require().NoError(err)
+
+		_, err = s.provider.Verify(ctx, raw, c.nonce, time.Now())
+		s.ErrorIs(err, c.err, c.overrides)
+	}
+}
+
+// TestLinkableTakeover covers a provider allowing every domain, asserting the email of a user outside of the verified
+// domain of the org: the identity verifies, but is never linked to the user.
+func (s *SSOTestSuite) TestLinkableTakeover() {
+	ctx := context.Background()
+
+	s.config.AllowedDomains = nil
+
+	raw, err := s.provider.Exchange(ctx, s.mock.code("nonce", map[string]any{"email": "victim@victim.com"}))
+	s.Require().NoError(err)
+
+	identity, err := s.provider.Verify(ctx, raw, "nonce", time.Now())
+	s.Require().NoError(err)
+
+	verified := &entities.OrgDomain{OrgID: s.config.OrgID, Domain: "example.com", IsVerified: true}
+	unverified := &entities.OrgDomain{OrgID: s.config.OrgID, Domain: "victim.com", IsVerified: false}
+
+	s.ErrorIs(sso.Linkable(nil, identity), sso.ErrUnlinked)
+	s.ErrorIs(sso.Linkable(verified, identity), sso.ErrUnlinked)
+	s.ErrorIs(sso.Linkable(unverified, identity), sso.ErrUnlinked)
+
+	identity.Email = "jane@Example.com"
+	s.NoError(sso.Linkable(verified, identity))
+
+	identity.EmailVerified = false
+	s.ErrorIs(sso.Linkable(verified, identity), sso.ErrEmail)
+}
+
+func (s *SSOTestSuite) TestExchangeUnknownCode() {
+	_, err := s.provider.Exchange(context.Background(), "unknown")
+	s.ErrorIs(err, sso.ErrExchange)
+}
+
+func (s *SSOTestSuite) TestState() {
+	now := time.Now()
+
+	state, err := sso.NewState(s.config.OrgID, "//evil.example.com", now)
+	s.Require().NoError(err)
+	s.Equal("/", state.Redirect)
+
+	encoded := state.Encode("secret")
+
+	decoded, err := sso.DecodeState("secret", encoded, now)
+	s.Require().NoError(err)
+	s.Equal(state.OrgID, decoded.OrgID)
+	s.Equal(state.Nonce, decoded.Nonce)
+
+	_, err = sso.DecodeState("other", encoded, now)
+	s.ErrorIs(err, sso.ErrState)
+
+	_, err = sso.DecodeState("secret", encoded, now.Add(sso.StateTTL+time.Minute))
+	s.ErrorIs(err, sso.ErrState)
+}
+
+func TestSSO(t *testing.T) {
+	suite.Run(t, new(SSOTestSuite))
+}
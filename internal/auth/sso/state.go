@@ -0,0 +1,98 @@
+package sso
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// StateTTL is the time given to the user to sign in at the provider.
+	StateTTL = 10 * time.Minute
+)
+
+var (
+	ErrState = errors.New("invalid state")
+)
+
+type (
+	// State is the state of a login, carried through the provider. It is signed with the secret, and bound to the
+	// browser of the user with a cookie.
+	State struct {
+		OrgID     uuid.UUID `json:"org_id"`
+		Nonce     string    `json:"nonce"`
+		Redirect  string    `json:"redirect"`
+		ExpiresAt int64     `json:"exp"`
+	}
+)
+
+// NewState creates the state of a login to the org. The redirect must be a path, see SafeRedirect.
+func NewState(org_id uuid.UUID, redirect string, now time.Time) (*State, error) {
+	nonce := make([]byte, 24)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return &State{
+		OrgID:     org_id,
+		Nonce:     base64.RawURLEncoding.EncodeToString(nonce),
+		Redirect:  SafeRedirect(redirect),
+		ExpiresAt: now.Add(StateTTL).Unix(),
+	}, nil
+}
+
+// Encode returns the signed state.
+func (s *State) Encode(secret string) string {
+	payload, _ := json.Marshal(s)
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + sign(secret, encoded)
+}
+
+// DecodeState verifies the signature and the expiry of the state.
+func DecodeState(secret, value string, now time.Time) (*State, error) {
+	encoded, signature, ok := strings.Cut(value, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(secret, encoded))) {
+		return nil, ErrState
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrState
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(payload, state); err != nil {
+		return nil, ErrState
+	}
+
+	if now.Unix() > state.ExpiresAt {
+		return nil, ErrState
+	}
+
+	return state, nil
+}
+
+// SafeRedirect returns the redirect if it is a path of the web app, and "/" otherwise, so that the login cannot
+// redirect to another site.
+func SafeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.Contains(redirect, "\\") {
+		return "/"
+	}
+
+	return redirect
+}
+
+func sign(secret, encoded string) string {
+	mac := hmac.New(sha256.New, []byte("sso:"+secret))
+	mac.Write([]byte(encoded))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,141 @@
+// Package teams resolves the team owning the events of a repo.
+//
+// A team owns a repo, or paths within a repo, see the team_repos table. An event is attributed to the team owning
+// the changed files, using the longest owned path matching each file. When the files are unknown, e.g. for pull
+// requests, the event is attributed to the team owning the whole repo. Without ownership, the event is attributed to
+// the team of the user, if the user belongs to a single team of the org.
+package teams
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+)
+
+// NormalizePath returns the path relative to the root of the repo, without leading or trailing slashes. The root of
+// the repo is the empty path.
+func NormalizePath(p string) string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return ""
+	}
+
+	p = path.Clean(p)
+	if p == "." {
+		return ""
+	}
+
+	return p
+}
+
+// Owner returns the team owning most of the paths, and uuid.Nil if no team owns any of them. Ties go to the team
+// whose ownership comes first in owned. Without paths, the owner of the whole repo is returned.
+func Owner(owned []entities.TeamRepo, paths []string) uuid.UUID {
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+
+	votes := make(map[uuid.UUID]int)
+
+	for _, p := range paths {
+		if team := match(owned, NormalizePath(p)); team != uuid.Nil {
+			votes[team]++
+		}
+	}
+
+	owner, most := uuid.Nil, 0
+
+	for _, o := range owned {
+		if votes[o.TeamID] > most {
+			owner, most = o.TeamID, votes[o.TeamID]
+		}
+	}
+
+	return owner
+}
+
+// Resolve returns the team owning the event of the repo, and nil if the event cannot be attributed to a team.
+func Resolve(ctx context.Context, repo *entities.Repo, paths []string, user *entities.User) (*entities.Team, error) {
+	owned, err := db.Queries().ListTeamReposByRepoID(ctx, repo.ID)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to list repo owners").Wrap(err)
+	}
+
+	if id := Owner(owned, paths); id != uuid.Nil {
+		return get(ctx, id, repo.OrgID)
+	}
+
+	if user == nil || user.ID == uuid.Nil {
+		return nil, nil
+	}
+
+	ids, err := db.Queries().ListTeamIDsByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to list user teams").Wrap(err)
+	}
+
+	// the user may belong to the teams of other orgs, a user in several teams of the org is ambiguous.
+	var found *entities.Team
+
+	for _, id := range ids {
+		team, err := get(ctx, id, repo.OrgID)
+		if err != nil {
+			return nil, err
+		}
+
+		if team == nil {
+			continue
+		}
+
+		if found != nil {
+			return nil, nil
+		}
+
+		found = team
+	}
+
+	return found, nil
+}
+
+// match returns the team owning the longest path containing p.
+func match(owned []entities.TeamRepo, p string) uuid.UUID {
+	team, longest := uuid.Nil, -1
+
+	for _, o := range owned {
+		if o.Path != "" && p != o.Path && !strings.HasPrefix(p, o.Path+"/") {
+			continue
+		}
+
+		if len(o.Path) > longest {
+			team, longest = o.TeamID, len(o.Path)
+		}
+	}
+
+	return team
+}
+
+// get returns the team, and nil if the team does not exist within the org.
+func get(ctx context.Context, id, org_id uuid.UUID) (*entities.Team, error) {
+	team, err := db.Queries().GetTeam(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to get team").Wrap(err)
+	}
+
+	if team.OrgID != org_id {
+		return nil, nil
+	}
+
+	return &team, nil
+}
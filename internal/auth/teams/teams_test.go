@@ -0,0 +1,68 @@
+package teams_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/auth/teams"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	OwnerTestSuite struct {
+		suite.Suite
+		platform uuid.UUID
+		billing  uuid.UUID
+		owned    []entities.TeamRepo
+	}
+)
+
+func (s *OwnerTestSuite) SetupSuite() {
+	s.platform = uuid.New()
+	s.billing = uuid.New()
+	s.owned = []entities.TeamRepo{
+		{TeamID: s.platform, Path: ""},
+		{TeamID: s.billing, Path: "services/billing"},
+	}
+}
+
+func (s *OwnerTestSuite) TestNormalizePath() {
+	s.Equal("", teams.NormalizePath("/"))
+	s.Equal("", teams.NormalizePath("./"))
+	s.Equal("services/billing", teams.NormalizePath("/services/billing/"))
+	s.Equal("services/billing", teams.NormalizePath("services//billing"))
+}
+
+func (s *OwnerTestSuite) TestWholeRepo() {
+	s.Equal(s.platform, teams.Owner(s.owned, nil))
+	s.Equal(s.platform, teams.Owner(s.owned, []string{"README.md"}))
+}
+
+func (s *OwnerTestSuite) TestLongestPath() {
+	s.Equal(s.billing, teams.Owner(s.owned, []string{"services/billing/invoice.go"}))
+	s.Equal(s.platform, teams.Owner(s.owned, []string{"services/billing-v2/invoice.go"}))
+}
+
+func (s *OwnerTestSuite) TestMostPaths() {
+	paths := []string{"services/billing/invoice.go", "services/billing/tax.go", "go.mod"}
+
+	s.Equal(s.billing, teams.Owner(s.owned, paths))
+}
+
+func (s *OwnerTestSuite) TestTie() {
+	s.Equal(s.platform, teams.Owner(s.owned, []string{"services/billing/invoice.go", "go.mod"}))
+}
+
+func (s *OwnerTestSuite) TestUnowned() {
+	owned := []entities.TeamRepo{{TeamID: s.billing, Path: "services/billing"}}
+
+	s.Equal(uuid.Nil, teams.Owner(owned, nil))
+	s.Equal(uuid.Nil, teams.Owner(owned, []string{"go.mod"}))
+	s.Equal(uuid.Nil, teams.Owner(nil, []string{"go.mod"}))
+}
+
+func TestOwner(t *testing.T) {
+	suite.Run(t, new(OwnerTestSuite))
+}
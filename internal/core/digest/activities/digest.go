@@ -0,0 +1,129 @@
+package activities
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/core/digest/config"
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/core/digest/fns"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	// Digest groups the activities of the digest workflow.
+	Digest struct{}
+)
+
+// ListDue returns the active subscriptions whose digest period has elapsed by the given time.
+func (a *Digest) ListDue(ctx context.Context, now time.Time) ([]entities.ListDueUserDigestsRow, error) {
+	params := entities.ListDueUserDigestsParams{
+		DailyBefore:  now.Add(-defs.FrequencyDaily.Period()),
+		WeeklyBefore: now.Add(-defs.FrequencyWeekly.Period()),
+	}
+
+	return db.Queries().ListDueUserDigests(ctx, params)
+}
+
+// ListDueTeams returns the active team subscriptions whose digest period has elapsed by the given time.
+func (a *Digest) ListDueTeams(ctx context.Context, now time.Time) ([]entities.ListDueTeamDigestsRow, error) {
+	params := entities.ListDueTeamDigestsParams{
+		DailyBefore:  now.Add(-defs.FrequencyDaily.Period()),
+		WeeklyBefore: now.Add(-defs.FrequencyWeekly.Period()),
+	}
+
+	return db.Queries().ListDueTeamDigests(ctx, params)
+}
+
+// Send builds the digest for the subscription from the pulse events of the org, renders it and sends it with the
+// configured sender. The subscription is marked as sent even if nothing happened during the period, so that the next
+// digest covers the next period.
+func (a *Digest) Send(ctx context.Context, payload *defs.SendPayload) error {
+	sub := payload.Subscription
+	frequency := defs.Frequency(sub.Frequency)
+	since := period_start(frequency, sub.LastSentAt, payload.Until)
+	filter := pulse.TallyFilter{Since: since, UserID: sub.UserID}
+
+	if sub.IncludeTeams {
+		teams, err := db.Queries().ListTeamIDsByUserID(ctx, sub.UserID)
+		if err != nil {
+			return err
+		}
+
+		filter.TeamIDs = teams
+	}
+
+	digest := &defs.Digest{Name: sub.FirstName, Frequency: frequency, Since: since, Until: payload.Until}
+
+	if err := deliver(ctx, sub.OrgID, sub.Slug, sub.Email, filter, digest); err != nil {
+		return err
+	}
+
+	return db.Queries().MarkUserDigestSent(ctx, entities.MarkUserDigestSentParams{ID: sub.ID, LastSentAt: payload.Until})
+}
+
+// SendTeam builds the digest for the team subscription from the pulse events of the team, and sends it to the email of
+// the subscription. Like Send, the subscription is marked as sent even if nothing happened during the period.
+func (a *Digest) SendTeam(ctx context.Context, payload *defs.SendTeamPayload) error {
+	sub := payload.Subscription
+	frequency := defs.Frequency(sub.Frequency)
+	since := period_start(frequency, sub.LastSentAt, payload.Until)
+	filter := pulse.TallyFilter{Since: since, TeamIDs: []uuid.UUID{sub.TeamID}}
+	digest := &defs.Digest{Team: true, Name: sub.Name, Frequency: frequency, Since: since, Until: payload.Until}
+
+	if err := deliver(ctx, sub.OrgID, sub.Slug, sub.Email, filter, digest); err != nil {
+		return err
+	}
+
+	return db.Queries().MarkTeamDigestSent(ctx, entities.MarkTeamDigestSentParams{ID: sub.ID, LastSentAt: payload.Until})
+}
+
+// period_start returns the start of the digest period ending at until. The period never starts before the last digest
+// was sent, so that an event is reported once.
+func period_start(frequency defs.Frequency, last_sent_at, until time.Time) time.Time {
+	since := until.Add(-frequency.Period())
+	if last_sent_at.After(since) {
+		return last_sent_at
+	}
+
+	return since
+}
+
+// deliver tallies the events of the org matching the filter into the digest, and sends the rendered digest to the
+// email. Nothing is sent if nothing happened during the period.
+func deliver(ctx context.Context, org_id uuid.UUID, slug, to string, filter pulse.TallyFilter, digest *defs.Digest) error {
+	tallies, err := pulse.Tallies(ctx, slug, filter)
+	if err != nil {
+		return err
+	}
+
+	repos, err := db.Queries().GetOrgReposByOrgID(ctx, org_id)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[uuid.UUID]string, len(repos))
+	for _, repo := range repos {
+		names[repo.ID] = repo.Name
+	}
+
+	digest.Repos = fns.Summarise(tallies, names)
+
+	if digest.IsEmpty() {
+		slog.Debug("core/digest: nothing to report, skipping", "org_id", org_id)
+
+		return nil
+	}
+
+	msg, err := fns.Render(to, digest)
+	if err != nil {
+		return err
+	}
+
+	return config.Sender().Send(ctx, msg)
+}
@@ -0,0 +1,37 @@
+package digest
+
+import (
+	"go.breu.io/quantm/internal/core/digest/activities"
+	"go.breu.io/quantm/internal/core/digest/config"
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/core/digest/schedule"
+	"go.breu.io/quantm/internal/core/digest/workflows"
+)
+
+type (
+	Config  = config.Config
+	Sender  = defs.Sender
+	Message = defs.Message
+
+	Activities = activities.Digest
+	Schedule   = schedule.Schedule
+)
+
+var (
+	DefaultConfig = config.DefaultConfig
+
+	WithConfig = config.WithConfig
+	WithSender = config.WithSender
+	Configure  = config.Instance
+
+	// Mailer returns the configured sender, it is also used to send notifications by email.
+	Mailer = config.Sender
+
+	// Workflow sends the periodic digests, there is one for the platform.
+	Workflow = workflows.Digest
+)
+
+const (
+	FrequencyDaily  = defs.FrequencyDaily
+	FrequencyWeekly = defs.FrequencyWeekly
+)
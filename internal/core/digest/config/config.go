@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/core/digest/fns"
+)
+
+var (
+	_once sync.Once
+	_c    *Config
+)
+
+type (
+	// Config holds the settings for sending digests. If the SMTP host is not set, digests are logged instead of sent.
+	Config struct {
+		Host     string `koanf:"HOST" json:"host"`                            // SMTP host.
+		Port     int    `koanf:"PORT" json:"port"`                            // SMTP port.
+		User     string `koanf:"USER" json:"user"`                            // SMTP username.
+		Password string `koanf:"PASS" json:"-"`                               // SMTP password.
+		From     string `koanf:"FROM" json:"from" validate:"omitempty,email"` // Sender address.
+
+		sender defs.Sender
+	}
+
+	ConfigOption func(*Config)
+)
+
+var (
+	DefaultConfig = Config{
+		Port: 587,
+		From: "digest@quantm.local",
+	}
+)
+
+func (c *Config) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// GetAddress returns the address of the SMTP server.
+func (c *Config) GetAddress() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
+// Sender returns the configured sender.
+func Sender() defs.Sender {
+	return Instance().sender
+}
+
+// WithConfig configures the SMTP sender.
+func WithConfig(cfg *Config) ConfigOption {
+	return func(config *Config) {
+		config.Host = cfg.Host
+		config.Port = cfg.Port
+		config.User = cfg.User
+		config.Password = cfg.Password
+		config.From = cfg.From
+	}
+}
+
+// WithSender replaces the SMTP sender with the given sender.
+func WithSender(sender defs.Sender) ConfigOption {
+	return func(config *Config) {
+		config.sender = sender
+	}
+}
+
+func Instance(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		_c = &Config{}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+
+		if _c.sender == nil && _c.Host != "" {
+			_c.sender = fns.NewSMTPSender(_c.GetAddress(), _c.User, _c.Password, _c.From)
+		}
+
+		if _c.sender == nil {
+			slog.Warn("core/digest: smtp not configured, digests will be logged")
+
+			_c.sender = &fns.LogSender{}
+		}
+	})
+
+	return _c
+}
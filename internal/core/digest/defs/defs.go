@@ -0,0 +1,88 @@
+package defs
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	// Frequency is how often a digest is sent to a user or a team.
+	Frequency string
+
+	// Message is a rendered digest, ready to be sent.
+	Message struct {
+		To      string
+		Subject string
+		Text    string
+		HTML    string
+	}
+
+	// Sender delivers a rendered digest. The default sender is SMTP, but any transport implementing this interface
+	// can be configured.
+	Sender interface {
+		Send(ctx context.Context, msg *Message) error
+	}
+
+	// Repo summarises the activity on a single repository for the digest period.
+	Repo struct {
+		ID        uuid.UUID
+		Name      string
+		Stale     uint64 // Stale is the number of times branches went stale.
+		Queued    uint64 // Queued is the number of pull requests still waiting in the merge queue.
+		Conflicts uint64 // Conflicts is the number of merge conflicts reported.
+		Resolved  uint64 // Resolved is the number of merge conflicts resolved.
+		Oversized uint64 // Oversized is the number of pushes that exceeded the line threshold.
+	}
+
+	// SendPayload is the payload for sending the digest of a subscription. Until is the end of the digest period.
+	SendPayload struct {
+		Subscription entities.ListDueUserDigestsRow `json:"subscription"`
+		Until        time.Time                      `json:"until"`
+	}
+
+	// SendTeamPayload is the payload for sending the digest of a team subscription.
+	SendTeamPayload struct {
+		Subscription entities.ListDueTeamDigestsRow `json:"subscription"`
+		Until        time.Time                      `json:"until"`
+	}
+
+	// Digest is the data rendered by the digest templates. For the digest of a team, Name is the name of the team.
+	Digest struct {
+		Team      bool
+		Name      string
+		Frequency Frequency
+		Since     time.Time
+		Until     time.Time
+		Repos     []Repo
+	}
+)
+
+const (
+	FrequencyDaily  Frequency = "daily"
+	FrequencyWeekly Frequency = "weekly"
+)
+
+const (
+	// Interval is how often the digest workflow checks for subscriptions that are due.
+	Interval = time.Hour
+)
+
+func (f Frequency) String() string { return string(f) }
+
+// Period returns the duration covered by a digest of the given frequency.
+func (f Frequency) Period() time.Duration {
+	if f == FrequencyDaily {
+		return 24 * time.Hour
+	}
+
+	return 7 * 24 * time.Hour
+}
+
+// IsEmpty returns true if nothing happened during the digest period.
+func (d *Digest) IsEmpty() bool {
+	return len(d.Repos) == 0
+}
@@ -0,0 +1,18 @@
+package defs
+
+import (
+	"go.breu.io/durex/workflows"
+
+	"go.breu.io/quantm/internal/durable"
+)
+
+// DigestWorkflowOptions returns the options for the digest workflow. There is a single digest workflow for the
+// platform, so the ID is fixed.
+func DigestWorkflowOptions() workflows.Options {
+	opts := durable.NewWorkflowOptions(
+		durable.WithSubject("digests"),
+		durable.WithScope("schedule"),
+	)
+
+	return opts
+}
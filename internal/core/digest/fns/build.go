@@ -0,0 +1,70 @@
+package fns
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// Summarise folds the tallies into a summary per repo, named after the given repos. Repos without any activity worth
+// reporting are left out.
+func Summarise(tallies []pulse.Tally, names map[uuid.UUID]string) []defs.Repo {
+	summaries := make(map[uuid.UUID]*defs.Repo)
+	removed := make(map[uuid.UUID]uint64)
+
+	for _, tally := range tallies {
+		name, ok := names[tally.SubjectID]
+		if !ok {
+			continue
+		}
+
+		summary, ok := summaries[tally.SubjectID]
+		if !ok {
+			summary = &defs.Repo{ID: tally.SubjectID, Name: name}
+			summaries[tally.SubjectID] = summary
+		}
+
+		switch events.Scope(tally.Scope) {
+		case events.ScopeBranch:
+			if events.Action(tally.Action) == events.ActionStale {
+				summary.Stale += tally.Count
+			}
+		case events.ScopeDiff:
+			summary.Oversized += tally.Count
+		case events.ScopeMerge:
+			switch events.Action(tally.Action) {
+			case events.ActionFailure:
+				summary.Conflicts += tally.Count
+			case events.ActionResolved:
+				summary.Resolved += tally.Count
+			}
+		case events.ScopeMergeQueue:
+			switch events.Action(tally.Action) {
+			case events.EventActionAdded:
+				summary.Queued += tally.Count
+			case events.EventActionRemoved:
+				removed[tally.SubjectID] += tally.Count
+			}
+		}
+	}
+
+	result := make([]defs.Repo, 0, len(summaries))
+
+	for id, summary := range summaries {
+		summary.Queued -= min(summary.Queued, removed[id])
+
+		if summary.Stale+summary.Queued+summary.Conflicts+summary.Resolved+summary.Oversized == 0 {
+			continue
+		}
+
+		result = append(result, *summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
@@ -0,0 +1,108 @@
+package fns_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/core/digest/fns"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	BuildTestSuite struct {
+		suite.Suite
+
+		api uuid.UUID
+		web uuid.UUID
+	}
+)
+
+func (s *BuildTestSuite) SetupTest() {
+	s.api = uuid.New()
+	s.web = uuid.New()
+}
+
+func (s *BuildTestSuite) TestSummarise() {
+	tests := []struct {
+		name    string
+		tallies []pulse.Tally
+		want    []defs.Repo
+	}{
+		{
+			name:    "nothing happened",
+			tallies: nil,
+			want:    []defs.Repo{},
+		},
+		{
+			name: "counts per scope",
+			tallies: []pulse.Tally{
+				s.tally(s.api, events.ScopeBranch, events.ActionStale, 2),
+				s.tally(s.api, events.ScopeDiff, events.ActionCreated, 3),
+				s.tally(s.api, events.ScopeMerge, events.ActionFailure, 4),
+				s.tally(s.api, events.ScopeMerge, events.ActionResolved, 1),
+				s.tally(s.api, events.ScopeMergeQueue, events.EventActionAdded, 5),
+			},
+			want: []defs.Repo{{ID: s.api, Name: "api", Stale: 2, Oversized: 3, Conflicts: 4, Resolved: 1, Queued: 5}},
+		},
+		{
+			name: "removed from queue",
+			tallies: []pulse.Tally{
+				s.tally(s.api, events.ScopeMergeQueue, events.EventActionRemoved, 2),
+				s.tally(s.api, events.ScopeMergeQueue, events.EventActionAdded, 5),
+			},
+			want: []defs.Repo{{ID: s.api, Name: "api", Queued: 3}},
+		},
+		{
+			name: "removed more than queued",
+			tallies: []pulse.Tally{
+				s.tally(s.api, events.ScopeMergeQueue, events.EventActionAdded, 1),
+				s.tally(s.api, events.ScopeMergeQueue, events.EventActionRemoved, 3),
+				s.tally(s.web, events.ScopeBranch, events.ActionStale, 1),
+			},
+			want: []defs.Repo{{ID: s.web, Name: "web", Stale: 1}},
+		},
+		{
+			name: "unreported actions",
+			tallies: []pulse.Tally{
+				s.tally(s.api, events.ScopeBranch, events.ActionCreated, 7),
+				s.tally(s.api, events.ScopePush, events.ActionCreated, 9),
+			},
+			want: []defs.Repo{},
+		},
+		{
+			name: "unknown repo",
+			tallies: []pulse.Tally{
+				s.tally(uuid.New(), events.ScopeBranch, events.ActionStale, 1),
+			},
+			want: []defs.Repo{},
+		},
+		{
+			name: "sorted by name",
+			tallies: []pulse.Tally{
+				s.tally(s.web, events.ScopeDiff, events.ActionCreated, 1),
+				s.tally(s.api, events.ScopeDiff, events.ActionCreated, 1),
+			},
+			want: []defs.Repo{{ID: s.api, Name: "api", Oversized: 1}, {ID: s.web, Name: "web", Oversized: 1}},
+		},
+	}
+
+	names := map[uuid.UUID]string{s.api: "api", s.web: "web"}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, fns.Summarise(tt.tallies, names))
+		})
+	}
+}
+
+func (s *BuildTestSuite) tally(id uuid.UUID, scope events.Scope, action events.Action, count uint64) pulse.Tally {
+	return pulse.Tally{SubjectID: id, Scope: string(scope), Action: string(action), Count: count}
+}
+
+func TestBuildSuite(t *testing.T) {
+	suite.Run(t, new(BuildTestSuite))
+}
@@ -0,0 +1,19 @@
+package fns
+
+import (
+	"context"
+	"log/slog"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+)
+
+type (
+	// LogSender logs the digests instead of sending them. It is used when no SMTP server is configured.
+	LogSender struct{}
+)
+
+func (s *LogSender) Send(_ context.Context, msg *defs.Message) error {
+	slog.Info("core/digest: digest", "to", msg.To, "subject", msg.Subject, "text", msg.Text)
+
+	return nil
+}
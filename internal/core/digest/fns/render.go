@@ -0,0 +1,52 @@
+package fns
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+	"time"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+)
+
+var (
+	//go:embed templates/*
+	templates embed.FS
+
+	funcs = map[string]any{
+		"date": func(t time.Time) string { return t.Format("Jan 2, 2006") },
+	}
+
+	html = htmltemplate.Must(htmltemplate.New("digest.html.tmpl").Funcs(funcs).ParseFS(templates, "templates/digest.html.tmpl"))
+	text = texttemplate.Must(texttemplate.New("digest.txt.tmpl").Funcs(funcs).ParseFS(templates, "templates/digest.txt.tmpl"))
+)
+
+// Render renders the digest into a message addressed to the given email.
+func Render(to string, digest *defs.Digest) (*defs.Message, error) {
+	msg := &defs.Message{
+		To:      to,
+		Subject: "Your " + digest.Frequency.String() + " quantm digest",
+	}
+
+	if digest.Team {
+		msg.Subject = "The " + digest.Frequency.String() + " quantm digest of " + digest.Name
+	}
+
+	buf := &bytes.Buffer{}
+	if err := text.Execute(buf, digest); err != nil {
+		return nil, err
+	}
+
+	msg.Text = buf.String()
+
+	buf.Reset()
+
+	if err := html.Execute(buf, digest); err != nil {
+		return nil, err
+	}
+
+	msg.HTML = buf.String()
+
+	return msg, nil
+}
@@ -0,0 +1,86 @@
+package fns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/core/digest/fns"
+)
+
+type (
+	RenderTestSuite struct {
+		suite.Suite
+
+		digest *defs.Digest
+	}
+)
+
+func (s *RenderTestSuite) SetupTest() {
+	until := time.Date(2024, time.March, 8, 9, 0, 0, 0, time.UTC)
+
+	s.digest = &defs.Digest{
+		Name:      "Ada",
+		Frequency: defs.FrequencyWeekly,
+		Since:     until.Add(-defs.FrequencyWeekly.Period()),
+		Until:     until,
+		Repos: []defs.Repo{
+			{ID: uuid.New(), Name: "api", Stale: 2, Queued: 3, Conflicts: 4, Resolved: 1, Oversized: 5},
+			{ID: uuid.New(), Name: "<web>", Stale: 1},
+		},
+	}
+}
+
+func (s *RenderTestSuite) TestUser() {
+	msg, err := fns.Render("ada@example.com", s.digest)
+	s.Require().NoError(err)
+
+	s.Equal("ada@example.com", msg.To)
+	s.Equal("Your weekly quantm digest", msg.Subject)
+
+	for _, body := range []string{msg.Text, msg.HTML} {
+		s.Contains(body, "Hi Ada,")
+		s.Contains(body, "Mar 1, 2024")
+		s.Contains(body, "Mar 8, 2024")
+		s.Contains(body, "api")
+		s.Contains(body, "4 (1 resolved)")
+		s.Contains(body, "profile")
+	}
+
+	s.Contains(msg.Text, "Stale branches:       2")
+	s.Contains(msg.Text, "Oversized changes:    5")
+	s.Contains(msg.Text, "<web>")
+}
+
+func (s *RenderTestSuite) TestTeam() {
+	s.digest.Team = true
+	s.digest.Name = "platform"
+	s.digest.Frequency = defs.FrequencyDaily
+
+	msg, err := fns.Render("platform@example.com", s.digest)
+	s.Require().NoError(err)
+
+	s.Equal("The daily quantm digest of platform", msg.Subject)
+
+	for _, body := range []string{msg.Text, msg.HTML} {
+		s.Contains(body, "daily digest of the platform team")
+		s.Contains(body, "team settings")
+		s.NotContains(body, "Hi platform")
+		s.NotContains(body, "profile")
+	}
+}
+
+func (s *RenderTestSuite) TestEscapeHTML() {
+	msg, err := fns.Render("ada@example.com", s.digest)
+	s.Require().NoError(err)
+
+	s.Contains(msg.HTML, "&lt;web&gt;")
+	s.NotContains(msg.HTML, "<web>")
+}
+
+func TestRenderSuite(t *testing.T) {
+	suite.Run(t, new(RenderTestSuite))
+}
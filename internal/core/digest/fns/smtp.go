@@ -0,0 +1,84 @@
+package fns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+)
+
+type (
+	// SMTPSender sends digests as multipart emails through an SMTP server.
+	SMTPSender struct {
+		addr string
+		from string
+		auth smtp.Auth
+	}
+)
+
+// Send sends the message with a plain text and an html part.
+func (s *SMTPSender) Send(_ context.Context, msg *defs.Message) error {
+	body, err := compose(s.from, msg)
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(s.addr, s.auth, s.from, []string{msg.To}, body)
+}
+
+// NewSMTPSender creates a sender for the SMTP server at addr. Authentication is skipped when user is empty.
+func NewSMTPSender(addr, user, password, from string) *SMTPSender {
+	sender := &SMTPSender{addr: addr, from: from}
+
+	if user != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		sender.auth = smtp.PlainAuth("", user, password, host)
+	}
+
+	return sender
+}
+
+// compose builds a multipart/alternative email from the message. Empty parts are left out.
+func compose(from string, msg *defs.Message) ([]byte, error) {
+	body := &bytes.Buffer{}
+	parts := multipart.NewWriter(body)
+
+	for _, part := range []struct{ kind, content string }{{"text/plain", msg.Text}, {"text/html", msg.HTML}} {
+		if part.content == "" {
+			continue
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.kind+"; charset=UTF-8")
+
+		w, err := parts.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	email := &bytes.Buffer{}
+
+	fmt.Fprintf(email, "From: %s\r\n", from)
+	fmt.Fprintf(email, "To: %s\r\n", msg.To)
+	fmt.Fprintf(email, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(email, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(email, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", parts.Boundary())
+
+	email.Write(body.Bytes())
+
+	return email.Bytes(), nil
+}
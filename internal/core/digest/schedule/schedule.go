@@ -0,0 +1,32 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/core/digest/workflows"
+	"go.breu.io/quantm/internal/durable"
+)
+
+type (
+	// Schedule starts the digest workflow. It conforms to the graceful.Service interface, so that the workflow is
+	// started once the core queue is available. Starting an already running workflow returns the running one, so it
+	// is safe to start on every boot.
+	Schedule struct{}
+)
+
+func (s *Schedule) Start(ctx context.Context) error {
+	run, err := durable.OnCore().ExecuteWorkflow(ctx, defs.DigestWorkflowOptions(), workflows.Digest)
+	if err != nil {
+		slog.Error("core/digest: unable to start workflow", "error", err.Error())
+		return err
+	}
+
+	slog.Info("core/digest: scheduled", "workflow_id", run.GetID(), "run_id", run.GetRunID())
+
+	return nil
+}
+
+// Stop is a no-op, the workflow outlives the process.
+func (s *Schedule) Stop(_ context.Context) error { return nil }
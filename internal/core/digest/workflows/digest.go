@@ -0,0 +1,55 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/digest/activities"
+	"go.breu.io/quantm/internal/core/digest/defs"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable/periodic"
+)
+
+// Digest checks every interval for user and team subscriptions that are due and sends a digest for each of them. A
+// failure to send a digest is logged, the subscription stays due and is picked up again on the next tick.
+func Digest(ctx workflow.Context) error {
+	logger := workflow.GetLogger(ctx)
+	acts := &activities.Digest{}
+	interval := periodic.New(ctx, defs.Interval)
+
+	for !workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+		actx := dispatch.WithDefaultActivityContext(ctx)
+		now := workflow.Now(ctx)
+		due := make([]entities.ListDueUserDigestsRow, 0)
+
+		if err := workflow.ExecuteActivity(actx, acts.ListDue, now).Get(ctx, &due); err != nil {
+			logger.Error("digest: unable to list due subscriptions", "error", err.Error())
+		}
+
+		for _, sub := range due {
+			payload := &defs.SendPayload{Subscription: sub, Until: now}
+
+			if err := workflow.ExecuteActivity(actx, acts.Send, payload).Get(ctx, nil); err != nil {
+				logger.Error("digest: unable to send", "user_id", sub.UserID.String(), "error", err.Error())
+			}
+		}
+
+		teams := make([]entities.ListDueTeamDigestsRow, 0)
+
+		if err := workflow.ExecuteActivity(actx, acts.ListDueTeams, now).Get(ctx, &teams); err != nil {
+			logger.Error("digest: unable to list due team subscriptions", "error", err.Error())
+		}
+
+		for _, sub := range teams {
+			payload := &defs.SendTeamPayload{Subscription: sub, Until: now}
+
+			if err := workflow.ExecuteActivity(actx, acts.SendTeam, payload).Get(ctx, nil); err != nil {
+				logger.Error("digest: unable to send", "team_id", sub.TeamID.String(), "error", err.Error())
+			}
+		}
+
+		interval.Tick(ctx)
+	}
+
+	return workflow.NewContinueAsNewError(ctx, Digest)
+}
@@ -8,15 +8,82 @@ import (
 )
 
 type (
+	// ChatThreadStatus is the latest status of a branch, rendered on the parent message of the branch thread.
+	ChatThreadStatus string
+
+	// ChatThread identifies the conversation for a branch on a chat platform. The first notification for a branch
+	// starts the thread, later notifications reply to it and update the status of the parent message.
+	//
+	// The thread is specific to the target it was started for, a notification for another target, e.g. after the user
+	// switched from direct messages to the channel of the team, starts a new thread.
+	//
+	// The thread is part of the branch workflow state, so it must remain serializable.
+	ChatThread struct {
+		Branch  string           `json:"branch"`  // Branch the thread is about.
+		Channel string           `json:"channel"` // Channel the parent message was posted in.
+		ID      string           `json:"id"`      // ID of the parent message, for slack, the message timestamp.
+		Status  ChatThreadStatus `json:"status"`  // Status rendered on the parent message.
+		Target  string           `json:"target"`  // Target the thread was started for, e.g. a user or the team.
+	}
+
 	Chat interface {
-		// NotifyLinesExceed sends a message indicating a line exceed message.
+		// StartThread posts the parent message of the thread, in the channel the subject resolves to, and returns the
+		// started thread. A thread that is already started is returned untouched.
+		//
+		// The thread is started by its own activity, so that the retries of a failed reply never post the parent
+		// message again.
 		//
 		// This method must not be called from the workflow.
-		NotifyLinesExceed(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error
+		StartThread(ctx context.Context, subject events.Subject, source string, thread *ChatThread) (*ChatThread, error)
 
-		// NotifyMergeConflict sends a message indicating a merge conflict.
+		// NotifyLinesExceed sends a message indicating a line exceed message. The message is threaded under the given
+		// thread, see StartThread. The updated thread is returned.
 		//
 		// This method must not be called from the workflow.
-		NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error
+		NotifyLinesExceed(
+			ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff], thread *ChatThread,
+		) (*ChatThread, error)
+
+		// NotifyMergeConflict sends a message indicating a merge conflict. If the event action is updated, the conflict
+		// was reported before and the payload holds only the files still conflicting. The message is threaded under the
+		// given thread, see StartThread. The updated thread is returned.
+		//
+		// This method must not be called from the workflow.
+		NotifyMergeConflict(
+			ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge], thread *ChatThread,
+		) (*ChatThread, error)
+
+		// NotifyMergeConflictResolved sends a message indicating that a previously reported merge conflict has been
+		// cleared. The message is threaded under the given thread, see StartThread. The updated thread is returned.
+		//
+		// This method must not be called from the workflow.
+		NotifyMergeConflictResolved(
+			ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge], thread *ChatThread,
+		) (*ChatThread, error)
+
+		// NotifyMerged sends a message indicating that the pull request of the branch has been merged, and marks the
+		// thread as merged. Threads that were never started are returned untouched. The updated thread is returned.
+		//
+		// This method must not be called from the workflow.
+		NotifyMerged(
+			ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest], thread *ChatThread,
+		) (*ChatThread, error)
 	}
 )
+
+const (
+	ChatThreadStatusLinesExceeded ChatThreadStatus = "lines_exceeded"
+	ChatThreadStatusMergeConflict ChatThreadStatus = "merge_conflict"
+	ChatThreadStatusResolved      ChatThreadStatus = "resolved"
+	ChatThreadStatusMerged        ChatThreadStatus = "merged"
+)
+
+// IsStarted returns true if the parent message of the thread has been posted.
+func (t *ChatThread) IsStarted() bool {
+	return t != nil && t.Channel != "" && t.ID != ""
+}
+
+// NewChatThread returns a thread for the branch that is yet to be started.
+func NewChatThread(branch string) *ChatThread {
+	return &ChatThread{Branch: branch}
+}
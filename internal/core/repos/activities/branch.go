@@ -2,17 +2,21 @@ package activities
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"sync"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	git "github.com/jeffwelling/git2go/v37"
 
+	"go.breu.io/quantm/internal/core/digest"
 	"go.breu.io/quantm/internal/core/kernel"
 	"go.breu.io/quantm/internal/core/repos/defs"
 	"go.breu.io/quantm/internal/core/repos/fns"
-	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/db"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
@@ -188,20 +192,116 @@ func (a *Branch) Rebase(ctx context.Context, payload *defs.RebasePayload) (*defs
 	return result, nil
 }
 
-// NotifyLinesExceeded notifies on chat if lines exceed a limit.
-func (a *Branch) NotifyLinesExceeded(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error {
-	if err := kernel.Get().ChatHook(event.Context.Hook).NotifyLinesExceed(ctx, event); err != nil {
+// StartChatThread posts the parent message of the branch thread on chat, and returns the started thread.
+func (a *Branch) StartChatThread(ctx context.Context, payload *defs.ThreadPayload) (*kernel.ChatThread, error) {
+	thread, err := kernel.Get().ChatHook(payload.Hook).StartThread(ctx, payload.Subject, payload.Source, payload.Thread)
+	if err != nil {
+		slog.Warn("unable to start thread on chat", "error", err.Error())
+		return nil, err
+	}
+
+	return thread, nil
+}
+
+// NotifyLinesExceeded notifies on chat if lines exceed a limit. The message is threaded under the branch
+// conversation, and the updated thread is returned.
+func (a *Branch) NotifyLinesExceeded(
+	ctx context.Context, payload *defs.ChatPayload[eventsv1.Diff],
+) (*kernel.ChatThread, error) {
+	thread, err := kernel.Get().ChatHook(payload.Event.Context.Hook).NotifyLinesExceed(ctx, payload.Event, payload.Thread)
+	if err != nil {
 		slog.Warn("unable to notify on chat", "error", err.Error())
-		return err
+		return nil, err
 	}
 
-	return nil
+	return thread, nil
+}
+
+// NotifyMergeConflict notifies on chat if merge conflict message. The message is threaded under the branch
+// conversation, and the updated thread is returned.
+func (a *Branch) NotifyMergeConflict(
+	ctx context.Context, payload *defs.ChatPayload[eventsv1.Merge],
+) (*kernel.ChatThread, error) {
+	thread, err := kernel.Get().ChatHook(payload.Event.Context.Hook).NotifyMergeConflict(ctx, payload.Event, payload.Thread)
+	if err != nil {
+		slog.Warn("unable to notify on chat", "error", err.Error())
+		return nil, err
+	}
+
+	return thread, nil
+}
+
+// NotifyMergeConflictResolved notifies on chat when a previously reported merge conflict is cleared. The message is
+// threaded under the branch conversation, and the updated thread is returned.
+func (a *Branch) NotifyMergeConflictResolved(
+	ctx context.Context, payload *defs.ChatPayload[eventsv1.Merge],
+) (*kernel.ChatThread, error) {
+	thread, err := kernel.Get().ChatHook(payload.Event.Context.Hook).NotifyMergeConflictResolved(ctx, payload.Event, payload.Thread)
+	if err != nil {
+		slog.Warn("unable to notify on chat", "error", err.Error())
+		return nil, err
+	}
+
+	return thread, nil
 }
 
-// NotifyMergeConflict notifies on chat if merge conflict message.
-func (a *Branch) NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error {
-	if err := kernel.Get().ChatHook(event.Context.Hook).NotifyMergeConflict(ctx, event); err != nil {
+// NotifyMerged notifies on chat that the pull request of the branch has been merged. The thread of the branch is
+// marked merged, and the updated thread is returned.
+func (a *Branch) NotifyMerged(
+	ctx context.Context, payload *defs.ChatPayload[eventsv1.PullRequest],
+) (*kernel.ChatThread, error) {
+	thread, err := kernel.Get().ChatHook(payload.Event.Context.Hook).NotifyMerged(ctx, payload.Event, payload.Thread)
+	if err != nil {
 		slog.Warn("unable to notify on chat", "error", err.Error())
+		return nil, err
+	}
+
+	return thread, nil
+}
+
+// GetNotifyPreferences returns the notification preferences of the user. Users without saved preferences get the
+// defaults. If no user is given, the notification goes to the channel linked to the repo.
+func (a *Branch) GetNotifyPreferences(ctx context.Context, user uuid.UUID) (*defs.NotifyPreferences, error) {
+	prefs := defs.NewNotifyPreferences()
+
+	if user == uuid.Nil {
+		prefs.Channel = defs.NotifyChannelTeam
+		return prefs, nil
+	}
+
+	saved, err := db.Queries().GetUserNotificationPreferences(ctx, user)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return prefs, nil
+		}
+
+		return nil, err
+	}
+
+	prefs.Kinds = make([]defs.NotifyKind, 0, len(saved.Kinds))
+	for _, kind := range saved.Kinds {
+		prefs.Kinds = append(prefs.Kinds, defs.NotifyKind(kind))
+	}
+
+	prefs.Channel = defs.NotifyChannel(saved.Channel)
+	prefs.Timezone = saved.Timezone
+	prefs.QuietStart = saved.QuietStart
+	prefs.QuietEnd = saved.QuietEnd
+
+	return prefs, nil
+}
+
+// NotifyByEmail sends the notification to the email of the user.
+func (a *Branch) NotifyByEmail(ctx context.Context, payload *defs.EmailPayload) error {
+	user, err := db.Queries().GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	msg := &digest.Message{To: user.Email, Subject: payload.Subject, Text: payload.Text}
+
+	if err := digest.Mailer().Send(ctx, msg); err != nil {
+		slog.Warn("unable to notify by email", "error", err.Error())
 		return err
 	}
 
@@ -318,7 +418,8 @@ func (a *Branch) tree_from_sha(_ context.Context, repo *git.Repository, sha stri
 
 // - Rebase Helpers -
 
-// get_annotated_commits retrieves annotated commits for the base and head of a rebase operation.
+// get_annotated_commits retrieves annotated commits for the base and head of a rebase operation. The base is the branch
+// being rebased, the head is either the sha or the name of the branch the base is rebased on.
 func (a *Branch) get_annotated_commits(
 	ctx context.Context, repo *git.Repository, base string, head string,
 ) (*git.AnnotatedCommit, *git.AnnotatedCommit, error) {
@@ -327,6 +428,19 @@ func (a *Branch) get_annotated_commits(
 		return nil, nil, fmt.Errorf("failed to get annotated commit from ref: %w", err)
 	}
 
+	if _, err := git.NewOid(head); err != nil {
+		if err := a.refresh_remote(ctx, repo, head); err != nil {
+			return nil, nil, fmt.Errorf("failed to refresh head branch: %w", err)
+		}
+
+		upstream, err := a.annotated_commit_from_ref(ctx, repo, head)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get annotated commit from head ref: %w", err)
+		}
+
+		return branch, upstream, nil
+	}
+
 	upstream, err := a.annotated_commit_from_oid(ctx, repo, head)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get annotated commit from sha: %w", err)
@@ -50,6 +50,10 @@ const (
 	SignalPullRequestReview        = defs.SignalPullRequestReview
 	SignalPullRequestReviewComment = defs.SignalPullRequestReviewComment
 	SignalMergeQueue               = defs.SignalMergeQueue
+	SignalTag                      = defs.SignalTag
+	SignalRelease                  = defs.SignalRelease
+	SignalDeployment               = defs.SignalDeployment
+	SignalDeploymentStatus         = defs.SignalDeploymentStatus
 )
 
 const (
@@ -18,3 +18,32 @@ func RebaseEventToMergeConflictEvent(
 		events.ActionFailure,
 	).SetPayload(payload)
 }
+
+// RebaseEventToMergeConflictResolvedEvent converts a Rebase event to a merge event, indicating that the previously
+// reported conflicts have been cleared.
+func RebaseEventToMergeConflictResolvedEvent(
+	rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase],
+	hook int32,
+	payload *eventsv1.Merge,
+) *events.Event[eventsv1.ChatHook, eventsv1.Merge] {
+	return events.NextWithHook[eventsv1.RepoHook, eventsv1.ChatHook, eventsv1.Rebase, eventsv1.Merge](
+		rebase,
+		eventsv1.ChatHook(hook),
+		events.ScopeMerge,
+		events.ActionResolved,
+	).SetPayload(payload)
+}
+
+// PullRequestEventToMergedEvent converts a completed pull request event to a chat event, indicating that the pull
+// request of the branch has been merged.
+func PullRequestEventToMergedEvent(
+	pr *events.Event[eventsv1.RepoHook, eventsv1.PullRequest],
+	hook int32,
+) *events.Event[eventsv1.ChatHook, eventsv1.PullRequest] {
+	return events.NextWithHook[eventsv1.RepoHook, eventsv1.ChatHook, eventsv1.PullRequest, eventsv1.PullRequest](
+		pr,
+		eventsv1.ChatHook(hook),
+		events.ScopePr,
+		events.ActionCompleted,
+	).SetPayload(pr.Payload)
+}
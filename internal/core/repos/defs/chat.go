@@ -0,0 +1,64 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// ChatPayload pairs a chat event with the thread of the branch it belongs to.
+	ChatPayload[P events.Payload] struct {
+		Event  *events.Event[eventsv1.ChatHook, P] `json:"event"`
+		Thread *kernel.ChatThread                  `json:"thread"`
+	}
+
+	// ThreadPayload identifies the thread of a branch to start on the chat platform of the hook. The parent message is
+	// posted in the channel the subject resolves to, see kernel.Chat.StartThread.
+	ThreadPayload struct {
+		Hook    eventsv1.ChatHook  `json:"hook"`
+		Subject events.Subject     `json:"subject"`
+		Source  string             `json:"source"`
+		Thread  *kernel.ChatThread `json:"thread"`
+	}
+)
+
+const (
+	// ThreadTargetTeam is the target of the threads started in the channel linked to the repo.
+	ThreadTargetTeam = "team"
+)
+
+// ForHook returns a copy of the payload with the event routed to the given hook. The thread is not carried over, since
+// threads are specific to a chat platform.
+func (p *ChatPayload[P]) ForHook(hook eventsv1.ChatHook) *ChatPayload[P] {
+	event := *p.Event
+	event.Context.Hook = hook
+
+	return &ChatPayload[P]{Event: &event}
+}
+
+// ForTeam returns a copy of the payload with the user removed from the event subject, so that the chat hook sends the
+// message to the channel linked to the repo instead of the user.
+func (p *ChatPayload[P]) ForTeam() *ChatPayload[P] {
+	event := *p.Event
+	event.Subject.UserID = uuid.Nil
+
+	return &ChatPayload[P]{Event: &event, Thread: p.Thread}
+}
+
+// ForThread returns the payload starting the thread the event is sent to.
+func (p *ChatPayload[P]) ForThread() *ThreadPayload {
+	return &ThreadPayload{Hook: p.Event.Context.Hook, Subject: p.Event.Subject, Source: p.Event.Context.Source, Thread: p.Thread}
+}
+
+// Target returns the target of the thread, the user the subject belongs to, or the team if the subject has no user. A
+// thread is specific to its target, see kernel.ChatThread.
+func (p *ThreadPayload) Target() string {
+	if p.Subject.UserID == uuid.Nil {
+		return ThreadTargetTeam
+	}
+
+	return "user:" + p.Subject.UserID.String()
+}
@@ -0,0 +1,96 @@
+package defs
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// NotifyKind is the kind of a notification sent to a user.
+	NotifyKind string
+
+	// NotifyChannel is where a user wants to receive notifications.
+	NotifyChannel string
+
+	// NotifyPreferences holds the notification preferences of a user. Quiet hours are given in minutes since midnight
+	// in the time zone of the user. If the start and end of the quiet hours are equal, there are no quiet hours.
+	NotifyPreferences struct {
+		Kinds      []NotifyKind  `json:"kinds"`
+		Channel    NotifyChannel `json:"channel"`
+		Timezone   string        `json:"timezone"`
+		QuietStart int32         `json:"quiet_start"`
+		QuietEnd   int32         `json:"quiet_end"`
+	}
+
+	// EmailPayload is the payload for notifying a user by email.
+	EmailPayload struct {
+		UserID  uuid.UUID `json:"user_id"`
+		Subject string    `json:"subject"`
+		Text    string    `json:"text"`
+	}
+)
+
+const (
+	NotifyKindLinesExceeded         NotifyKind = "lines_exceeded"
+	NotifyKindMergeConflict         NotifyKind = "merge_conflict"
+	NotifyKindMergeConflictResolved NotifyKind = "merge_conflict_resolved"
+)
+
+const (
+	NotifyChannelDM    NotifyChannel = "dm"    // NotifyChannelDM sends a direct message on the chat linked to the user.
+	NotifyChannelTeam  NotifyChannel = "team"  // NotifyChannelTeam sends to the channel linked to the repo.
+	NotifyChannelEmail NotifyChannel = "email" // NotifyChannelEmail sends an email to the user.
+)
+
+// NewNotifyPreferences returns the preferences of a user without any saved preferences. All kinds are sent as direct
+// messages at any hour.
+func NewNotifyPreferences() *NotifyPreferences {
+	return &NotifyPreferences{
+		Kinds:    []NotifyKind{NotifyKindLinesExceeded, NotifyKindMergeConflict, NotifyKindMergeConflictResolved},
+		Channel:  NotifyChannelDM,
+		Timezone: "UTC",
+	}
+}
+
+// Allows returns true if the user wants to receive notifications of the given kind.
+func (p *NotifyPreferences) Allows(kind NotifyKind) bool {
+	return slices.Contains(p.Kinds, kind)
+}
+
+// Delay returns how long a notification arriving at the given time must wait for the quiet hours to end. It is zero
+// outside quiet hours. Unknown time zones are treated as UTC.
+func (p *NotifyPreferences) Delay(now time.Time) time.Duration {
+	if p.QuietStart == p.QuietEnd {
+		return 0
+	}
+
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := now.In(loc)
+	minute := int32(local.Hour()*60 + local.Minute())
+
+	quiet := false
+	if p.QuietStart < p.QuietEnd {
+		quiet = minute >= p.QuietStart && minute < p.QuietEnd
+	} else {
+		quiet = minute >= p.QuietStart || minute < p.QuietEnd
+	}
+
+	if !quiet {
+		return 0
+	}
+
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	end := midnight.Add(time.Duration(p.QuietEnd) * time.Minute)
+
+	if !end.After(local) {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	return end.Sub(local)
+}
@@ -0,0 +1,69 @@
+package defs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+)
+
+type (
+	NotifyPreferencesTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *NotifyPreferencesTestSuite) at(hour, minute int) time.Time {
+	return time.Date(2024, time.March, 4, hour, minute, 0, 0, time.UTC)
+}
+
+func (s *NotifyPreferencesTestSuite) TestNoQuietHours() {
+	prefs := defs.NewNotifyPreferences()
+
+	s.Equal(time.Duration(0), prefs.Delay(s.at(3, 0)))
+}
+
+func (s *NotifyPreferencesTestSuite) TestSameDay() {
+	prefs := &defs.NotifyPreferences{Timezone: "UTC", QuietStart: 12 * 60, QuietEnd: 13 * 60}
+
+	s.Equal(time.Duration(0), prefs.Delay(s.at(11, 59)))
+	s.Equal(time.Hour, prefs.Delay(s.at(12, 0)))
+	s.Equal(15*time.Minute, prefs.Delay(s.at(12, 45)))
+	s.Equal(time.Duration(0), prefs.Delay(s.at(13, 0)))
+}
+
+func (s *NotifyPreferencesTestSuite) TestOvernight() {
+	prefs := &defs.NotifyPreferences{Timezone: "UTC", QuietStart: 22 * 60, QuietEnd: 7 * 60}
+
+	s.Equal(time.Duration(0), prefs.Delay(s.at(21, 0)))
+	s.Equal(9*time.Hour, prefs.Delay(s.at(22, 0)))
+	s.Equal(2*time.Hour, prefs.Delay(s.at(5, 0)))
+	s.Equal(time.Duration(0), prefs.Delay(s.at(7, 0)))
+}
+
+func (s *NotifyPreferencesTestSuite) TestTimezone() {
+	prefs := &defs.NotifyPreferences{Timezone: "Asia/Kolkata", QuietStart: 22 * 60, QuietEnd: 7 * 60}
+
+	// 18:00 UTC is 23:30 in Kolkata.
+	s.Equal(7*time.Hour+30*time.Minute, prefs.Delay(s.at(18, 0)))
+	s.Equal(time.Duration(0), prefs.Delay(s.at(6, 0)))
+}
+
+func (s *NotifyPreferencesTestSuite) TestUnknownTimezone() {
+	prefs := &defs.NotifyPreferences{Timezone: "Nowhere/Special", QuietStart: 12 * 60, QuietEnd: 13 * 60}
+
+	s.Equal(time.Hour, prefs.Delay(s.at(12, 0)))
+}
+
+func (s *NotifyPreferencesTestSuite) TestAllows() {
+	prefs := &defs.NotifyPreferences{Kinds: []defs.NotifyKind{defs.NotifyKindMergeConflict}}
+
+	s.True(prefs.Allows(defs.NotifyKindMergeConflict))
+	s.False(prefs.Allows(defs.NotifyKindLinesExceeded))
+}
+
+func TestNotifyPreferences(t *testing.T) {
+	suite.Run(t, new(NotifyPreferencesTestSuite))
+}
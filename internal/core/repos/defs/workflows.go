@@ -22,6 +22,21 @@ const (
 	SignalPullRequestReview        queues.Signal = "pr_review"         // signals a pull request review event.
 	SignalPullRequestReviewComment queues.Signal = "pr_review_comment" // signals a pull request review comment event.
 	SignalMergeQueue               queues.Signal = "merge_queue"       // signals a pull request queue event.
+	SignalTag                      queues.Signal = "tag"               // signals a tag event.
+	SignalRelease                  queues.Signal = "release"           // signals a release event.
+	SignalDeployment               queues.Signal = "deployment"        // signals a deployment event.
+	SignalDeploymentStatus         queues.Signal = "deployment_status" // signals a deployment status event.
+)
+
+// activities, the names the activities of the branch are registered with. The notifications held during the quiet hours
+// of a user are sent by name, so the names must match the methods of activities.Branch.
+const (
+	ActivityStartChatThread             = "StartChatThread"             // starts the thread of a branch on chat.
+	ActivityNotifyLinesExceeded         = "NotifyLinesExceeded"         // notifies that the changes exceed the threshold.
+	ActivityNotifyMergeConflict         = "NotifyMergeConflict"         // notifies a merge conflict.
+	ActivityNotifyMergeConflictResolved = "NotifyMergeConflictResolved" // notifies that a merge conflict is resolved.
+	ActivityNotifyMerged                = "NotifyMerged"                // notifies that the pull request is merged.
+	ActivityNotifyByEmail               = "NotifyByEmail"               // sends a notification by email.
 )
 
 const (
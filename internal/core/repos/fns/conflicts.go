@@ -0,0 +1,22 @@
+package fns
+
+// SameFiles returns true if both slices hold the same set of files, regardless of order.
+func SameFiles(a, b []string) bool {
+	return len(a) == len(b) && ContainsFiles(a, b) && ContainsFiles(b, a)
+}
+
+// ContainsFiles returns true if every file in subset is also present in files.
+func ContainsFiles(files, subset []string) bool {
+	set := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		set[file] = struct{}{}
+	}
+
+	for _, file := range subset {
+		if _, ok := set[file]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,66 @@
+package fns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/core/repos/fns"
+)
+
+type (
+	ConflictsTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *ConflictsTestSuite) TestSameFiles() {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{"both empty", nil, []string{}, true},
+		{"same order", []string{"a.go", "b.go"}, []string{"a.go", "b.go"}, true},
+		{"any order", []string{"a.go", "b.go"}, []string{"b.go", "a.go"}, true},
+		{"subset", []string{"a.go", "b.go"}, []string{"a.go"}, false},
+		{"superset", []string{"a.go"}, []string{"a.go", "b.go"}, false},
+		{"different", []string{"a.go"}, []string{"b.go"}, false},
+		{"duplicates", []string{"a.go", "a.go"}, []string{"a.go", "b.go"}, false},
+		{"one empty", []string{"a.go"}, nil, false},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, fns.SameFiles(tt.a, tt.b))
+		})
+	}
+}
+
+func (s *ConflictsTestSuite) TestContainsFiles() {
+	tests := []struct {
+		name   string
+		files  []string
+		subset []string
+		want   bool
+	}{
+		{"empty subset", []string{"a.go"}, nil, true},
+		{"both empty", nil, nil, true},
+		{"equal", []string{"a.go", "b.go"}, []string{"b.go", "a.go"}, true},
+		{"shrunk", []string{"a.go", "b.go", "c.go"}, []string{"b.go"}, true},
+		{"grown", []string{"a.go"}, []string{"a.go", "b.go"}, false},
+		{"disjoint", []string{"a.go"}, []string{"b.go"}, false},
+		{"empty files", nil, []string{"a.go"}, false},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, fns.ContainsFiles(tt.files, tt.subset))
+		})
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	suite.Run(t, new(ConflictsTestSuite))
+}
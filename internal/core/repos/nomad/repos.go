@@ -21,6 +21,16 @@ type (
 	}
 )
 
+var (
+	// RepoServicePolicy declares the permissions required by the procedures of the RepoService.
+	RepoServicePolicy = auth.Policy{
+		corev1connect.RepoServiceCreateRepoProcedure:         {auth.PermissionReposWrite},
+		corev1connect.RepoServiceGetRepoByIDProcedure:        {auth.PermissionReposRead},
+		corev1connect.RepoServiceGetOrgReposByOrgIDProcedure: {auth.PermissionReposRead},
+		corev1connect.RepoServiceListReposProcedure:          {auth.PermissionReposRead},
+	}
+)
+
 func (s *RepoService) ListRepos(
 	ctx context.Context, req *connect.Request[emptypb.Empty],
 ) (*connect.Response[corev1.ListReposResponse], error) {
@@ -37,5 +47,7 @@ func (s *RepoService) ListRepos(
 }
 
 func NewRepoServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	auth.Declare(RepoServicePolicy)
+
 	return corev1connect.NewRepoServiceHandler(&RepoService{}, opts...)
 }
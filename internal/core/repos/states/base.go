@@ -1,6 +1,7 @@
 package states
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"go.breu.io/durex/dispatch"
@@ -8,6 +9,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/events"
 )
 
 type (
@@ -25,10 +27,24 @@ type (
 
 // rx wraps workflow.ReceiveChannel.Receive, adding logging.  It receives a message
 // from the specified Temporal channel. The target parameter must be a pointer to the
-// data structure expected to be received.
-func (state *Base) rx(ctx workflow.Context, ch workflow.ReceiveChannel, target any) {
+// event expected to be received.
+//
+// The event is upcast to the current version of the schema before decoding, so that events sent by a previous release
+// are handled by workflows started before the upgrade. If the event cannot be decoded, the error is logged and returned,
+// and the handler must drop the signal, the target being left partially decoded.
+func (state *Base) rx(ctx workflow.Context, ch workflow.ReceiveChannel, target any) error {
 	state.logger.Info(fmt.Sprintf("rx: %s", ch.Name()))
-	ch.Receive(ctx, target)
+
+	raw := json.RawMessage{}
+	ch.Receive(ctx, &raw)
+
+	if err := events.Unmarshal(raw, target); err != nil {
+		state.logger.Error(fmt.Sprintf("rx: %s: unable to decode event, dropping", ch.Name()), "error", err.Error())
+
+		return err
+	}
+
+	return nil
 }
 
 // run wraps workflow.ExecuteActivity with logging with the default activity context. If you need to
@@ -48,6 +64,24 @@ func (state *Base) run(ctx workflow.Context, action string, activity, event, res
 	return nil
 }
 
+// run_ex wraps workflow.ExecuteActivity with logging, with the given activity options.
+func (state *Base) run_ex(
+	ctx workflow.Context, opts workflow.ActivityOptions, action string, activity, event, result any, keyvals ...any,
+) error {
+	state.logger.Info(fmt.Sprintf("dispatch(%s): init ...", action), keyvals...)
+
+	ctx = dispatch.WithCustomActivityContext(ctx, opts)
+
+	if err := workflow.ExecuteActivity(ctx, activity, event).Get(ctx, result); err != nil {
+		state.logger.Error(fmt.Sprintf("dispatch(%s): error", action), keyvals...)
+		return err
+	}
+
+	state.logger.Info(fmt.Sprintf("dispatch(%s): success", action), keyvals...)
+
+	return nil
+}
+
 // - public
 
 // RestartRecommended checks if the workflow should be continued as new.
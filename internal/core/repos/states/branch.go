@@ -1,12 +1,14 @@
 package states
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"go.temporal.io/sdk/workflow"
 
+	"go.breu.io/quantm/internal/core/kernel"
 	"go.breu.io/quantm/internal/core/repos/activities"
 	"go.breu.io/quantm/internal/core/repos/cast"
 	"go.breu.io/quantm/internal/core/repos/defs"
@@ -15,6 +17,7 @@ import (
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/durable/periodic"
 	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/webhook"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 	"go.breu.io/quantm/internal/pulse"
 )
@@ -28,12 +31,45 @@ type (
 	Branch struct {
 		*Base `json:"base"` // Base workflow state.
 
-		Branch       string           `json:"branch"`
-		LatestCommit *eventsv1.Commit `json:"latest_commit"`
+		Branch       string             `json:"branch"`
+		LatestCommit *eventsv1.Commit   `json:"latest_commit"`
+		Thread       *kernel.ChatThread `json:"thread"`   // Chat thread for the branch, started by the first notification.
+		Conflict     *eventsv1.Merge    `json:"conflict"` // Last reported merge conflict, nil when the branch is clean.
+
+		// Push is the last push to the branch, the parent of the stale events.
+		Push *events.Event[eventsv1.RepoHook, eventsv1.Push] `json:"push"`
+
+		// Stale is true once the branch went stale since the last push, so that the stale event is persisted once.
+		Stale bool `json:"stale"`
+
+		// Held are the notifications held until the quiet hours of their users end, in the order they were held.
+		Held []*HeldNotification `json:"held"`
 
 		intervals BranchIntervals
 		acts      *activities.Branch
 		done      bool
+		armed     time.Time          // time the release timer of the held notifications fires at, zero if no timer is armed.
+		webhooks  workflow.WaitGroup // webhooks being sent, see Drain.
+	}
+
+	// HeldNotification is a notification held until the quiet hours of the user end. The payload is kept encoded, so
+	// that the notification survives a continue-as-new along with the rest of the state.
+	HeldNotification struct {
+		Action   string             `json:"action"`
+		Activity string             `json:"activity"` // name of the activity sending the notification.
+		Channel  defs.NotifyChannel `json:"channel"`
+		Payload  json.RawMessage    `json:"payload"` // the chat event, or the email payload for the email channel.
+		Until    time.Time          `json:"until"`
+
+		// Start identifies the thread the chat notification is sent to, so that it is started before the notification.
+		// The thread is set when the notification is sent, it is nil for the email channel.
+		Start *defs.ThreadPayload `json:"start"`
+	}
+
+	// held_chat is the chat payload of a held notification, see defs.ChatPayload.
+	held_chat struct {
+		Event  json.RawMessage    `json:"event"`
+		Thread *kernel.ChatThread `json:"thread"`
 	}
 )
 
@@ -50,14 +86,15 @@ func (state *Branch) PullRequestMonitor(ctx workflow.Context) {
 	})
 }
 
-// StaleMonitor is a goroutine that monitors the branch for staleness. If the branch is stale, a notification is
-// sent to the hook associated with the branch.
+// StaleMonitor is a goroutine that monitors the branch for staleness. If the branch is stale, a stale event is
+// persisted and a notification is sent to the hook associated with the branch.
 //
 // TODO: implement the logic for sending a notification if the branch is stale.
 func (state *Branch) StaleMonitor(ctx workflow.Context) {
 	workflow.Go(ctx, func(ctx_ workflow.Context) {
 		for {
 			state.intervals.stale.Tick(ctx_)
+			state.stale(ctx_)
 			_ = state.notify_user(ctx)
 		}
 	})
@@ -69,9 +106,12 @@ func (state *Branch) StaleMonitor(ctx workflow.Context) {
 func (state *Branch) OnPush(ctx workflow.Context) durable.ChannelHandler {
 	return func(ch workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.Push]{}
-		state.rx(ctx, ch, event)
+		if err := state.rx(ctx, ch, event); err != nil {
+			return
+		}
 
 		state.intervals.stale.Reset(ctx)
+		state.Stale = false
 
 		opts := &workflow.SessionOptions{ExecutionTimeout: time.Minute * 30, CreationTimeout: time.Second * 30}
 
@@ -84,10 +124,25 @@ func (state *Branch) OnPush(ctx workflow.Context) durable.ChannelHandler {
 		defer workflow.CompleteSession(session)
 
 		state.LatestCommit = fns.GetLatestCommit(event.Payload)
+		state.Push = event
 
 		clone := &defs.ClonePayload{Repo: state.Repo, Hook: event.Context.Hook, Branch: state.Branch, SHA: event.Payload.After}
 		path := state.clone(session, clone)
 		diff := state.diff(session, path, state.Repo.DefaultBranch, event.Payload.After)
+
+		// a push may clear a previously reported conflict, so the branch is rebased again on the trunk.
+		if state.Conflict != nil {
+			rebase := events.
+				Next[eventsv1.RepoHook, eventsv1.Push, eventsv1.Rebase](event, events.ScopeRebase, events.ActionRequested).
+				SetPayload(&eventsv1.Rebase{
+					Base:       state.Branch,
+					Head:       state.Repo.DefaultBranch,
+					Repository: event.Payload.Repository,
+				})
+
+			state.check_merge_conflict(session, rebase, state.rebase(session, path, rebase))
+		}
+
 		state.remove_dir(ctx, path)
 
 		// compare the diff
@@ -100,7 +155,9 @@ func (state *Branch) OnPush(ctx workflow.Context) durable.ChannelHandler {
 func (state *Branch) OnRebase(ctx workflow.Context) durable.ChannelHandler {
 	return func(ch workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.Rebase]{}
-		state.rx(ctx, ch, event)
+		if err := state.rx(ctx, ch, event); err != nil {
+			return
+		}
 
 		opts := &workflow.SessionOptions{ExecutionTimeout: time.Minute * 30, CreationTimeout: time.Second * 30}
 
@@ -115,20 +172,34 @@ func (state *Branch) OnRebase(ctx workflow.Context) durable.ChannelHandler {
 		clone := &defs.ClonePayload{Repo: state.Repo, Hook: event.Context.Hook, Branch: state.Branch, SHA: event.Payload.Head}
 		path := state.clone(session, clone)
 
-		rebase := &defs.RebaseResult{}
-		_ = state.run(ctx, "rebase", state.acts.Rebase, &defs.RebasePayload{Rebase: event.Payload, Path: path}, rebase)
-
-		state.check_merge_conflict(session, event, rebase)
+		state.check_merge_conflict(session, event, state.rebase(session, path, event))
 
 		state.remove_dir(ctx, path)
 	}
 }
 
+// OnPR handles pull request events. Once the pull request of the branch is merged, the thread of the branch is marked
+// merged.
+func (state *Branch) OnPR(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		event := &events.Event[eventsv1.RepoHook, eventsv1.PullRequest]{}
+		if err := state.rx(ctx, rx, event); err != nil {
+			return
+		}
+
+		if event.Context.Action == events.ActionCompleted {
+			state.merged(ctx, event)
+		}
+	}
+}
+
 // OnLabel handles pull request label events.
 func (state *Branch) OnLabel(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestLabel]{}
-		state.rx(ctx, rx, event)
+		if err := state.rx(ctx, rx, event); err != nil {
+			return
+		}
 
 		switch event.Payload.Name {
 		case "qmerge":
@@ -145,7 +216,7 @@ func (state *Branch) OnLabel(ctx workflow.Context) durable.ChannelHandler {
 func (state *Branch) OnPrReview(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestReview]{}
-		state.rx(ctx, rx, event)
+		_ = state.rx(ctx, rx, event)
 	}
 }
 
@@ -153,13 +224,46 @@ func (state *Branch) OnPrReview(ctx workflow.Context) durable.ChannelHandler {
 func (state *Branch) OnPRReviewComment(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestReview]{}
-		state.rx(ctx, rx, event)
+		_ = state.rx(ctx, rx, event)
 	}
 }
 
-// ExitLoop returns true if the branch should exit the event loop.
+// ExitLoop returns true if the branch should exit the event loop. A branch that is done waits for its held
+// notifications to be sent, while held notifications are carried over on continue-as-new.
 func (state *Branch) ExitLoop(ctx workflow.Context) bool {
-	return state.done || workflow.GetInfo(ctx).GetContinueAsNewSuggested()
+	return (state.done && len(state.Held) == 0) || workflow.GetInfo(ctx).GetContinueAsNewSuggested()
+}
+
+// Drain waits for the webhooks still being sent, so that their retries are not cut short when the workflow completes or
+// continues as new.
+func (state *Branch) Drain(ctx workflow.Context) {
+	state.webhooks.Wait(ctx)
+}
+
+// ArmRelease adds a timer to the selector firing when the earliest held notification is due, so that the event loop
+// wakes up to send it. Nothing is added if no notification is held, or a timer firing no later is already armed.
+func (state *Branch) ArmRelease(ctx workflow.Context, selector workflow.Selector) {
+	if len(state.Held) == 0 {
+		return
+	}
+
+	until := state.Held[0].Until
+	for _, held := range state.Held[1:] {
+		if held.Until.Before(until) {
+			until = held.Until
+		}
+	}
+
+	if !state.armed.IsZero() && !state.armed.After(until) {
+		return
+	}
+
+	state.armed = until
+
+	selector.AddFuture(workflow.NewTimer(ctx, until.Sub(workflow.Now(ctx))), func(workflow.Future) {
+		state.armed = time.Time{}
+		state.release(ctx)
+	})
 }
 
 // Init initializes the branch state.
@@ -170,6 +274,11 @@ func (state *Branch) Init(ctx workflow.Context) {
 	stale := periodic.New(ctx, time.Minute*60*24)
 
 	state.intervals = BranchIntervals{pr: pr, stale: stale}
+	state.webhooks = workflow.NewWaitGroup(ctx)
+
+	if state.Thread == nil {
+		state.Thread = kernel.NewChatThread(state.Branch)
+	}
 }
 
 // clone clones the repository at the given SHA using a Temporal activity.  A UUID is generated for the clone path via SideEffect
@@ -202,6 +311,20 @@ func (state *Branch) diff(ctx workflow.Context, path, base, sha string) *eventsv
 	return result
 }
 
+// rebase rebases the cloned branch at path on top of the head given in the event using a Temporal activity. Returns
+// the rebase result.
+func (state *Branch) rebase(
+	ctx workflow.Context, path string, event *events.Event[eventsv1.RepoHook, eventsv1.Rebase],
+) *defs.RebaseResult {
+	result := &defs.RebaseResult{}
+
+	if err := state.run(ctx, "rebase", state.acts.Rebase, &defs.RebasePayload{Rebase: event.Payload, Path: path}, result); err != nil {
+		state.logger.Error("rebase: unable to rebase", "error", err.Error())
+	}
+
+	return result
+}
+
 // check the change diff and if it exceed from the threshold sends message to user other wise message to repo connected group.
 func (state *Branch) compare_diff(
 	ctx workflow.Context, push *events.Event[eventsv1.RepoHook, eventsv1.Push], diff *eventsv1.Diff,
@@ -221,41 +344,307 @@ func (state *Branch) compare_diff(
 			)
 		}
 
-		if err := state.run(ctx, "line_exceed", state.acts.NotifyLinesExceeded, event, nil); err != nil {
-			state.logger.Error("lines_exceed: unable to to send", "error", err.Error())
-		}
+		payload := &defs.ChatPayload[eventsv1.Diff]{Event: event, Thread: state.Thread}
+		subject := fmt.Sprintf("%s: changes on %s exceed the allowed threshold", state.Repo.Name, state.Branch)
+
+		notify(ctx, state, defs.NotifyKindLinesExceeded, "line_exceed", defs.ActivityNotifyLinesExceeded, payload, subject)
 	}
 }
 
-// check_merge_conflict check the merge conflict and send chat message otherwise nothing.
+// check_merge_conflict compares the conflicts of the rebase against the last reported conflict and notifies on chat.
+//
+//   - new or different conflicts are reported as a merge conflict.
+//   - if the conflicts shrink, an update listing only the remaining files is sent.
+//   - if a previously reported conflict is cleared, a resolved message is sent.
+//   - if the conflicts are unchanged, nothing is sent.
 func (state *Branch) check_merge_conflict(
 	ctx workflow.Context, rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase], res *defs.RebaseResult,
 ) {
-	if len(res.Conflicts) > 0 {
-		// check the repo's connected chat or user's connected chat.
-		hook := int32(eventsv1.ChatHook_CHAT_HOOK_SLACK)
+	previous := state.Conflict
 
-		// TODO - head and base commits
-		payload := &eventsv1.Merge{
-			HeadBranch: rebase.Payload.Head,
-			BaseBranch: rebase.Payload.Base,
-			Files:      res.Conflicts,
+	if !res.HasConflicts() {
+		// the rebase failed for reasons other than conflicts, so we can't tell whether the branch is clean.
+		if previous == nil || (res.Status != defs.RebaseStatusSuccess && res.Status != defs.RebaseStatusUpToDate) {
+			return
 		}
 
-		event := cast.RebaseEventToMergeConflictEvent(rebase, hook, payload)
+		state.conflict_resolved(ctx, rebase, previous)
 
-		// persist chat event
-		if err := pulse.Persist(ctx, event); err != nil {
-			state.logger.Warn(
-				"attempt_merge: unable to persist merge event",
-				"repo", state.Repo.ID, "branch", payload.HeadBranch, "error", err.Error(),
-			)
+		return
+	}
+
+	if previous != nil && fns.SameFiles(previous.Files, res.Conflicts) {
+		return
+	}
+
+	// check the repo's connected chat or user's connected chat.
+	hook := int32(eventsv1.ChatHook_CHAT_HOOK_SLACK)
+
+	// the conflict is reported as for a pull request, the branch is the head and the trunk the base. Mind that the
+	// rebase is the other way around, the branch is rebased on the trunk. The head commit is the last pushed, the base
+	// commit is left out, the trunk is fetched by the rebase and its commit is not tracked by the branch.
+	payload := &eventsv1.Merge{
+		HeadBranch: state.Branch,
+		HeadCommit: state.LatestCommit,
+		BaseBranch: state.Repo.DefaultBranch,
+		Files:      res.Conflicts,
+	}
+
+	event := cast.RebaseEventToMergeConflictEvent(rebase, hook, payload)
+
+	if previous != nil && fns.ContainsFiles(previous.Files, res.Conflicts) {
+		event.SetActionUpdated()
+	}
+
+	// persist chat event
+	if err := pulse.Persist(ctx, event); err != nil {
+		state.logger.Warn(
+			"attempt_merge: unable to persist merge event",
+			"repo", state.Repo.ID, "branch", payload.HeadBranch, "error", err.Error(),
+		)
+	}
+
+	state.Conflict = payload
+
+	chat := &defs.ChatPayload[eventsv1.Merge]{Event: event, Thread: state.Thread}
+	subject := fmt.Sprintf("%s: %s has merge conflicts with %s", state.Repo.Name, payload.HeadBranch, payload.BaseBranch)
+
+	notify(ctx, state, defs.NotifyKindMergeConflict, "merge_conflict", defs.ActivityNotifyMergeConflict, chat, subject)
+}
+
+// conflict_resolved clears the last reported conflict, emits a resolved event and notifies on chat.
+func (state *Branch) conflict_resolved(
+	ctx workflow.Context, rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase], previous *eventsv1.Merge,
+) {
+	hook := int32(eventsv1.ChatHook_CHAT_HOOK_SLACK)
+
+	payload := &eventsv1.Merge{
+		HeadBranch: state.Branch,
+		HeadCommit: state.LatestCommit,
+		BaseBranch: state.Repo.DefaultBranch,
+		Files:      previous.Files,
+	}
+
+	event := cast.RebaseEventToMergeConflictResolvedEvent(rebase, hook, payload)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		state.logger.Warn(
+			"conflict_resolved: unable to persist merge event",
+			"repo", state.Repo.ID, "branch", state.Branch, "error", err.Error(),
+		)
+	}
+
+	state.Conflict = nil
+
+	chat := &defs.ChatPayload[eventsv1.Merge]{Event: event, Thread: state.Thread}
+	subject := fmt.Sprintf("%s: merge conflicts on %s are resolved", state.Repo.Name, state.Branch)
+
+	notify(
+		ctx, state, defs.NotifyKindMergeConflictResolved, "conflict_resolved", defs.ActivityNotifyMergeConflictResolved, chat, subject,
+	)
+}
+
+// merged notifies that the pull request of the branch has been merged. The webhook of the org is always sent to, the
+// chat only if the thread of the branch has been started, the message closing the thread.
+func (state *Branch) merged(ctx workflow.Context, pr *events.Event[eventsv1.RepoHook, eventsv1.PullRequest]) {
+	hook := int32(eventsv1.ChatHook_CHAT_HOOK_SLACK)
+	payload := &defs.ChatPayload[eventsv1.PullRequest]{Event: cast.PullRequestEventToMergedEvent(pr, hook), Thread: state.Thread}
+
+	state.webhook(ctx, "merged", defs.ActivityNotifyMerged, payload.ForHook(eventsv1.ChatHook_CHAT_HOOK_WEBHOOK))
+
+	if !state.Thread.IsStarted() {
+		return
+	}
+
+	// the thread is sent to as the target it was started for.
+	if state.Thread.Target == defs.ThreadTargetTeam {
+		payload = payload.ForTeam()
+	}
+
+	thread := &kernel.ChatThread{}
+
+	if err := state.run(ctx, "merged", defs.ActivityNotifyMerged, payload, thread); err != nil {
+		state.logger.Error("merged: unable to to send", "error", err.Error())
+		return
+	}
+
+	state.Thread = thread
+}
+
+// preferences returns the notification preferences of the user. If they can't be fetched, the defaults are used.
+func (state *Branch) preferences(ctx workflow.Context, user uuid.UUID) *defs.NotifyPreferences {
+	prefs := defs.NewNotifyPreferences()
+
+	if err := state.run(ctx, "preferences", state.acts.GetNotifyPreferences, user, prefs); err != nil {
+		state.logger.Warn("preferences: unable to get, using defaults", "user", user.String(), "error", err.Error())
+	}
+
+	return prefs
+}
+
+// notify sends the chat notification following the preferences of the user the event belongs to. The webhook of the
+// org is sent to right away, regardless of the preferences.
+//
+//   - kinds the user opted out of are not sent.
+//   - depending on the channel, the notification is sent as a direct message, to the channel of the repo, or by email.
+//   - during the quiet hours of the user, the notification is held in the state until the quiet hours end, see
+//     ArmRelease.
+func notify[P events.Payload](
+	ctx workflow.Context, state *Branch, kind defs.NotifyKind, action, activity string, payload *defs.ChatPayload[P],
+	subject string,
+) {
+	state.webhook(ctx, action, activity, payload.ForHook(eventsv1.ChatHook_CHAT_HOOK_WEBHOOK))
+
+	user := payload.Event.Subject.UserID
+	prefs := state.preferences(ctx, user)
+
+	if !prefs.Allows(kind) {
+		return
+	}
+
+	held := &HeldNotification{Action: action, Activity: activity, Channel: prefs.Channel}
+
+	var body any = payload.Event
+
+	switch prefs.Channel {
+	case defs.NotifyChannelEmail:
+		held.Activity = defs.ActivityNotifyByEmail
+		body = &defs.EmailPayload{
+			UserID:  user,
+			Subject: subject,
+			Text:    fmt.Sprintf("%s\n\n%s/tree/%s\n", subject, payload.Event.Context.Source, state.Branch),
 		}
+	case defs.NotifyChannelTeam:
+		payload = payload.ForTeam()
+		body = payload.Event
+	}
+
+	if prefs.Channel != defs.NotifyChannelEmail {
+		held.Start = payload.ForThread()
+		held.Start.Thread = nil
+	}
 
-		if err := state.run(ctx, "merge_conflict", state.acts.NotifyMergeConflict, event, nil); err != nil {
-			state.logger.Error("merge_conflict: unable to to send", "error", err.Error())
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		state.logger.Error(action+": unable to encode notification", "error", err.Error())
+		return
+	}
+
+	held.Payload = encoded
+
+	delay := prefs.Delay(workflow.Now(ctx))
+	if delay == 0 {
+		state.send(ctx, held)
+		return
+	}
+
+	state.logger.Info(action+": quiet hours, holding notification", "user", user.String(), "delay", delay.String())
+
+	held.Until = workflow.Now(ctx).Add(delay)
+	state.Held = append(state.Held, held)
+}
+
+// send sends the notification with its activity. Chat notifications are threaded under the current thread of the
+// branch, which may have been started while the notification was held. A thread that is not started yet, or that was
+// started for another target, is started first and kept in the state, so that the retries of the notification reply to
+// it.
+func (state *Branch) send(ctx workflow.Context, held *HeldNotification) {
+	if held.Channel == defs.NotifyChannelEmail {
+		if err := state.run(ctx, held.Action+"_email", held.Activity, held.Payload, nil); err != nil {
+			state.logger.Error(held.Action+": unable to send email", "error", err.Error())
 		}
+
+		return
 	}
+
+	if held.Start != nil {
+		target := held.Start.Target()
+
+		// the thread belongs to another target, e.g. the user switched from direct messages to the channel of the team.
+		if state.Thread.Target != target {
+			state.Thread = kernel.NewChatThread(state.Branch)
+			state.Thread.Target = target
+		}
+
+		if !state.Thread.IsStarted() {
+			start := *held.Start
+			start.Thread = state.Thread
+			started := &kernel.ChatThread{}
+
+			if err := state.run(ctx, held.Action+"_thread", defs.ActivityStartChatThread, &start, started); err != nil {
+				state.logger.Error(held.Action+": unable to start thread", "error", err.Error())
+				return
+			}
+
+			state.Thread = started
+		}
+	}
+
+	payload := &held_chat{Event: held.Payload, Thread: state.Thread}
+	thread := &kernel.ChatThread{}
+
+	if err := state.run(ctx, held.Action, held.Activity, payload, thread); err != nil {
+		state.logger.Error(held.Action+": unable to to send", "error", err.Error())
+		return
+	}
+
+	state.Thread = thread
+}
+
+// release sends the held notifications that are due, in the order they were held, and keeps the others.
+func (state *Branch) release(ctx workflow.Context) {
+	now := workflow.Now(ctx)
+	due := make([]*HeldNotification, 0, len(state.Held))
+	kept := make([]*HeldNotification, 0, len(state.Held))
+
+	for _, held := range state.Held {
+		if held.Until.After(now) {
+			kept = append(kept, held)
+		} else {
+			due = append(due, held)
+		}
+	}
+
+	state.Held = kept
+
+	for _, held := range due {
+		state.send(ctx, held)
+	}
+}
+
+// webhook sends the notification to the webhook configured for the org in the background, so that the retries of a
+// failing webhook do not hold the chat notification back. The webhook is independent of the chat platform connected to
+// the repo, so failures are logged and otherwise ignored.
+func (state *Branch) webhook(ctx workflow.Context, action string, activity, payload any) {
+	state.webhooks.Add(1)
+
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		defer state.webhooks.Done()
+
+		if err := state.run_ex(ctx, webhook.ActivityOptions(), action+"_webhook", activity, payload, nil); err != nil {
+			state.logger.Warn(action+": unable to send to webhook", "error", err.Error())
+		}
+	})
+}
+
+// stale persists a stale event when the branch goes stale, the event is the basis of the stale branches in the digests.
+// The event is persisted once per push, the ticks of a branch that stays stale are ignored. Nothing is persisted until
+// the branch has seen a push.
+func (state *Branch) stale(ctx workflow.Context) {
+	if state.Push == nil || state.Stale {
+		return
+	}
+
+	event := events.
+		Next[eventsv1.RepoHook, eventsv1.Push, eventsv1.GitRef](state.Push, events.ScopeBranch, events.ActionStale).
+		SetPayload(&eventsv1.GitRef{Ref: state.Push.Payload.Ref, Kind: "branch"})
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		state.logger.Warn("stale: unable to persist stale event", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+		return
+	}
+
+	state.Stale = true
 }
 
 func (state *Branch) notify_user(_ workflow.Context) error { return nil }
@@ -264,5 +653,5 @@ func (state *Branch) notify_user(_ workflow.Context) error { return nil }
 func NewBranch(repo *entities.Repo, chat *entities.ChatLink, branch string) *Branch {
 	base := &Base{Repo: repo, ChatLink: chat}
 
-	return &Branch{Base: base, Branch: branch, acts: &activities.Branch{}}
+	return &Branch{Base: base, Branch: branch, Thread: kernel.NewChatThread(branch), acts: &activities.Branch{}}
 }
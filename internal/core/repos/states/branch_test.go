@@ -0,0 +1,358 @@
+package states_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/repos/activities"
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/core/repos/states"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	BranchTestSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		env *testsuite.TestWorkflowEnvironment
+	}
+)
+
+func (s *BranchTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *BranchTestSuite) Test_ReleaseHeld() {
+	start := s.env.Now()
+	sent := make([]string, 0)
+	started := s.start_thread()
+
+	s.env.RegisterActivityWithOptions(
+		func(_ context.Context, payload *defs.ChatPayload[eventsv1.Merge]) (*kernel.ChatThread, error) {
+			sent = append(sent, payload.Event.Payload.GetHeadBranch())
+
+			thread := *payload.Thread
+			thread.ID = payload.Event.Payload.GetHeadBranch()
+
+			return &thread, nil
+		},
+		activity.RegisterOptions{Name: "NotifyMergeConflict"},
+	)
+
+	state := states.NewBranch(nil, nil, "feature")
+	state.Held = []*states.HeldNotification{
+		s.held("later", start.Add(2*time.Hour)),
+		s.held("sooner", start.Add(time.Hour)),
+	}
+
+	s.env.ExecuteWorkflow(ReleaseTestWorkflow, state)
+
+	if s.True(s.env.IsWorkflowCompleted()) && s.NoError(s.env.GetWorkflowError()) {
+		result := &states.Branch{}
+
+		s.NoError(s.env.GetWorkflowResult(result))
+		s.Empty(result.Held)
+		s.Equal("later", result.Thread.ID)
+		s.Equal([]string{"sooner", "later"}, sent)
+		s.Equal(1, *started)
+		s.GreaterOrEqual(s.env.Now().Sub(start), 2*time.Hour)
+	}
+}
+
+func (s *BranchTestSuite) Test_StartThreadOnce() {
+	attempts := 0
+	started := s.start_thread()
+
+	s.env.RegisterActivityWithOptions(
+		func(_ context.Context, payload *defs.ChatPayload[eventsv1.Merge]) (*kernel.ChatThread, error) {
+			attempts++
+			if attempts == 1 {
+				return nil, errors.New("reply failed")
+			}
+
+			s.True(payload.Thread.IsStarted())
+
+			thread := *payload.Thread
+			thread.Status = kernel.ChatThreadStatusMergeConflict
+
+			return &thread, nil
+		},
+		activity.RegisterOptions{Name: "NotifyMergeConflict"},
+	)
+
+	state := states.NewBranch(nil, nil, "feature")
+	state.Held = []*states.HeldNotification{s.held("feature", s.env.Now().Add(time.Hour))}
+
+	s.env.ExecuteWorkflow(ReleaseTestWorkflow, state)
+
+	if s.True(s.env.IsWorkflowCompleted()) && s.NoError(s.env.GetWorkflowError()) {
+		result := &states.Branch{}
+
+		s.NoError(s.env.GetWorkflowResult(result))
+		s.Equal(2, attempts)
+		s.Equal(1, *started)
+		s.Equal("parent", result.Thread.ID)
+		s.Equal(kernel.ChatThreadStatusMergeConflict, result.Thread.Status)
+	}
+}
+
+func (s *BranchTestSuite) Test_DropUndecodable() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(defs.SignalPullRequestLabel.String(), "not an event")
+	}, time.Millisecond*50)
+
+	s.env.ExecuteWorkflow(LabelTestWorkflow, states.NewBranch(nil, nil, "feature"))
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *BranchTestSuite) Test_Merged() {
+	tests := []struct {
+		name    string
+		started bool
+		hooks   []eventsv1.ChatHook
+	}{
+		{"started thread", true, []eventsv1.ChatHook{eventsv1.ChatHook_CHAT_HOOK_WEBHOOK, eventsv1.ChatHook_CHAT_HOOK_SLACK}},
+		{"thread not started", false, []eventsv1.ChatHook{eventsv1.ChatHook_CHAT_HOOK_WEBHOOK}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.SetupTest()
+
+			hooks := make([]eventsv1.ChatHook, 0)
+
+			s.env.RegisterActivityWithOptions(
+				func(_ context.Context, payload *defs.ChatPayload[eventsv1.PullRequest]) (*kernel.ChatThread, error) {
+					hooks = append(hooks, payload.Event.Context.Hook)
+
+					if payload.Thread == nil {
+						return nil, nil
+					}
+
+					thread := *payload.Thread
+					thread.Status = kernel.ChatThreadStatusMerged
+
+					return &thread, nil
+				},
+				activity.RegisterOptions{Name: "NotifyMerged"},
+			)
+
+			pr := events.
+				New[eventsv1.RepoHook, eventsv1.PullRequest]().
+				SetScope(events.ScopePr).
+				SetAction(events.ActionCompleted).
+				SetPayload(&eventsv1.PullRequest{Number: 42, HeadBranch: "feature"})
+
+			s.env.RegisterDelayedCallback(func() {
+				s.env.SignalWorkflow(defs.SignalPullRequest.String(), pr)
+			}, time.Millisecond*50)
+
+			state := states.NewBranch(nil, nil, "feature")
+			if tt.started {
+				state.Thread.Channel, state.Thread.ID = "C0", "1700000000.000100"
+			}
+
+			s.env.ExecuteWorkflow(PullRequestTestWorkflow, state)
+
+			if s.True(s.env.IsWorkflowCompleted()) && s.NoError(s.env.GetWorkflowError()) {
+				result := &states.Branch{}
+
+				s.NoError(s.env.GetWorkflowResult(result))
+				s.ElementsMatch(tt.hooks, hooks)
+
+				if tt.started {
+					s.Equal(kernel.ChatThreadStatusMerged, result.Thread.Status)
+				} else {
+					s.Empty(result.Thread.Status)
+				}
+			}
+		})
+	}
+}
+
+func (s *BranchTestSuite) Test_StaleOnce() {
+	persisted := 0
+
+	s.env.RegisterActivityWithOptions(
+		func(_ context.Context, flat events.Flat[eventsv1.RepoHook]) error {
+			if flat.Scope == events.ScopeBranch && flat.Action == events.ActionStale {
+				persisted++
+			}
+
+			return nil
+		},
+		activity.RegisterOptions{Name: "PersistRepoEvent"},
+	)
+
+	state := states.NewBranch(nil, nil, "feature")
+	state.Push = events.
+		New[eventsv1.RepoHook, eventsv1.Push]().
+		SetScope(events.ScopePush).
+		SetAction(events.ActionCreated).
+		SetPayload(&eventsv1.Push{Ref: "refs/heads/feature"})
+
+	s.env.ExecuteWorkflow(StaleTestWorkflow, state)
+
+	if s.True(s.env.IsWorkflowCompleted()) && s.NoError(s.env.GetWorkflowError()) {
+		result := &states.Branch{}
+
+		s.NoError(s.env.GetWorkflowResult(result))
+		s.True(result.Stale)
+		s.Equal(1, persisted)
+	}
+}
+
+func (s *BranchTestSuite) Test_ThreadPerTarget() {
+	start := s.env.Now()
+	started := s.start_thread()
+	threads := make([]string, 0)
+
+	s.env.RegisterActivityWithOptions(
+		func(_ context.Context, payload *defs.ChatPayload[eventsv1.Merge]) (*kernel.ChatThread, error) {
+			threads = append(threads, payload.Thread.Target)
+
+			return payload.Thread, nil
+		},
+		activity.RegisterOptions{Name: "NotifyMergeConflict"},
+	)
+
+	user := uuid.New()
+	dm := s.held("dm", start.Add(time.Hour))
+	dm.Start.Subject.UserID = user
+	again := s.held("dm again", start.Add(2*time.Hour))
+	again.Start.Subject.UserID = user
+
+	state := states.NewBranch(nil, nil, "feature")
+	state.Held = []*states.HeldNotification{dm, again, s.held("team", start.Add(3*time.Hour))}
+
+	s.env.ExecuteWorkflow(ReleaseTestWorkflow, state)
+
+	if s.True(s.env.IsWorkflowCompleted()) && s.NoError(s.env.GetWorkflowError()) {
+		result := &states.Branch{}
+
+		s.NoError(s.env.GetWorkflowResult(result))
+		s.Equal([]string{"user:" + user.String(), "user:" + user.String(), defs.ThreadTargetTeam}, threads)
+		s.Equal(2, *started)
+		s.Equal(defs.ThreadTargetTeam, result.Thread.Target)
+	}
+}
+
+func (s *BranchTestSuite) Test_ActivityNames() {
+	acts := reflect.TypeOf(&activities.Branch{})
+
+	for _, name := range []string{
+		defs.ActivityStartChatThread,
+		defs.ActivityNotifyLinesExceeded,
+		defs.ActivityNotifyMergeConflict,
+		defs.ActivityNotifyMergeConflictResolved,
+		defs.ActivityNotifyMerged,
+		defs.ActivityNotifyByEmail,
+	} {
+		_, ok := acts.MethodByName(name)
+		s.True(ok, name)
+	}
+}
+
+// start_thread registers the activity starting the thread, and returns the number of threads started.
+func (s *BranchTestSuite) start_thread() *int {
+	started := 0
+
+	s.env.RegisterActivityWithOptions(
+		func(_ context.Context, payload *defs.ThreadPayload) (*kernel.ChatThread, error) {
+			started++
+
+			thread := *payload.Thread
+			thread.Channel, thread.ID = "C0", "parent"
+
+			return &thread, nil
+		},
+		activity.RegisterOptions{Name: "StartChatThread"},
+	)
+
+	return &started
+}
+
+func (s *BranchTestSuite) held(head string, until time.Time) *states.HeldNotification {
+	event := &events.Event[eventsv1.ChatHook, eventsv1.Merge]{Payload: &eventsv1.Merge{HeadBranch: head}}
+
+	payload, err := json.Marshal(event)
+	s.Require().NoError(err)
+
+	return &states.HeldNotification{
+		Action:   "merge_conflict",
+		Activity: "NotifyMergeConflict",
+		Channel:  defs.NotifyChannelDM,
+		Payload:  payload,
+		Until:    until,
+		Start:    &defs.ThreadPayload{Hook: eventsv1.ChatHook_CHAT_HOOK_SLACK, Source: "https://github.com/acme/api"},
+	}
+}
+
+func ReleaseTestWorkflow(ctx workflow.Context, state *states.Branch) (*states.Branch, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+	state.Init(ctx)
+
+	selector := workflow.NewSelector(ctx)
+
+	for len(state.Held) > 0 {
+		state.ArmRelease(ctx, selector)
+		selector.Select(ctx)
+	}
+
+	return state, nil
+}
+
+func StaleTestWorkflow(ctx workflow.Context, state *states.Branch) (*states.Branch, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+	state.Init(ctx)
+	state.StaleMonitor(ctx)
+
+	_ = workflow.Sleep(ctx, 4*24*time.Hour)
+
+	return state, nil
+}
+
+func LabelTestWorkflow(ctx workflow.Context, state *states.Branch) error {
+	state.Init(ctx)
+
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(workflow.GetSignalChannel(ctx, defs.SignalPullRequestLabel.String()), state.OnLabel(ctx))
+	selector.Select(ctx)
+
+	return nil
+}
+
+func PullRequestTestWorkflow(ctx workflow.Context, state *states.Branch) (*states.Branch, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+	state.Init(ctx)
+
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(workflow.GetSignalChannel(ctx, defs.SignalPullRequest.String()), state.OnPR(ctx))
+	selector.Select(ctx)
+
+	state.Drain(ctx)
+
+	return state, nil
+}
+
+func TestBranchSuite(t *testing.T) {
+	suite.Run(t, new(BranchTestSuite))
+}
@@ -38,7 +38,9 @@ type (
 func (state *Repo) OnPush(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		push := &events.Event[eventsv1.RepoHook, eventsv1.Push]{}
-		state.rx(ctx, rx, push)
+		if err := state.rx(ctx, rx, push); err != nil {
+			return
+		}
 
 		branch := fns.BranchNameFromRef(push.Payload.Ref)
 
@@ -59,7 +61,9 @@ func (state *Repo) OnPush(ctx workflow.Context) durable.ChannelHandler {
 func (state *Repo) OnRef(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		ref := &events.Event[eventsv1.RepoHook, eventsv1.GitRef]{}
-		state.rx(ctx, rx, ref)
+		if err := state.rx(ctx, rx, ref); err != nil {
+			return
+		}
 
 		if ref.Payload.Kind == "branch" {
 			branch := fns.BranchNameFromRef(ref.Payload.Ref)
@@ -79,11 +83,23 @@ func (state *Repo) OnRef(ctx workflow.Context) durable.ChannelHandler {
 	}
 }
 
-// OnPR handles the pull request event on the repository.
+// OnPR handles the pull request event on the repository. Merged pull requests are forwarded to their branch.
 func (state *Repo) OnPR(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		pr := &events.Event[eventsv1.RepoHook, eventsv1.PullRequest]{}
-		state.rx(ctx, rx, pr)
+		if err := state.rx(ctx, rx, pr); err != nil {
+			return
+		}
+
+		if pr.Context.Action != events.ActionCompleted {
+			return
+		}
+
+		branch := fns.BranchNameFromRef(pr.Payload.GetHeadBranch())
+
+		if err := state.forward_to_branch(ctx, defs.SignalPullRequest, branch, pr); err != nil {
+			state.logger.Warn("pr: unable to signal branch", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
+		}
 	}
 }
 
@@ -91,7 +107,7 @@ func (state *Repo) OnPR(ctx workflow.Context) durable.ChannelHandler {
 func (state *Repo) OnPRReview(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		label := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestReview]{}
-		state.rx(ctx, rx, label)
+		_ = state.rx(ctx, rx, label)
 	}
 }
 
@@ -99,19 +115,73 @@ func (state *Repo) OnPRReview(ctx workflow.Context) durable.ChannelHandler {
 func (state *Repo) OnPRReviewComment(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		label := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestReview]{}
-		state.rx(ctx, rx, label)
+		_ = state.rx(ctx, rx, label)
 	}
 }
 
 func (state *Repo) OnMergeQueue(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		mq := &events.Event[eventsv1.RepoHook, eventsv1.MergeQueue]{}
-		state.rx(ctx, rx, mq)
+		if err := state.rx(ctx, rx, mq); err != nil {
+			return
+		}
 
 		_ = state.forward_to_trunk(ctx, defs.SignalMergeQueue, mq)
 	}
 }
 
+// OnTag handles the tag event on the repository.
+func (state *Repo) OnTag(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		tag := &events.Event[eventsv1.RepoHook, eventsv1.Tag]{}
+		if err := state.rx(ctx, rx, tag); err != nil {
+			return
+		}
+
+		state.logger.Info("tag", "repo", state.Repo.ID, "tag", tag.Payload.Name, "action", tag.Context.Action)
+	}
+}
+
+// OnRelease handles the release event on the repository.
+func (state *Repo) OnRelease(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		release := &events.Event[eventsv1.RepoHook, eventsv1.Release]{}
+		if err := state.rx(ctx, rx, release); err != nil {
+			return
+		}
+
+		state.logger.Info("release", "repo", state.Repo.ID, "tag", release.Payload.Tag, "action", release.Context.Action)
+	}
+}
+
+// OnDeployment handles the deployment event on the repository.
+func (state *Repo) OnDeployment(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		deployment := &events.Event[eventsv1.RepoHook, eventsv1.Deployment]{}
+		if err := state.rx(ctx, rx, deployment); err != nil {
+			return
+		}
+
+		state.logger.Info(
+			"deployment", "repo", state.Repo.ID, "environment", deployment.Payload.Environment, "sha", deployment.Payload.Sha,
+		)
+	}
+}
+
+// OnDeploymentStatus handles the deployment status event on the repository.
+func (state *Repo) OnDeploymentStatus(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		status := &events.Event[eventsv1.RepoHook, eventsv1.DeploymentStatus]{}
+		if err := state.rx(ctx, rx, status); err != nil {
+			return
+		}
+
+		state.logger.Info(
+			"deployment_status", "repo", state.Repo.ID, "environment", status.Payload.Environment, "state", status.Payload.State,
+		)
+	}
+}
+
 // - query handlers -
 
 // QueryBranchTrigger queries the parent branch for the specified branch.
@@ -26,7 +26,9 @@ type (
 func (state Trunk) OnMergeQueue(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		mq := &events.Event[eventsv1.RepoHook, eventsv1.MergeQueue]{}
-		state.rx(ctx, rx, mq)
+		if err := state.rx(ctx, rx, mq); err != nil {
+			return
+		}
 
 		if mq.Context.Action == events.EventActionRemoved {
 			state.MergeQueue.Remove(ctx, mq.Payload.GetNumber())
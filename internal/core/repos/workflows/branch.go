@@ -25,6 +25,9 @@ func Branch(ctx workflow.Context, state *states.Branch) error {
 	rebase := workflow.GetSignalChannel(ctx, defs.SignalRebase.String())
 	selector.AddReceive(rebase, state.OnRebase(ctx))
 
+	pr := workflow.GetSignalChannel(ctx, defs.SignalPullRequest.String())
+	selector.AddReceive(pr, state.OnPR(ctx))
+
 	label := workflow.GetSignalChannel(ctx, defs.SignalPullRequestLabel.String())
 	selector.AddReceive(label, state.OnLabel(ctx))
 
@@ -37,9 +40,12 @@ func Branch(ctx workflow.Context, state *states.Branch) error {
 	// - event loop -
 
 	for !state.ExitLoop(ctx) {
+		state.ArmRelease(ctx, selector)
 		selector.Select(ctx)
 	}
 
+	state.Drain(ctx)
+
 	// - exit or continue -
 
 	if state.RestartRecommended(ctx) {
@@ -40,6 +40,18 @@ func Repo(ctx workflow.Context, state *states.Repo) error {
 	prrc := workflow.GetSignalChannel(ctx, defs.SignalPullRequestReviewComment.String())
 	selector.AddReceive(prrc, state.OnPRReviewComment(ctx))
 
+	tag := workflow.GetSignalChannel(ctx, defs.SignalTag.String())
+	selector.AddReceive(tag, state.OnTag(ctx))
+
+	release := workflow.GetSignalChannel(ctx, defs.SignalRelease.String())
+	selector.AddReceive(release, state.OnRelease(ctx))
+
+	deployment := workflow.GetSignalChannel(ctx, defs.SignalDeployment.String())
+	selector.AddReceive(deployment, state.OnDeployment(ctx))
+
+	status := workflow.GetSignalChannel(ctx, defs.SignalDeploymentStatus.String())
+	selector.AddReceive(status, state.OnDeploymentStatus(ctx))
+
 	// - event loop -
 
 	for !state.RestartRecommended(ctx) {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"go.breu.io/quantm/internal/db/fields"
 )
 
 type TeamRole string
@@ -55,6 +56,36 @@ func (ns NullTeamRole) Value() (driver.Value, error) {
 	return string(ns.TeamRole), nil
 }
 
+type ApiToken struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Kind       string    `json:"kind"`
+	Name       string    `json:"name"`
+	Hash       string    `json:"hash"`
+	Scopes     []string  `json:"scopes"`
+	OrgID      uuid.UUID `json:"org_id"`
+	UserID     uuid.UUID `json:"user_id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	IsRevoked  bool      `json:"is_revoked"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+type AuditLog struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	OrgID      uuid.UUID `json:"org_id"`
+	ActorKind  string    `json:"actor_kind"`
+	ActorID    string    `json:"actor_id"`
+	Action     string    `json:"action"`
+	TargetKind string    `json:"target_kind"`
+	TargetID   string    `json:"target_id"`
+	Before     []byte    `json:"before"`
+	After      []byte    `json:"after"`
+	RequestID  string    `json:"request_id"`
+	Ip         string    `json:"ip"`
+}
+
 type ChatLink struct {
 	ID        uuid.UUID `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
@@ -65,6 +96,18 @@ type ChatLink struct {
 	Data      []byte    `json:"data"`
 }
 
+type EventOutbox struct {
+	ID            uuid.UUID `json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	EventID       uuid.UUID `json:"event_id"`
+	OrgID         uuid.UUID `json:"org_id"`
+	Record        []byte    `json:"record"`
+	Attempts      int32     `json:"attempts"`
+	Error         string    `json:"error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+}
+
 type GithubInstallation struct {
 	ID                  uuid.UUID `json:"id"`
 	CreatedAt           time.Time `json:"created_at"`
@@ -132,6 +175,51 @@ type Org struct {
 	Hooks     []byte    `json:"hooks"`
 }
 
+type OrgDomain struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	OrgID      uuid.UUID `json:"org_id"`
+	Domain     string    `json:"domain"`
+	Token      string    `json:"token"`
+	IsVerified bool      `json:"is_verified"`
+	AutoJoin   bool      `json:"auto_join"`
+}
+
+type OrgInvitation struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	OrgID      uuid.UUID `json:"org_id"`
+	Email      string    `json:"email"`
+	Role       string    `json:"role"`
+	Hash       string    `json:"hash"`
+	InvitedBy  uuid.UUID `json:"invited_by"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	IsAccepted bool      `json:"is_accepted"`
+	IsRevoked  bool      `json:"is_revoked"`
+}
+
+type OrgMember struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserID    uuid.UUID `json:"user_id"`
+	OrgID     uuid.UUID `json:"org_id"`
+}
+
+type OrgSsoConfig struct {
+	ID             uuid.UUID        `json:"id"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	OrgID          uuid.UUID        `json:"org_id"`
+	Issuer         string           `json:"issuer"`
+	ClientID       string           `json:"client_id"`
+	ClientSecret   fields.Sensitive `json:"client_secret"`
+	AllowedDomains []string         `json:"allowed_domains"`
+	IsRequired     bool             `json:"is_required"`
+}
+
 type Repo struct {
 	ID            uuid.UUID       `json:"id"`
 	CreatedAt     time.Time       `json:"created_at"`
@@ -148,6 +236,41 @@ type Repo struct {
 	IsActive      bool            `json:"is_active"`
 }
 
+type ServiceKey struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Name       string    `json:"name"`
+	Hash       string    `json:"hash"`
+	Scopes     []string  `json:"scopes"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	IsRevoked  bool      `json:"is_revoked"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+type Session struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Jti        string    `json:"jti"`
+	UserID     uuid.UUID `json:"user_id"`
+	OrgID      uuid.UUID `json:"org_id"`
+	IssuedAt   time.Time `json:"issued_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Ip         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	IsSso      bool      `json:"is_sso"`
+	IsRevoked  bool      `json:"is_revoked"`
+}
+
+type SessionRevocation struct {
+	UserID        uuid.UUID `json:"user_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	RevokedBefore time.Time `json:"revoked_before"`
+}
+
 type Team struct {
 	ID        uuid.UUID `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
@@ -157,6 +280,26 @@ type Team struct {
 	Slug      string    `json:"slug"`
 }
 
+type TeamDigest struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	TeamID     uuid.UUID `json:"team_id"`
+	Email      string    `json:"email"`
+	Frequency  string    `json:"frequency"`
+	IsActive   bool      `json:"is_active"`
+	LastSentAt time.Time `json:"last_sent_at"`
+}
+
+type TeamRepo struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	TeamID    uuid.UUID `json:"team_id"`
+	RepoID    uuid.UUID `json:"repo_id"`
+	Path      string    `json:"path"`
+}
+
 type TeamUser struct {
 	ID        uuid.UUID `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
@@ -182,6 +325,29 @@ type User struct {
 	IsVerified bool      `json:"is_verified"`
 }
 
+type UserDigest struct {
+	ID           uuid.UUID `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	UserID       uuid.UUID `json:"user_id"`
+	Frequency    string    `json:"frequency"`
+	IncludeTeams bool      `json:"include_teams"`
+	IsActive     bool      `json:"is_active"`
+	LastSentAt   time.Time `json:"last_sent_at"`
+}
+
+type UserNotificationPreference struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	UserID     uuid.UUID `json:"user_id"`
+	Kinds      []string  `json:"kinds"`
+	Channel    string    `json:"channel"`
+	Timezone   string    `json:"timezone"`
+	QuietStart int32     `json:"quiet_start"`
+	QuietEnd   int32     `json:"quiet_end"`
+}
+
 type UserRole struct {
 	ID        uuid.UUID `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
@@ -190,3 +356,24 @@ type UserRole struct {
 	UserID    uuid.UUID `json:"user_id"`
 	OrgID     uuid.UUID `json:"org_id"`
 }
+
+type Webhook struct {
+	ID        uuid.UUID        `json:"id"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+	OrgID     uuid.UUID        `json:"org_id"`
+	Url       string           `json:"url"`
+	Secret    fields.Sensitive `json:"secret"`
+	IsActive  bool             `json:"is_active"`
+}
+
+type WebhookDeadLetter struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	WebhookID uuid.UUID `json:"webhook_id"`
+	EventID   uuid.UUID `json:"event_id"`
+	Attempts  int32     `json:"attempts"`
+	Error     string    `json:"error"`
+	Payload   []byte    `json:"payload"`
+}
@@ -38,6 +38,27 @@ func (q *Queries) CreateOrg(ctx context.Context, arg CreateOrgParams) (Org, erro
 	return i, err
 }
 
+const getOrg = `-- name: GetOrg :one
+SELECT id, created_at, updated_at, name, domain, slug, hooks
+FROM orgs
+WHERE id = $1
+`
+
+func (q *Queries) GetOrg(ctx context.Context, id uuid.UUID) (Org, error) {
+	row := q.db.QueryRow(ctx, getOrg, id)
+	var i Org
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.Domain,
+		&i.Slug,
+		&i.Hooks,
+	)
+	return i, err
+}
+
 const getOrgByDomain = `-- name: GetOrgByDomain :one
 SELECT id, created_at, updated_at, name, domain, slug, hooks
 FROM orgs
@@ -72,6 +93,32 @@ func (q *Queries) GetOrgSlugByID(ctx context.Context, id uuid.UUID) (string, err
 	return slug, err
 }
 
+const listOrgSlugs = `-- name: ListOrgSlugs :many
+SELECT slug
+FROM orgs
+ORDER BY created_at
+`
+
+func (q *Queries) ListOrgSlugs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listOrgSlugs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var slug string
+		if err := rows.Scan(&slug); err != nil {
+			return nil, err
+		}
+		items = append(items, slug)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setOrgHooks = `-- name: SetOrgHooks :exec
 UPDATE orgs
 SET hooks = $2
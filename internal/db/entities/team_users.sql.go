@@ -47,6 +47,64 @@ func (q *Queries) CreateTeamUser(ctx context.Context, arg CreateTeamUserParams)
 	return i, err
 }
 
+const deleteOrgTeamMembers = `-- name: DeleteOrgTeamMembers :exec
+DELETE FROM team_users AS tu
+USING teams AS team
+WHERE tu.team_id = team.id AND tu.user_id = $1 AND team.org_id = $2
+`
+
+type DeleteOrgTeamMembersParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	OrgID  uuid.UUID `json:"org_id"`
+}
+
+func (q *Queries) DeleteOrgTeamMembers(ctx context.Context, arg DeleteOrgTeamMembersParams) error {
+	_, err := q.db.Exec(ctx, deleteOrgTeamMembers, arg.UserID, arg.OrgID)
+	return err
+}
+
+const deleteTeamMember = `-- name: DeleteTeamMember :exec
+DELETE FROM team_users
+WHERE team_id = $1 AND user_id = $2
+`
+
+type DeleteTeamMemberParams struct {
+	TeamID uuid.UUID `json:"team_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteTeamMember(ctx context.Context, arg DeleteTeamMemberParams) error {
+	_, err := q.db.Exec(ctx, deleteTeamMember, arg.TeamID, arg.UserID)
+	return err
+}
+
+const getTeamMember = `-- name: GetTeamMember :one
+SELECT id, created_at, updated_at, team_id, user_id, role, is_active, is_admin
+FROM team_users
+WHERE team_id = $1 AND user_id = $2
+`
+
+type GetTeamMemberParams struct {
+	TeamID uuid.UUID `json:"team_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetTeamMember(ctx context.Context, arg GetTeamMemberParams) (TeamUser, error) {
+	row := q.db.QueryRow(ctx, getTeamMember, arg.TeamID, arg.UserID)
+	var i TeamUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TeamID,
+		&i.UserID,
+		&i.Role,
+		&i.IsActive,
+		&i.IsAdmin,
+	)
+	return i, err
+}
+
 const getTeamUser = `-- name: GetTeamUser :one
 SELECT id, created_at, updated_at, team_id, user_id, role, is_active, is_admin
 FROM team_users
@@ -68,3 +126,151 @@ func (q *Queries) GetTeamUser(ctx context.Context, userID uuid.UUID) (TeamUser,
 	)
 	return i, err
 }
+
+const listTeamIDsByUserID = `-- name: ListTeamIDsByUserID :many
+SELECT team_id
+FROM team_users
+WHERE user_id = $1 AND is_active = true
+`
+
+func (q *Queries) ListTeamIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listTeamIDsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var team_id uuid.UUID
+		if err := rows.Scan(&team_id); err != nil {
+			return nil, err
+		}
+		items = append(items, team_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamMembers = `-- name: ListTeamMembers :many
+SELECT tu.id, tu.created_at, tu.updated_at, tu.team_id, tu.user_id, tu.role, tu.is_active, tu.is_admin, u.id, u.created_at, u.updated_at, u.org_id, u.email, u.first_name, u.last_name, u.password, u.picture, u.is_active, u.is_verified
+FROM team_users AS tu
+JOIN users AS u
+  ON tu.user_id = u.id
+WHERE tu.team_id = $1
+ORDER BY u.email
+`
+
+type ListTeamMembersRow struct {
+	TeamUser TeamUser `json:"team_user"`
+	User     User     `json:"user"`
+}
+
+func (q *Queries) ListTeamMembers(ctx context.Context, teamID uuid.UUID) ([]ListTeamMembersRow, error) {
+	rows, err := q.db.Query(ctx, listTeamMembers, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamMembersRow
+	for rows.Next() {
+		var i ListTeamMembersRow
+		if err := rows.Scan(
+			&i.TeamUser.ID,
+			&i.TeamUser.CreatedAt,
+			&i.TeamUser.UpdatedAt,
+			&i.TeamUser.TeamID,
+			&i.TeamUser.UserID,
+			&i.TeamUser.Role,
+			&i.TeamUser.IsActive,
+			&i.TeamUser.IsAdmin,
+			&i.User.ID,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.OrgID,
+			&i.User.Email,
+			&i.User.FirstName,
+			&i.User.LastName,
+			&i.User.Password,
+			&i.User.Picture,
+			&i.User.IsActive,
+			&i.User.IsVerified,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamRolesByUserID = `-- name: ListTeamRolesByUserID :many
+SELECT DISTINCT tu.role
+FROM team_users AS tu
+JOIN teams AS team
+  ON tu.team_id = team.id
+WHERE tu.user_id = $1 AND team.org_id = $2 AND tu.is_active = true
+`
+
+type ListTeamRolesByUserIDParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	OrgID  uuid.UUID `json:"org_id"`
+}
+
+func (q *Queries) ListTeamRolesByUserID(ctx context.Context, arg ListTeamRolesByUserIDParams) ([]TeamRole, error) {
+	rows, err := q.db.Query(ctx, listTeamRolesByUserID, arg.UserID, arg.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamRole
+	for rows.Next() {
+		var role TeamRole
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTeamMember = `-- name: UpdateTeamMember :one
+UPDATE team_users
+SET role = $3, is_admin = $4, is_active = true
+WHERE team_id = $1 AND user_id = $2
+RETURNING id, created_at, updated_at, team_id, user_id, role, is_active, is_admin
+`
+
+type UpdateTeamMemberParams struct {
+	TeamID  uuid.UUID `json:"team_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	Role    TeamRole  `json:"role"`
+	IsAdmin bool      `json:"is_admin"`
+}
+
+func (q *Queries) UpdateTeamMember(ctx context.Context, arg UpdateTeamMemberParams) (TeamUser, error) {
+	row := q.db.QueryRow(ctx, updateTeamMember,
+		arg.TeamID,
+		arg.UserID,
+		arg.Role,
+		arg.IsAdmin,
+	)
+	var i TeamUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TeamID,
+		&i.UserID,
+		&i.Role,
+		&i.IsActive,
+		&i.IsAdmin,
+	)
+	return i, err
+}
@@ -12,18 +12,19 @@ import (
 )
 
 const createTeam = `-- name: CreateTeam :one
-INSERT INTO teams (name, org_id)
-VALUES ($1, $2)
+INSERT INTO teams (name, org_id, slug)
+VALUES ($1, $2, $3)
 RETURNING id, created_at, updated_at, org_id, name, slug
 `
 
 type CreateTeamParams struct {
 	Name  string    `json:"name"`
 	OrgID uuid.UUID `json:"org_id"`
+	Slug  string    `json:"slug"`
 }
 
 func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error) {
-	row := q.db.QueryRow(ctx, createTeam, arg.Name, arg.OrgID)
+	row := q.db.QueryRow(ctx, createTeam, arg.Name, arg.OrgID, arg.Slug)
 	var i Team
 	err := row.Scan(
 		&i.ID,
@@ -105,6 +106,40 @@ func (q *Queries) GetTeamBySlug(ctx context.Context, slug string) (GetTeamBySlug
 	return i, err
 }
 
+const listTeamsByOrgID = `-- name: ListTeamsByOrgID :many
+SELECT id, created_at, updated_at, org_id, name, slug
+FROM teams
+WHERE org_id = $1
+ORDER BY name
+`
+
+func (q *Queries) ListTeamsByOrgID(ctx context.Context, orgID uuid.UUID) ([]Team, error) {
+	rows, err := q.db.Query(ctx, listTeamsByOrgID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Team
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrgID,
+			&i.Name,
+			&i.Slug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateTeam = `-- name: UpdateTeam :one
 UPDATE teams
 SET name = $2
@@ -35,3 +35,82 @@ func (q *Queries) CreateUserRole(ctx context.Context, arg CreateUserRoleParams)
 	)
 	return i, err
 }
+
+const deleteUserRoles = `-- name: DeleteUserRoles :exec
+DELETE FROM user_roles
+WHERE user_id = $1 AND org_id = $2
+`
+
+type DeleteUserRolesParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	OrgID  uuid.UUID `json:"org_id"`
+}
+
+func (q *Queries) DeleteUserRoles(ctx context.Context, arg DeleteUserRolesParams) error {
+	_, err := q.db.Exec(ctx, deleteUserRoles, arg.UserID, arg.OrgID)
+	return err
+}
+
+const listUserRoleNames = `-- name: ListUserRoleNames :many
+SELECT name
+FROM user_roles
+WHERE user_id = $1 AND org_id = $2
+`
+
+type ListUserRoleNamesParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	OrgID  uuid.UUID `json:"org_id"`
+}
+
+func (q *Queries) ListUserRoleNames(ctx context.Context, arg ListUserRoleNamesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUserRoleNames, arg.UserID, arg.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserRoles = `-- name: ListUserRoles :many
+SELECT id, created_at, updated_at, name, user_id, org_id
+FROM user_roles
+WHERE user_id = $1
+`
+
+func (q *Queries) ListUserRoles(ctx context.Context, userID uuid.UUID) ([]UserRole, error) {
+	rows, err := q.db.Query(ctx, listUserRoles, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserRole
+	for rows.Next() {
+		var i UserRole
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.UserID,
+			&i.OrgID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -88,7 +88,7 @@ LEFT JOIN oauth_accounts AS account
 JOIN orgs AS org
   ON usr.org_id = org.id
 LEFT JOIN user_roles AS role
-  ON usr.id = role.user_id
+  ON usr.id = role.user_id AND usr.org_id = role.org_id
 WHERE
   usr.email = lower($1)
 GROUP BY
@@ -152,7 +152,7 @@ LEFT JOIN oauth_accounts AS account
 JOIN orgs AS org
   ON usr.org_id = org.id
 LEFT JOIN user_roles AS role
-  ON usr.id = role.user_id
+  ON usr.id = role.user_id AND usr.org_id = role.org_id
 WHERE
   usr.id = $1
 GROUP BY
@@ -266,6 +266,68 @@ func (q *Queries) GetUserByProviderAccount(ctx context.Context, arg GetUserByPro
 	return i, err
 }
 
+const setUserActive = `-- name: SetUserActive :one
+UPDATE users
+SET is_active = $2
+WHERE id = $1
+RETURNING id, created_at, updated_at, org_id, email, first_name, last_name, password, picture, is_active, is_verified
+`
+
+type SetUserActiveParams struct {
+	ID       uuid.UUID `json:"id"`
+	IsActive bool      `json:"is_active"`
+}
+
+func (q *Queries) SetUserActive(ctx context.Context, arg SetUserActiveParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserActive, arg.ID, arg.IsActive)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.Password,
+		&i.Picture,
+		&i.IsActive,
+		&i.IsVerified,
+	)
+	return i, err
+}
+
+const setUserOrg = `-- name: SetUserOrg :one
+UPDATE users
+SET org_id = $2
+WHERE id = $1
+RETURNING id, created_at, updated_at, org_id, email, first_name, last_name, password, picture, is_active, is_verified
+`
+
+type SetUserOrgParams struct {
+	ID    uuid.UUID `json:"id"`
+	OrgID uuid.UUID `json:"org_id"`
+}
+
+func (q *Queries) SetUserOrg(ctx context.Context, arg SetUserOrgParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserOrg, arg.ID, arg.OrgID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.Password,
+		&i.Picture,
+		&i.IsActive,
+		&i.IsVerified,
+	)
+	return i, err
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET first_name = $2, last_name = $3, email = lower($4), org_id = $5
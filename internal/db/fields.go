@@ -41,11 +41,16 @@ type (
 	// and transmitted securely without being exposed in plain text. This is particularly useful for protecting sensitive
 	// values, both at rest and in motion.
 	//
-	// The encryption key is derived from a secret by calling shared.Service(). shared.Service is a singleton initialized
-	// at application startup using environment variables.
+	// The encryption key is derived from the current secret of the keyring, set at application startup with SetSecrets.
 	Sensitive = fields.Sensitive
 )
 
 func NewDuration(d time.Duration) Duration {
 	return fields.NewDuration(d)
 }
+
+// SetSecrets sets the keyring of Sensitive. Values are encrypted with the current secret, and decrypted with the
+// current or the previous secrets. After a rotation, the values are encrypted again with Rekey.
+func SetSecrets(current string, previous ...string) {
+	fields.SetKeyring(fields.NewKeyring(current, previous...))
+}
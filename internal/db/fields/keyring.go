@@ -0,0 +1,117 @@
+package fields
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// version is the prefix of the versioned ciphertexts, followed by the key id and a dot, e.g. v1.0a1b2c3d.
+	version = "v1."
+
+	// kidlen is the length of a key id.
+	kidlen = 8
+)
+
+var (
+	keyring atomic.Pointer[Keyring]
+)
+
+type (
+	// Keyring holds the keys of Sensitive. Values are encrypted with the key of the current secret, and decrypted with
+	// the key of the id in their prefix, so that the secret can be rotated: the previous secrets are kept for decryption
+	// until every value is encrypted again with the current secret, see NeedsRekey.
+	//
+	// Values without a prefix were encrypted before the keyring, with the secret padded to 32 bytes. That secret was
+	// always the default one, SetSecret was never applied, so the default secret is kept for their decryption.
+	Keyring struct {
+		secret  string            // current secret
+		current string            // id of the key of the current secret
+		keys    map[string][]byte // keys by id
+		legacy  [][]byte          // keys of the values without a prefix
+	}
+)
+
+// NewKeyring creates a keyring encrypting with the current secret, and decrypting with the current and the previous
+// secrets.
+func NewKeyring(current string, previous ...string) *Keyring {
+	k := &Keyring{
+		secret:  current,
+		current: KeyID(current),
+		keys:    make(map[string][]byte),
+	}
+
+	for _, secret := range append([]string{current}, previous...) {
+		k.keys[KeyID(secret)] = derive(secret)
+		k.legacy = append(k.legacy, pad(secret))
+	}
+
+	k.legacy = append(k.legacy, pad(_secret))
+
+	return k
+}
+
+// SetKeyring replaces the keyring of Sensitive.
+func SetKeyring(k *Keyring) { keyring.Store(k) }
+
+// Keys returns the keyring of Sensitive.
+func Keys() *Keyring { return keyring.Load() }
+
+// KeyID returns the id of the key of the secret. The id does not disclose the key.
+func KeyID(secret string) string {
+	sum := sha256.Sum256([]byte("quantm/fields/kid:" + secret))
+
+	return hex.EncodeToString(sum[:])[:kidlen]
+}
+
+// Current returns the id of the encryption key.
+func (k *Keyring) Current() string { return k.current }
+
+// NeedsRekey reports whether the stored value, as returned by Sensitive.Value, is not encrypted with the current key.
+func NeedsRekey(stored string) bool {
+	return !strings.HasPrefix(stored, version+Keys().Current()+".")
+}
+
+// prefix returns the prefix of the ciphertexts of the current key.
+func (k *Keyring) prefix() []byte {
+	return []byte(version + k.current + ".")
+}
+
+// key returns the key and the ciphertext of a value, and the legacy keys to try if the value has no known prefix.
+func (k *Keyring) key(encrypted []byte) ([]byte, []byte, bool) {
+	n := len(version) + kidlen + 1
+
+	if len(encrypted) > n && bytes.HasPrefix(encrypted, []byte(version)) && encrypted[n-1] == '.' {
+		if key, ok := k.keys[string(encrypted[len(version):n-1])]; ok {
+			return key, encrypted[n:], true
+		}
+	}
+
+	return nil, encrypted, false
+}
+
+// derive derives the 32 bytes AES key of the secret.
+func derive(secret string) []byte {
+	kdf := hkdf.New(sha256.New, []byte(secret), nil, []byte("quantm/fields.Sensitive"))
+	key := make([]byte, 32)
+	_, _ = io.ReadFull(kdf, key)
+
+	return key
+}
+
+// pad truncates or pads the secret to 32 bytes, the key of the values without a prefix.
+func pad(secret string) []byte {
+	s := []byte(secret)
+
+	if len(s) > 32 {
+		return s[:32]
+	}
+
+	return append(s, make([]byte, 32-len(s))...)
+}
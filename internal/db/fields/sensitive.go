@@ -37,26 +37,25 @@
 package fields
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"database/sql/driver"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"io"
-	"sync/atomic"
+	"strings"
 )
 
 const (
 	_secret string = "set me"
 )
 
-var (
-	_defaultsecret atomic.Value
-)
-
-func init()                { _defaultsecret.Store(_secret) }
-func Secret() string       { return _defaultsecret.Load().(string) }
-func SetSecret(val string) { _defaultsecret.Store(val) }
+func init()                { SetKeyring(NewKeyring(_secret)) }
+func Secret() string       { return Keys().secret }
+func SetSecret(val string) { SetKeyring(NewKeyring(val)) }
 
 type (
 	// Sensitive represents a string encrypted using AES-GCM.
@@ -65,8 +64,9 @@ type (
 	// and transmitted securely without being exposed in plain text. This is particularly useful for protecting sensitive
 	// values, both at rest and in motion.
 	//
-	// The encryption key is derived from a secret by calling shared.Service(). shared.Service is a singleton initialized
-	// at application startup using environment variables.
+	// The encryption key is derived from the current secret of the Keyring, set at application startup using
+	// environment variables. The ciphertext is prefixed with the version and the id of its key, e.g. v1.0a1b2c3d., so
+	// that the secret can be rotated without losing the values encrypted with the previous secrets.
 	//
 	// Usage:
 	//
@@ -105,43 +105,26 @@ type (
 	// - Are there any known security vulnerabilities with the AES-GCM implementation?
 	// - What is the maximum plaintext length?
 	// - How are non-ASCII characters handled?
-	// - Is key configuration possible? How is key exposure prevented?
 	// - What is the performance impact on the application?
 	// - Are there any specific use cases where Sensitive is well-suited?
-	// - How can we handle secret rotation? Maybe a version prefix with Vault integration?
 	Sensitive string
 )
 
+var (
+	ErrUnknownKey = errors.New("sensitive: unable to decrypt with the keys of the keyring")
+)
+
 func (sen Sensitive) String() string {
 	return string(sen)
 }
 
-// secret truncates or pads the secret to 32 bytes for AES-GCM.
-func (sen Sensitive) secret() []byte {
-	s := []byte(_secret)
-
-	if len(s) > 32 {
-		return s[:32]
-	}
-
-	if len(s) < 32 {
-		return append(s, make([]byte, 32-len(s))...)
-	}
-
-	return s
-}
-
-// encrypt encrypts the string value and returns the encrypted data.
+// encrypt encrypts the string value with the current key, and returns the encrypted data prefixed with the id of the
+// key.
 func (sen Sensitive) encrypt() ([]byte, error) {
 	plain := []byte(string(sen))
+	keys := Keys()
 
-	// Use the secret directly as the key
-	block, err := aes.NewCipher(sen.secret())
-	if err != nil {
-		return nil, err
-	}
-
-	gcm, err := cipher.NewGCM(block)
+	gcm, err := aead(keys.keys[keys.current])
 	if err != nil {
 		return nil, err
 	}
@@ -153,22 +136,37 @@ func (sen Sensitive) encrypt() ([]byte, error) {
 
 	sealed := gcm.Seal(nonce, nonce, plain, nil)
 
-	return sealed, nil
+	return append(keys.prefix(), sealed...), nil
 }
 
-// from rebuilds the encrypted string from the encrypted data.
+// from rebuilds the encrypted string from the encrypted data. Data without a known key id was encrypted before the
+// keyring, the legacy keys are tried.
 func (sen *Sensitive) from(encrypted []byte) error {
-	// Use the secret directly as the key
-	block, err := aes.NewCipher(sen.secret())
-	if err != nil {
-		return err
+	key, ciphertext, ok := Keys().key(encrypted)
+	if ok {
+		return sen.open(key, ciphertext)
 	}
 
-	gcm, err := cipher.NewGCM(block)
+	for _, key := range Keys().legacy {
+		if err := sen.open(key, ciphertext); err == nil {
+			return nil
+		}
+	}
+
+	return ErrUnknownKey
+}
+
+// open decrypts the nonce and the ciphertext with the key.
+func (sen *Sensitive) open(key, encrypted []byte) error {
+	gcm, err := aead(key)
 	if err != nil {
 		return err
 	}
 
+	if len(encrypted) < gcm.NonceSize() {
+		return errors.New("sensitive: ciphertext too short")
+	}
+
 	nonce := encrypted[:gcm.NonceSize()]
 	ciphertext := encrypted[gcm.NonceSize():]
 
@@ -189,7 +187,7 @@ func (sen Sensitive) MarshalJSON() ([]byte, error) {
 		return nil, err
 	}
 
-	return json.Marshal(base64.StdEncoding.EncodeToString(encrypted))
+	return json.Marshal(encode(encrypted))
 }
 
 // UnmarshalJSON implements json.Unmarshaler interface.
@@ -199,7 +197,7 @@ func (sen *Sensitive) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	decoded, err := base64.StdEncoding.DecodeString(encrypted)
+	decoded, err := decode(encrypted)
 	if err != nil {
 		return err
 	}
@@ -221,3 +219,70 @@ func (sen Sensitive) MarshalCQL() ([]byte, error) {
 func (sen *Sensitive) UnmarshalCQL(b []byte) error {
 	return sen.from(b)
 }
+
+// Value implements driver.Valuer, storing the encrypted value as a base64 encoded string after the id of its key.
+func (sen Sensitive) Value() (driver.Value, error) {
+	encrypted, err := sen.encrypt()
+	if err != nil {
+		return nil, err
+	}
+
+	return encode(encrypted), nil
+}
+
+// Scan implements sql.Scanner, decrypting the base64 encoded string stored by Value.
+func (sen *Sensitive) Scan(src any) error {
+	var encoded string
+
+	switch v := src.(type) {
+	case string:
+		encoded = v
+	case []byte:
+		encoded = string(v)
+	default:
+		return errors.New("sensitive: unsupported type")
+	}
+
+	decoded, err := decode(encoded)
+	if err != nil {
+		return err
+	}
+
+	return sen.from(decoded)
+}
+
+// encode encodes the encrypted data as text, keeping the prefix readable. Values without a prefix are base64 encoded.
+func encode(encrypted []byte) string {
+	n := len(version) + kidlen + 1
+
+	if len(encrypted) > n && bytes.HasPrefix(encrypted, []byte(version)) {
+		return string(encrypted[:n]) + base64.StdEncoding.EncodeToString(encrypted[n:])
+	}
+
+	return base64.StdEncoding.EncodeToString(encrypted)
+}
+
+// decode decodes the text of encode. The base64 alphabet has no dot, so a text with the version prefix is versioned.
+func decode(encoded string) ([]byte, error) {
+	n := len(version) + kidlen + 1
+
+	if len(encoded) > n && strings.HasPrefix(encoded, version) {
+		decoded, err := base64.StdEncoding.DecodeString(encoded[n:])
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte(encoded[:n]), decoded...), nil
+	}
+
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+func aead(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -37,7 +37,9 @@
 package fields
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/sethvargo/go-password/password"
@@ -102,3 +104,55 @@ func (s *EncryptedFieldTestSuite) TestMarshalCQL() {
 
 	s.Equal(s.sensitive.String(), decrypted.String())
 }
+
+func (s *EncryptedFieldTestSuite) TestValueScan() {
+	// Store the string as a database value
+	value, err := s.sensitive.Value()
+	s.NoError(err)
+
+	// Scan the database value
+	var decrypted Sensitive
+	err = decrypted.Scan(value)
+	s.NoError(err)
+
+	s.Equal(s.sensitive.String(), decrypted.String())
+}
+
+func (s *EncryptedFieldTestSuite) TestRotation() {
+	defer SetKeyring(Keys())
+
+	SetKeyring(NewKeyring("old"))
+
+	value, err := s.sensitive.Value()
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(value.(string), "v1."+KeyID("old")+"."))
+
+	// the previous secret decrypts, until the value is encrypted again.
+	SetKeyring(NewKeyring("new", "old"))
+	s.True(NeedsRekey(value.(string)))
+
+	var decrypted Sensitive
+	s.Require().NoError(decrypted.Scan(value))
+	s.Equal(s.sensitive.String(), decrypted.String())
+
+	rekeyed, err := decrypted.Value()
+	s.Require().NoError(err)
+	s.False(NeedsRekey(rekeyed.(string)))
+
+	SetKeyring(NewKeyring("new"))
+	s.ErrorIs(decrypted.Scan(value), ErrUnknownKey)
+	s.NoError(decrypted.Scan(rekeyed))
+}
+
+func (s *EncryptedFieldTestSuite) TestLegacy() {
+	gcm, err := aead(pad(_secret))
+	s.Require().NoError(err)
+
+	nonce := make([]byte, gcm.NonceSize())
+	legacy := base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(s.sensitive), nil))
+
+	var decrypted Sensitive
+	s.Require().NoError(decrypted.Scan(legacy))
+	s.Equal(s.sensitive.String(), decrypted.String())
+	s.True(NeedsRekey(legacy))
+}
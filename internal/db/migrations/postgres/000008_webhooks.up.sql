@@ -0,0 +1,38 @@
+-- hooks/webhook::webhooks::create
+create table webhooks (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  org_id uuid not null references orgs (id),
+  url varchar(2048) not null,
+  secret text not null,
+  is_active boolean not null default true,
+  constraint webhooks_org_id_unique unique (org_id)
+);
+
+-- hooks/webhook::webhooks::trigger
+create trigger update_webhooks_updated_at
+  after update on webhooks
+  for each row
+  execute function update_updated_at();
+
+-- hooks/webhook::webhook_dead_letters::create
+create table webhook_dead_letters (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  webhook_id uuid not null references webhooks (id) on delete cascade,
+  event_id uuid not null,
+  attempts integer not null,
+  error text not null,
+  payload jsonb not null default '{}'
+);
+
+-- hooks/webhook::webhook_dead_letters::index
+create index webhook_dead_letters_webhook_id_idx on webhook_dead_letters (webhook_id);
+
+-- hooks/webhook::webhook_dead_letters::trigger
+create trigger update_webhook_dead_letters_updated_at
+  after update on webhook_dead_letters
+  for each row
+  execute function update_updated_at();
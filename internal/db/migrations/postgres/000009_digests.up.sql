@@ -0,0 +1,22 @@
+-- core/digest::user_digests::create
+create table user_digests (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  user_id uuid not null references users (id) on delete cascade,
+  frequency varchar(16) not null default 'weekly',
+  include_teams boolean not null default false,
+  is_active boolean not null default false,
+  last_sent_at timestamptz not null default 'epoch',
+  constraint user_digests_user_id_unique unique (user_id),
+  constraint user_digests_frequency_check check (frequency in ('daily', 'weekly'))
+);
+
+-- core/digest::user_digests::index
+create index user_digests_is_active_idx on user_digests (is_active, last_sent_at);
+
+-- core/digest::user_digests::trigger
+create trigger update_user_digests_updated_at
+  after update on user_digests
+  for each row
+  execute function update_updated_at();
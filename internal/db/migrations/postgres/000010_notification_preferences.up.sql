@@ -0,0 +1,23 @@
+-- core/repos::user_notification_preferences::create
+create table user_notification_preferences (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  user_id uuid not null references users (id) on delete cascade,
+  kinds text[] not null default '{lines_exceeded,merge_conflict,merge_conflict_resolved}',
+  channel varchar(16) not null default 'dm',
+  timezone varchar(64) not null default 'UTC',
+  quiet_start integer not null default 0,
+  quiet_end integer not null default 0,
+  constraint user_notification_preferences_user_id_unique unique (user_id),
+  constraint user_notification_preferences_channel_check check (channel in ('dm', 'team', 'email')),
+  constraint user_notification_preferences_quiet_check check (
+    quiet_start between 0 and 1439 and quiet_end between 0 and 1439
+  )
+);
+
+-- core/repos::user_notification_preferences::trigger
+create trigger update_user_notification_preferences_updated_at
+  after update on user_notification_preferences
+  for each row
+  execute function update_updated_at();
@@ -0,0 +1,22 @@
+-- pulse::event_outbox::create
+create table event_outbox (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  event_id uuid not null,
+  org_id uuid not null,
+  record jsonb not null,
+  attempts integer not null default 0,
+  error text not null default '',
+  next_attempt_at timestamptz not null default now(),
+  constraint event_outbox_event_id_unique unique (event_id)
+);
+
+-- pulse::event_outbox::index
+create index event_outbox_next_attempt_at_idx on event_outbox (next_attempt_at);
+
+-- pulse::event_outbox::trigger
+create trigger update_event_outbox_updated_at
+  after update on event_outbox
+  for each row
+  execute function update_updated_at();
@@ -0,0 +1,18 @@
+-- auth::service_keys::create
+create table service_keys (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  name varchar(255) not null,
+  hash varchar(64) not null,
+  scopes text[] not null default '{}',
+  expires_at timestamptz not null,
+  is_revoked boolean not null default false,
+  last_used_at timestamptz not null default now()
+);
+
+-- auth::service_keys::trigger
+create trigger update_service_keys_updated_at
+  after update on service_keys
+  for each row
+  execute function update_updated_at();
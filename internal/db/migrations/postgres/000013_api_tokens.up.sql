@@ -0,0 +1,29 @@
+-- auth::api_tokens::create
+create table api_tokens (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  kind varchar(16) not null,
+  name varchar(255) not null,
+  hash varchar(64) not null,
+  scopes text[] not null default '{}',
+  org_id uuid not null references orgs (id) on delete cascade,
+  user_id uuid not null references users (id) on delete cascade,
+  expires_at timestamptz not null,
+  is_revoked boolean not null default false,
+  last_used_at timestamptz not null default now(),
+  constraint api_tokens_kind_check check (kind in ('personal', 'org'))
+);
+
+-- auth::api_tokens::index
+create index api_tokens_org_id_idx on api_tokens (org_id);
+
+-- the prefix of a token is the first 6 bytes of its random id, i.e. the first 13 characters of the uuid, and the token
+-- starts with it. the prefix identifies a single token.
+create unique index api_tokens_prefix_idx on api_tokens (left(id::text, 13));
+
+-- auth::api_tokens::trigger
+create trigger update_api_tokens_updated_at
+  after update on api_tokens
+  for each row
+  execute function update_updated_at();
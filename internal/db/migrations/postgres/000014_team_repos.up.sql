@@ -0,0 +1,24 @@
+-- auth::team_repos::create
+create table team_repos (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  team_id uuid not null references teams (id) on delete cascade,
+  repo_id uuid not null references repos (id) on delete cascade,
+  path varchar(1024) not null default '',
+  constraint team_repos_repo_path_unique unique (repo_id, path)
+);
+
+-- auth::team_repos::index
+create index team_repos_team_id_idx on team_repos (team_id);
+
+-- auth::team_repos::trigger
+create trigger update_team_repos_updated_at
+  after update on team_repos
+  for each row
+  execute function update_updated_at();
+
+-- auth::team_users::cascade
+alter table team_users
+  drop constraint team_users_team_id_fkey,
+  add constraint team_users_team_id_fkey foreign key (team_id) references teams (id) on delete cascade;
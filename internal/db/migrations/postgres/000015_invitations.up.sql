@@ -0,0 +1,42 @@
+-- auth::org_invitations::create
+create table org_invitations (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  org_id uuid not null references orgs (id) on delete cascade,
+  email varchar(255) not null,
+  role varchar(63) not null,
+  hash varchar(64) not null,
+  invited_by uuid not null references users (id) on delete cascade,
+  expires_at timestamptz not null,
+  is_accepted boolean not null default false,
+  is_revoked boolean not null default false
+);
+
+-- auth::org_invitations::index
+create index org_invitations_org_id_idx on org_invitations (org_id);
+
+-- auth::org_invitations::trigger
+create trigger update_org_invitations_updated_at
+  after update on org_invitations
+  for each row
+  execute function update_updated_at();
+
+-- auth::org_domains::create
+create table org_domains (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  org_id uuid not null references orgs (id) on delete cascade,
+  domain varchar(255) not null,
+  token varchar(64) not null,
+  is_verified boolean not null default false,
+  auto_join boolean not null default true,
+  constraint org_domains_org_id_unique unique (org_id)
+);
+
+-- auth::org_domains::trigger
+create trigger update_org_domains_updated_at
+  after update on org_domains
+  for each row
+  execute function update_updated_at();
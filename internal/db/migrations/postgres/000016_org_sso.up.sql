@@ -0,0 +1,19 @@
+-- auth::org_sso_configs::create
+create table org_sso_configs (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  org_id uuid not null references orgs (id) on delete cascade,
+  issuer varchar(1024) not null,
+  client_id varchar(255) not null,
+  client_secret text not null,
+  allowed_domains text[] not null default '{}',
+  is_required boolean not null default false,
+  constraint org_sso_configs_org_id_unique unique (org_id)
+);
+
+-- auth::org_sso_configs::trigger
+create trigger update_org_sso_configs_updated_at
+  after update on org_sso_configs
+  for each row
+  execute function update_updated_at();
@@ -0,0 +1,32 @@
+-- audit::audit_logs::create
+create table audit_logs (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  org_id uuid not null,
+  actor_kind varchar(63) not null,
+  actor_id varchar(255) not null,
+  action varchar(255) not null,
+  target_kind varchar(63) not null,
+  target_id varchar(255) not null,
+  before jsonb,
+  after jsonb,
+  request_id varchar(255) not null default '',
+  ip varchar(64) not null default ''
+);
+
+-- audit::audit_logs::index
+create index audit_logs_org_id_created_at_idx on audit_logs (org_id, created_at desc);
+
+-- audit::audit_logs::append_only
+create or replace function audit_logs_append_only()
+returns trigger as $$
+begin
+  raise exception 'audit_logs is append-only';
+end;
+$$ language plpgsql;
+
+-- audit::audit_logs::trigger
+create trigger audit_logs_append_only
+  before update or delete on audit_logs
+  for each row
+  execute function audit_logs_append_only();
@@ -0,0 +1,40 @@
+-- auth::sessions::create
+create table sessions (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  jti varchar(255) not null,
+  user_id uuid not null references users (id) on delete cascade,
+  org_id uuid not null,
+  issued_at timestamptz not null,
+  expires_at timestamptz not null,
+  last_seen_at timestamptz not null default now(),
+  ip varchar(64) not null default '',
+  user_agent varchar(512) not null default '',
+  is_sso boolean not null default false,
+  is_revoked boolean not null default false,
+  constraint sessions_jti_unique unique (jti)
+);
+
+-- auth::sessions::index
+create index sessions_user_id_idx on sessions (user_id);
+
+-- auth::sessions::trigger
+create trigger update_sessions_updated_at
+  after update on sessions
+  for each row
+  execute function update_updated_at();
+
+-- auth::session_revocations::create
+create table session_revocations (
+  user_id uuid primary key references users (id) on delete cascade,
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  revoked_before timestamptz not null
+);
+
+-- auth::session_revocations::trigger
+create trigger update_session_revocations_updated_at
+  after update on session_revocations
+  for each row
+  execute function update_updated_at();
@@ -0,0 +1,28 @@
+-- auth::org_members::create
+-- the orgs of a user. users.org_id is the default org of the user, the org of the sessions created at login. the roles
+-- of a member are the user_roles of the org.
+create table org_members (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  user_id uuid not null references users (id) on delete cascade,
+  org_id uuid not null references orgs (id) on delete cascade,
+  constraint org_members_user_id_org_id_unique unique (user_id, org_id)
+);
+
+-- auth::org_members::index
+create index org_members_org_id_idx on org_members (org_id);
+
+-- auth::org_members::trigger
+create trigger update_org_members_updated_at
+  after update on org_members
+  for each row
+  execute function update_updated_at();
+
+-- auth::org_members::backfill
+-- every user is a member of their org, except the users without an org.
+insert into org_members (user_id, org_id)
+select id, org_id
+from users
+where org_id <> '00000000-0000-0000-0000-000000000001'
+on conflict (user_id, org_id) do nothing;
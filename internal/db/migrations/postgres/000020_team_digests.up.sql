@@ -0,0 +1,24 @@
+-- core/digest::team_digests::create
+-- the email digest of a team, sent to the address of the team, e.g. a mailing list. the digest covers the activity of
+-- the team, regardless of its members.
+create table team_digests (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  team_id uuid not null references teams (id) on delete cascade,
+  email varchar(255) not null,
+  frequency varchar(16) not null default 'weekly',
+  is_active boolean not null default false,
+  last_sent_at timestamptz not null default 'epoch',
+  constraint team_digests_team_id_unique unique (team_id),
+  constraint team_digests_frequency_check check (frequency in ('daily', 'weekly'))
+);
+
+-- core/digest::team_digests::index
+create index team_digests_is_active_idx on team_digests (is_active, last_sent_at);
+
+-- core/digest::team_digests::trigger
+create trigger update_team_digests_updated_at
+  after update on team_digests
+  for each row
+  execute function update_updated_at();
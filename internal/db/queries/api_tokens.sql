@@ -0,0 +1,31 @@
+-- name: CreateAPIToken :one
+INSERT INTO api_tokens (id, kind, name, hash, scopes, org_id, user_id, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetAPIToken :one
+SELECT *
+FROM api_tokens
+WHERE id = $1;
+
+-- name: ListPersonalAPITokens :many
+SELECT *
+FROM api_tokens
+WHERE kind = 'personal' AND user_id = $1 AND org_id = $2
+ORDER BY created_at DESC;
+
+-- name: ListOrgAPITokens :many
+SELECT *
+FROM api_tokens
+WHERE kind = 'org' AND org_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeAPIToken :exec
+UPDATE api_tokens
+SET is_revoked = true
+WHERE id = $1;
+
+-- name: TouchAPIToken :exec
+UPDATE api_tokens
+SET last_used_at = now()
+WHERE id = $1 AND last_used_at < now() - interval '1 minute';
@@ -0,0 +1,17 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (org_id, actor_kind, actor_id, action, target_kind, target_id, before, after, request_id, ip)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListAuditLogs :many
+SELECT *
+FROM audit_logs
+WHERE org_id = @org_id
+  AND (@action::text = '' OR action = @action::text)
+  AND (@actor_id::text = '' OR actor_id = @actor_id::text)
+  AND (@target_kind::text = '' OR target_kind = @target_kind::text)
+  AND (@target_id::text = '' OR target_id = @target_id::text)
+  AND created_at >= @since
+  AND created_at < @until
+  AND (@cursor::uuid = '00000000-0000-0000-0000-000000000000' OR id < @cursor::uuid)
+ORDER BY id DESC
+LIMIT @row_limit;
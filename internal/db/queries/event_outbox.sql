@@ -0,0 +1,30 @@
+-- name: CreateEventOutbox :exec
+INSERT INTO event_outbox (event_id, org_id, record, error)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (event_id) DO NOTHING;
+
+-- name: ClaimEventOutbox :many
+UPDATE event_outbox
+SET next_attempt_at = @lease_until
+WHERE id IN (
+  SELECT id
+  FROM event_outbox
+  WHERE next_attempt_at <= now() AND attempts < @max_attempts
+  ORDER BY next_attempt_at
+  LIMIT @row_limit
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DeleteEventOutbox :exec
+DELETE FROM event_outbox
+WHERE id = ANY(@ids::uuid[]);
+
+-- name: FailEventOutbox :exec
+UPDATE event_outbox
+SET attempts = attempts + 1, error = $2, next_attempt_at = $3
+WHERE id = $1;
+
+-- name: CountEventOutbox :one
+SELECT count(*)
+FROM event_outbox;
@@ -0,0 +1,22 @@
+-- name: EnsureOrgDomain :one
+INSERT INTO org_domains (org_id, domain, token)
+VALUES ($1, $2, $3)
+ON CONFLICT (org_id) DO UPDATE
+SET org_id = excluded.org_id
+RETURNING *;
+
+-- name: GetOrgDomain :one
+SELECT *
+FROM org_domains
+WHERE org_id = $1;
+
+-- name: SetOrgDomainVerified :exec
+UPDATE org_domains
+SET is_verified = true
+WHERE org_id = $1;
+
+-- name: SetOrgDomainAutoJoin :one
+UPDATE org_domains
+SET auto_join = $2
+WHERE org_id = $1
+RETURNING *;
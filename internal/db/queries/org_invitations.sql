@@ -0,0 +1,25 @@
+-- name: CreateOrgInvitation :one
+INSERT INTO org_invitations (id, org_id, email, role, hash, invited_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetOrgInvitation :one
+SELECT *
+FROM org_invitations
+WHERE id = $1;
+
+-- name: ListOrgInvitations :many
+SELECT *
+FROM org_invitations
+WHERE org_id = $1 AND is_accepted = false AND is_revoked = false
+ORDER BY created_at DESC;
+
+-- name: AcceptOrgInvitation :execrows
+UPDATE org_invitations
+SET is_accepted = true
+WHERE id = $1 AND is_accepted = false AND is_revoked = false AND expires_at > now();
+
+-- name: RevokeOrgInvitation :exec
+UPDATE org_invitations
+SET is_revoked = true
+WHERE id = $1;
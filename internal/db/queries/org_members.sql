@@ -0,0 +1,23 @@
+-- name: CreateOrgMember :exec
+INSERT INTO org_members (user_id, org_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id, org_id) DO NOTHING;
+
+-- name: IsOrgMember :one
+SELECT EXISTS (
+  SELECT 1
+  FROM org_members
+  WHERE user_id = $1 AND org_id = $2
+);
+
+-- name: ListUserOrgs :many
+SELECT org.*
+FROM org_members AS member
+JOIN orgs AS org
+  ON member.org_id = org.id
+WHERE member.user_id = $1
+ORDER BY org.name;
+
+-- name: DeleteOrgMember :exec
+DELETE FROM org_members
+WHERE user_id = $1 AND org_id = $2;
@@ -0,0 +1,42 @@
+-- name: DeleteOrgSSOConfig :exec
+DELETE FROM org_sso_configs
+WHERE org_id = $1;
+
+-- name: GetOrgSSOConfig :one
+SELECT *
+FROM org_sso_configs
+WHERE org_id = $1;
+
+-- name: GetOrgSSOConfigBySlug :one
+SELECT org_sso_configs.*
+FROM org_sso_configs
+JOIN orgs ON orgs.id = org_sso_configs.org_id
+WHERE orgs.slug = $1;
+
+-- name: IsOrgSSORequired :one
+SELECT is_required
+FROM org_sso_configs
+WHERE org_id = $1;
+
+-- name: SetOrgSSOConfig :one
+INSERT INTO org_sso_configs (org_id, issuer, client_id, client_secret, allowed_domains, is_required)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (org_id) DO UPDATE
+SET issuer = EXCLUDED.issuer,
+    client_id = EXCLUDED.client_id,
+    client_secret = EXCLUDED.client_secret,
+    allowed_domains = EXCLUDED.allowed_domains,
+    is_required = EXCLUDED.is_required
+RETURNING *;
+
+-- name: ListOrgSSOConfigSecrets :many
+SELECT id, client_secret::text AS stored
+FROM org_sso_configs
+WHERE id > @after
+ORDER BY id
+LIMIT @row_limit;
+
+-- name: UpdateOrgSSOConfigSecret :exec
+UPDATE org_sso_configs
+SET client_secret = $2
+WHERE id = $1;
@@ -3,6 +3,11 @@ INSERT INTO orgs (name, domain, slug)
 VALUES ($1, LOWER($2), $3)
 RETURNING *;
 
+-- name: GetOrg :one
+SELECT *
+FROM orgs
+WHERE id = $1;
+
 -- name: GetOrgByDomain :one
 SELECT *
 FROM orgs
@@ -13,6 +18,11 @@ SELECT slug
 FROM orgs
 WHERE id = $1;
 
+-- name: ListOrgSlugs :many
+SELECT slug
+FROM orgs
+ORDER BY created_at;
+
 -- name: SetOrgHooks :exec
 UPDATE orgs
 SET hooks = $2
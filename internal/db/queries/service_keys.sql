@@ -0,0 +1,29 @@
+-- name: CreateServiceKey :one
+INSERT INTO service_keys (id, name, hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetServiceKey :one
+SELECT *
+FROM service_keys
+WHERE id = $1;
+
+-- name: ListServiceKeys :many
+SELECT *
+FROM service_keys
+ORDER BY created_at DESC;
+
+-- name: ExpireServiceKey :exec
+UPDATE service_keys
+SET expires_at = LEAST(expires_at, @expires_at::timestamptz)
+WHERE id = @id;
+
+-- name: RevokeServiceKey :exec
+UPDATE service_keys
+SET is_revoked = true
+WHERE id = $1;
+
+-- name: TouchServiceKey :exec
+UPDATE service_keys
+SET last_used_at = now()
+WHERE id = $1 AND last_used_at < now() - interval '1 minute';
@@ -0,0 +1,41 @@
+-- name: RegisterSession :one
+INSERT INTO sessions (jti, user_id, org_id, issued_at, expires_at, ip, user_agent, is_sso)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (jti) DO UPDATE SET last_seen_at = now()
+RETURNING *;
+
+-- name: GetSession :one
+SELECT *
+FROM sessions
+WHERE id = $1;
+
+-- name: GetSessionByJti :one
+SELECT *
+FROM sessions
+WHERE jti = $1;
+
+-- name: ListUserSessions :many
+SELECT *
+FROM sessions
+WHERE user_id = $1 AND is_revoked = false AND expires_at > now()
+ORDER BY last_seen_at DESC;
+
+-- name: RevokeSession :exec
+UPDATE sessions
+SET is_revoked = true
+WHERE id = $1;
+
+-- name: RevokeUserSessions :exec
+UPDATE sessions
+SET is_revoked = true
+WHERE user_id = $1 AND is_revoked = false;
+
+-- name: GetSessionRevocation :one
+SELECT revoked_before
+FROM session_revocations
+WHERE user_id = $1;
+
+-- name: SetSessionRevocation :exec
+INSERT INTO session_revocations (user_id, revoked_before)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET revoked_before = EXCLUDED.revoked_before;
@@ -0,0 +1,31 @@
+-- name: GetTeamDigest :one
+SELECT *
+FROM team_digests
+WHERE team_id = $1;
+
+-- name: SetTeamDigest :one
+INSERT INTO team_digests (team_id, email, frequency, is_active)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_id) DO UPDATE
+SET email = EXCLUDED.email, frequency = EXCLUDED.frequency, is_active = EXCLUDED.is_active
+RETURNING *;
+
+-- name: ListDueTeamDigests :many
+SELECT
+  dig.id, dig.team_id, dig.email, dig.frequency, dig.last_sent_at,
+  team.name, team.org_id, org.slug
+FROM team_digests AS dig
+JOIN teams AS team
+  ON dig.team_id = team.id
+JOIN orgs AS org
+  ON team.org_id = org.id
+WHERE
+  dig.is_active = true AND (
+    (dig.frequency = 'daily' AND dig.last_sent_at < @daily_before) OR
+    (dig.frequency = 'weekly' AND dig.last_sent_at < @weekly_before)
+  );
+
+-- name: MarkTeamDigestSent :exec
+UPDATE team_digests
+SET last_sent_at = $2
+WHERE id = $1;
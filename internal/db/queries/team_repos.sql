@@ -0,0 +1,22 @@
+-- name: AssignTeamRepo :one
+INSERT INTO team_repos (team_id, repo_id, path)
+VALUES ($1, $2, $3)
+ON CONFLICT (repo_id, path) DO UPDATE
+SET team_id = excluded.team_id
+RETURNING *;
+
+-- name: UnassignTeamRepo :exec
+DELETE FROM team_repos
+WHERE team_id = $1 AND repo_id = $2 AND path = $3;
+
+-- name: ListTeamReposByTeamID :many
+SELECT *
+FROM team_repos
+WHERE team_id = $1
+ORDER BY repo_id, path;
+
+-- name: ListTeamReposByRepoID :many
+SELECT *
+FROM team_repos
+WHERE repo_id = $1
+ORDER BY path;
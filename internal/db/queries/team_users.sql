@@ -7,3 +7,43 @@ RETURNING *;
 SELECT *
 FROM team_users
 WHERE user_id = $1;
+
+-- name: ListTeamIDsByUserID :many
+SELECT team_id
+FROM team_users
+WHERE user_id = $1 AND is_active = true;
+
+-- name: ListTeamRolesByUserID :many
+SELECT DISTINCT tu.role
+FROM team_users AS tu
+JOIN teams AS team
+  ON tu.team_id = team.id
+WHERE tu.user_id = $1 AND team.org_id = $2 AND tu.is_active = true;
+
+-- name: GetTeamMember :one
+SELECT *
+FROM team_users
+WHERE team_id = $1 AND user_id = $2;
+
+-- name: UpdateTeamMember :one
+UPDATE team_users
+SET role = $3, is_admin = $4, is_active = true
+WHERE team_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteTeamMember :exec
+DELETE FROM team_users
+WHERE team_id = $1 AND user_id = $2;
+
+-- name: ListTeamMembers :many
+SELECT sqlc.embed(tu), sqlc.embed(u)
+FROM team_users AS tu
+JOIN users AS u
+  ON tu.user_id = u.id
+WHERE tu.team_id = $1
+ORDER BY u.email;
+
+-- name: DeleteOrgTeamMembers :exec
+DELETE FROM team_users AS tu
+USING teams AS team
+WHERE tu.team_id = team.id AND tu.user_id = $1 AND team.org_id = $2;
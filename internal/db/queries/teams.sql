@@ -5,8 +5,8 @@ WHERE id = $1
 LIMIT 1;
 
 -- name: CreateTeam :one
-INSERT INTO teams (name, org_id)
-VALUES ($1, $2)
+INSERT INTO teams (name, org_id, slug)
+VALUES ($1, $2, $3)
 RETURNING *;
 
 -- name: GetTeamBySlug :one
@@ -28,3 +28,9 @@ RETURNING *;
 -- name: DeleteTeam :exec
 DELETE FROM teams
 WHERE id = $1;
+
+-- name: ListTeamsByOrgID :many
+SELECT *
+FROM teams
+WHERE org_id = $1
+ORDER BY name;
@@ -0,0 +1,31 @@
+-- name: GetUserDigest :one
+SELECT *
+FROM user_digests
+WHERE user_id = $1;
+
+-- name: SetUserDigest :one
+INSERT INTO user_digests (user_id, frequency, include_teams, is_active)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE
+SET frequency = EXCLUDED.frequency, include_teams = EXCLUDED.include_teams, is_active = EXCLUDED.is_active
+RETURNING *;
+
+-- name: ListDueUserDigests :many
+SELECT
+  dig.id, dig.user_id, dig.frequency, dig.include_teams, dig.last_sent_at,
+  usr.email, usr.first_name, usr.org_id, org.slug
+FROM user_digests AS dig
+JOIN users AS usr
+  ON dig.user_id = usr.id
+JOIN orgs AS org
+  ON usr.org_id = org.id
+WHERE
+  dig.is_active = true AND usr.is_active = true AND (
+    (dig.frequency = 'daily' AND dig.last_sent_at < @daily_before) OR
+    (dig.frequency = 'weekly' AND dig.last_sent_at < @weekly_before)
+  );
+
+-- name: MarkUserDigestSent :exec
+UPDATE user_digests
+SET last_sent_at = $2
+WHERE id = $1;
@@ -0,0 +1,16 @@
+-- name: GetUserNotificationPreferences :one
+SELECT *
+FROM user_notification_preferences
+WHERE user_id = $1;
+
+-- name: SetUserNotificationPreferences :one
+INSERT INTO user_notification_preferences (user_id, kinds, channel, timezone, quiet_start, quiet_end)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id) DO UPDATE
+SET
+  kinds = EXCLUDED.kinds,
+  channel = EXCLUDED.channel,
+  timezone = EXCLUDED.timezone,
+  quiet_start = EXCLUDED.quiet_start,
+  quiet_end = EXCLUDED.quiet_end
+RETURNING *;
@@ -1,3 +1,17 @@
 -- name: CreateUserRole :one
 INSERT INTO user_roles (name, user_id, org_id)
 VALUES ($1, $2, $3) RETURNING *;
+
+-- name: ListUserRoleNames :many
+SELECT name
+FROM user_roles
+WHERE user_id = $1 AND org_id = $2;
+
+-- name: DeleteUserRoles :exec
+DELETE FROM user_roles
+WHERE user_id = $1 AND org_id = $2;
+
+-- name: ListUserRoles :many
+SELECT *
+FROM user_roles
+WHERE user_id = $1;
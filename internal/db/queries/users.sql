@@ -50,7 +50,7 @@ LEFT JOIN oauth_accounts AS account
 JOIN orgs AS org
   ON usr.org_id = org.id
 LEFT JOIN user_roles AS role
-  ON usr.id = role.user_id
+  ON usr.id = role.user_id AND usr.org_id = role.org_id
 WHERE
   usr.id = $1
 GROUP BY
@@ -92,7 +92,7 @@ LEFT JOIN oauth_accounts AS account
 JOIN orgs AS org
   ON usr.org_id = org.id
 LEFT JOIN user_roles AS role
-  ON usr.id = role.user_id
+  ON usr.id = role.user_id AND usr.org_id = role.org_id
 WHERE
   usr.email = lower($1)
 GROUP BY
@@ -118,3 +118,15 @@ RETURNING *;
 UPDATE users
 SET password = $2
 WHERE id = $1;
+
+-- name: SetUserActive :one
+UPDATE users
+SET is_active = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: SetUserOrg :one
+UPDATE users
+SET org_id = $2
+WHERE id = $1
+RETURNING *;
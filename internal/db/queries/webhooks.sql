@@ -0,0 +1,40 @@
+-- name: SetWebhook :one
+INSERT INTO webhooks (org_id, url, secret, is_active)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (org_id) DO UPDATE
+SET url = EXCLUDED.url, secret = EXCLUDED.secret, is_active = EXCLUDED.is_active
+RETURNING *;
+
+-- name: GetWebhookByOrgID :one
+SELECT *
+FROM webhooks
+WHERE org_id = $1;
+
+-- name: DeleteWebhookByOrgID :exec
+DELETE FROM webhooks
+WHERE org_id = $1;
+
+-- name: CreateWebhookDeadLetter :one
+INSERT INTO webhook_dead_letters (webhook_id, event_id, attempts, error, payload)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListWebhookDeadLettersByOrgID :many
+SELECT webhook_dead_letters.*
+FROM webhook_dead_letters
+JOIN webhooks ON webhooks.id = webhook_dead_letters.webhook_id
+WHERE webhooks.org_id = $1
+ORDER BY webhook_dead_letters.created_at DESC
+LIMIT 100;
+
+-- name: ListWebhookSecrets :many
+SELECT id, secret::text AS stored
+FROM webhooks
+WHERE id > @after
+ORDER BY id
+LIMIT @row_limit;
+
+-- name: UpdateWebhookSecret :exec
+UPDATE webhooks
+SET secret = $2
+WHERE id = $1;
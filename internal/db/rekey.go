@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/db/fields"
+)
+
+const (
+	rekey_batch = 100
+)
+
+// Rekey encrypts again, with the current secret, the Sensitive columns encrypted with a previous secret or before the
+// keyring. It walks the tables in batches, and returns the number of values encrypted again. It is idempotent, and
+// must complete before a previous secret is removed from the keyring.
+func Rekey(ctx context.Context) (int, error) {
+	webhooks, err := rekey(
+		ctx, "webhooks",
+		func(ctx context.Context, after uuid.UUID) ([]entities.ListWebhookSecretsRow, error) {
+			return Queries().ListWebhookSecrets(ctx, entities.ListWebhookSecretsParams{After: after, RowLimit: rekey_batch})
+		},
+		func(row entities.ListWebhookSecretsRow) (uuid.UUID, string) { return row.ID, row.Stored },
+		func(ctx context.Context, id uuid.UUID, secret fields.Sensitive) error {
+			return Queries().UpdateWebhookSecret(ctx, entities.UpdateWebhookSecretParams{ID: id, Secret: secret})
+		},
+	)
+	if err != nil {
+		return webhooks, err
+	}
+
+	sso, err := rekey(
+		ctx, "org_sso_configs",
+		func(ctx context.Context, after uuid.UUID) ([]entities.ListOrgSSOConfigSecretsRow, error) {
+			params := entities.ListOrgSSOConfigSecretsParams{After: after, RowLimit: rekey_batch}
+
+			return Queries().ListOrgSSOConfigSecrets(ctx, params)
+		},
+		func(row entities.ListOrgSSOConfigSecretsRow) (uuid.UUID, string) { return row.ID, row.Stored },
+		func(ctx context.Context, id uuid.UUID, secret fields.Sensitive) error {
+			params := entities.UpdateOrgSSOConfigSecretParams{ID: id, ClientSecret: secret}
+
+			return Queries().UpdateOrgSSOConfigSecret(ctx, params)
+		},
+	)
+
+	return webhooks + sso, err
+}
+
+// rekey walks a table by id, and updates the values not encrypted with the current secret.
+func rekey[T any](
+	ctx context.Context,
+	table string,
+	list func(context.Context, uuid.UUID) ([]T, error),
+	row func(T) (uuid.UUID, string),
+	update func(context.Context, uuid.UUID, fields.Sensitive) error,
+) (int, error) {
+	count := 0
+	after := uuid.Nil
+
+	for {
+		rows, err := list(ctx, after)
+		if err != nil {
+			return count, err
+		}
+
+		for _, r := range rows {
+			id, stored := row(r)
+			after = id
+
+			if !fields.NeedsRekey(stored) {
+				continue
+			}
+
+			var value fields.Sensitive
+			if err := value.Scan(stored); err != nil {
+				return count, err
+			}
+
+			if err := update(ctx, id, value); err != nil {
+				return count, err
+			}
+
+			count++
+		}
+
+		if len(rows) < rekey_batch {
+			break
+		}
+	}
+
+	slog.Info("db: rekeyed", "table", table, "count", count)
+
+	return count, nil
+}
@@ -19,7 +19,7 @@ func NewHints(args ...string) Hints {
 
 	details := make(Hints)
 
-	for i := 0; i < len(args); i += 2 {
+	for i := 0; i+1 < len(args); i += 2 {
 		details[args[i]] = args[i+1]
 	}
 
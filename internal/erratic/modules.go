@@ -1,13 +1,16 @@
 package erratic
 
 const (
-	CommonModule      int = 100
-	AuthModule        int = 200
-	CoreModule        int = 300
-	CoreKernelModule  int = 301
-	CoreReposModule   int = 302
-	CoreChatModule    int = 303
-	HooksModule       int = 400
-	HooksGithubModule int = 401
-	HooksSlackModule  int = 402
+	CommonModule       int = 100
+	AuthModule         int = 200
+	CoreModule         int = 300
+	CoreKernelModule   int = 301
+	CoreReposModule    int = 302
+	CoreChatModule     int = 303
+	HooksModule        int = 400
+	HooksGithubModule  int = 401
+	HooksSlackModule   int = 402
+	HooksWebhookModule int = 403
+	PulseModule        int = 500
+	AuditModule        int = 600
 )
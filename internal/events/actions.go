@@ -19,6 +19,11 @@ const (
 	EventActionAdded   Action = "added"     // EventActionAdded indicates something was added to something else.
 	EventActionRemoved Action = "removed"   // EventActionRemoved indicates something was removed from something else.
 	ActionRequested    Action = "requested" // ActionRequested indicates a request for an action, approval, or resource was initiated.
+	ActionResolved     Action = "resolved"  // ActionResolved indicates a previously reported problem no longer applies.
+	ActionStale        Action = "stale"     // ActionStale indicates an item has seen no activity for longer than allowed.
+
+	ActionPublished   Action = "published"   // ActionPublished indicates an item was made available to its audience.
+	ActionUnpublished Action = "unpublished" // ActionUnpublished indicates a published item was withdrawn.
 )
 
 // String returns the string representation of the EventAction.
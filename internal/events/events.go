@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type (
@@ -94,9 +96,10 @@ func (e *Event[H, P]) SetPayload(payload *P) *Event[H, P] {
 	return e
 }
 
-// Flatten flattens the event into a simpler structure.
+// Flatten flattens the event into a simpler structure. The payload is encoded as protojson, if it can't be encoded, the
+// flat event is left without payload.
 func (e *Event[H, P]) Flatten() *Flat[H] {
-	return &Flat[H]{
+	flat := &Flat[H]{
 		Version:     e.Version,
 		ID:          e.ID,
 		Timestamp:   e.Timestamp,
@@ -111,6 +114,15 @@ func (e *Event[H, P]) Flatten() *Flat[H] {
 		TeamID:      e.Subject.TeamID,
 		UserID:      e.Subject.UserID,
 	}
+
+	if msg, ok := any(e.Payload).(proto.Message); ok && e.Payload != nil {
+		if payload, err := protojson.Marshal(msg); err == nil {
+			flat.PayloadType = string(msg.ProtoReflect().Descriptor().FullName())
+			flat.Payload = payload
+		}
+	}
+
+	return flat
 }
 
 // Next creates a new event based on the provided event, scope, and action.
@@ -7,8 +7,8 @@ import (
 )
 
 type (
-	// Flat is the flat structure of an event for time series databases. It does not
-	// contain payload data, only metadata.
+	// Flat is the flat structure of an event for time series databases. The payload is carried along as protojson,
+	// together with the full name of the payload message, so that readers can decode it.
 	Flat[H Hook] struct {
 		Version     EventVersion `json:"version"`      // Version is the version of the event.
 		ID          uuid.UUID    `json:"id"`           // ID is the ID of the event.
@@ -23,5 +23,7 @@ type (
 		TeamID      uuid.UUID    `json:"team_id"`      // TeamID is the ID of the team that the subject belongs to. Can be empty.
 		OrgID       uuid.UUID    `json:"org_id"`       // OrgID is the ID of the organization that the subject belongs to.
 		Timestamp   time.Time    `json:"timestamp"`    // Timestamp is the timestamp of the event.
+		PayloadType string       `json:"payload_type"` // PayloadType is the full name of the payload message.
+		Payload     []byte       `json:"payload"`      // Payload is the payload encoded as protojson.
 	}
 )
@@ -17,4 +17,8 @@ const (
 	ScopePrLabel    Scope = "pr_label"    // ScopePrLabel scopes pull request label event.
 	ScopeMerge      Scope = "merge"       // ScopeMerge scopes merge event.
 	ScopeMergeQueue Scope = "merge_queue" // ScopeMergeQueue scopes merge queue event.
+	ScopeRelease    Scope = "release"     // ScopeRelease scopes release event.
+	ScopeDeployment Scope = "deployment"  // ScopeDeployment scopes deployment event.
+
+	ScopeDeploymentStatus Scope = "deployment_status" // ScopeDeploymentStatus scopes deployment status event.
 )
@@ -0,0 +1,191 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// Document is an event encoded as a JSON object, the way it travels in workflow signals. Numbers are kept as
+	// json.Number, so that large ids survive the round trip.
+	Document map[string]any
+
+	// Upcaster reshapes an event document of a version to the shape of the next version. kind is the full name of the
+	// payload message the event is decoded to, e.g. ctrlplane.events.v1.Push, so that an upcaster can reshape a single
+	// kind of payload. The version of the document is set by the chain.
+	Upcaster func(doc Document, kind string) error
+
+	// Upcasters chains the versions of the event schema, oldest first. Each version may register an upcaster to the
+	// next version, a version without upcaster has the same shape as the next one.
+	Upcasters struct {
+		mu       sync.RWMutex
+		versions []EventVersion
+		steps    map[EventVersion][]Upcaster
+	}
+
+	// payload_kind is implemented by events, returning the full name of their payload message.
+	payload_kind interface {
+		PayloadKind() string
+	}
+
+	// version_only peeks at the version of an encoded event.
+	version_only struct {
+		Version EventVersion `json:"version"`
+	}
+)
+
+var (
+	// ErrUnknownVersion is returned when the version of an event is not in the chain, e.g. the event was sent by a
+	// newer release.
+	ErrUnknownVersion = errors.New("events: unknown event version")
+
+	// chain is the chain of the versions of the event schema.
+	chain = NewUpcasters(Versions...)
+)
+
+// NewUpcasters creates a chain of the given versions, oldest first.
+func NewUpcasters(versions ...EventVersion) *Upcasters {
+	return &Upcasters{versions: versions, steps: make(map[EventVersion][]Upcaster)}
+}
+
+// Register adds an upcaster from the version to the next one. Upcasters of the same version run in the order of
+// registration. It panics if the version is not in the chain, or is the latest version.
+func (u *Upcasters) Register(from EventVersion, upcaster Upcaster) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	idx := slices.Index(u.versions, from)
+	if idx < 0 || idx == len(u.versions)-1 {
+		panic(fmt.Sprintf("events: cannot upcast from version %q", from))
+	}
+
+	u.steps[from] = append(u.steps[from], upcaster)
+}
+
+// Latest returns the latest version of the chain.
+func (u *Upcasters) Latest() EventVersion {
+	return u.versions[len(u.versions)-1]
+}
+
+// Upcast brings the document to the latest version. A document without version is the oldest version.
+func (u *Upcasters) Upcast(doc Document, kind string) error {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	version, _ := doc["version"].(string)
+	if version == "" {
+		version = string(u.versions[0])
+	}
+
+	idx := slices.Index(u.versions, EventVersion(version))
+	if idx < 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownVersion, version)
+	}
+
+	for i := idx; i < len(u.versions)-1; i++ {
+		for _, step := range u.steps[u.versions[i]] {
+			if err := step(doc, kind); err != nil {
+				return fmt.Errorf("events: upcasting from %s: %w", u.versions[i], err)
+			}
+		}
+
+		doc["version"] = string(u.versions[i+1])
+	}
+
+	return nil
+}
+
+// Unmarshal decodes the encoded event into the target, upcasting it to the latest version first. Events of the latest
+// version are decoded as is.
+func (u *Upcasters) Unmarshal(data []byte, target any) error {
+	peek := version_only{}
+	if err := json.Unmarshal(data, &peek); err != nil {
+		return err
+	}
+
+	if peek.Version == u.Latest() {
+		return json.Unmarshal(data, target)
+	}
+
+	doc := Document{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&doc); err != nil {
+		return err
+	}
+
+	kind := ""
+	if typed, ok := target.(payload_kind); ok {
+		kind = typed.PayloadKind()
+	}
+
+	if err := u.Upcast(doc, kind); err != nil {
+		return err
+	}
+
+	upcasted, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(upcasted, target)
+}
+
+// Context returns the context of the event, or nil if the document has none.
+func (d Document) Context() Document {
+	return d.child("context")
+}
+
+// Subject returns the subject of the event, or nil if the document has none.
+func (d Document) Subject() Document {
+	return d.child("subject")
+}
+
+// Payload returns the payload of the event, or nil if the document has none.
+func (d Document) Payload() Document {
+	return d.child("payload")
+}
+
+// Rename moves the value of the key from to the key to, if set.
+func (d Document) Rename(from, to string) {
+	if value, ok := d[from]; ok {
+		d[to] = value
+		delete(d, from)
+	}
+}
+
+func (d Document) child(key string) Document {
+	if child, ok := d[key].(map[string]any); ok {
+		return Document(child)
+	}
+
+	return nil
+}
+
+// RegisterUpcaster adds an upcaster from the version to the next one, to the chain of the event schema.
+func RegisterUpcaster(from EventVersion, upcaster Upcaster) {
+	chain.Register(from, upcaster)
+}
+
+// Unmarshal decodes the encoded event into the target, upcasting it to the current version first. Signal handlers of
+// long-running workflows decode events with Unmarshal, so that events sent by a previous release are brought to the
+// shape the handler expects.
+func Unmarshal(data []byte, target any) error {
+	return chain.Unmarshal(data, target)
+}
+
+// PayloadKind returns the full name of the payload message of the event.
+func (e *Event[H, P]) PayloadKind() string {
+	if msg, ok := any(new(P)).(proto.Message); ok {
+		return string(msg.ProtoReflect().Descriptor().FullName())
+	}
+
+	return ""
+}
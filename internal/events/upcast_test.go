@@ -0,0 +1,118 @@
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	UpcastTestSuite struct {
+		suite.Suite
+	}
+
+	push = events.Event[eventsv1.RepoHook, eventsv1.Push]
+)
+
+func (s *UpcastTestSuite) event() *push {
+	return events.
+		New[eventsv1.RepoHook, eventsv1.Push]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
+		SetScope(events.ScopePush).
+		SetAction(events.ActionCreated).
+		SetParents(uuid.New()).
+		SetSubjectID(uuid.New()).
+		SetPayload(&eventsv1.Push{Ref: "refs/heads/main", After: "deadbeef", SenderId: 9007199254740993})
+}
+
+func (s *UpcastTestSuite) TestChainEndsAtDefault() {
+	s.Equal(events.EventVersionDefault, events.Versions[len(events.Versions)-1])
+}
+
+func (s *UpcastTestSuite) TestRoundTrip() {
+	event := s.event()
+
+	data, err := json.Marshal(event)
+	s.Require().NoError(err)
+
+	decoded := &push{}
+	s.Require().NoError(events.Unmarshal(data, decoded))
+
+	s.Equal(event.ID, decoded.ID)
+	s.Equal(event.Version, decoded.Version)
+	s.Equal(event.Context.Parents, decoded.Context.Parents)
+	s.Equal(event.Context.Action, decoded.Context.Action)
+	s.Equal(event.Subject.ID, decoded.Subject.ID)
+	s.Equal(event.Payload.GetRef(), decoded.Payload.GetRef())
+	s.Equal(event.Payload.GetSenderId(), decoded.Payload.GetSenderId())
+}
+
+func (s *UpcastTestSuite) TestRoundTripFromOldestVersion() {
+	event := s.event()
+	event.Version = events.Version_0_1_0
+
+	data, err := json.Marshal(event)
+	s.Require().NoError(err)
+
+	decoded := &push{}
+	s.Require().NoError(events.Unmarshal(data, decoded))
+
+	s.Equal(events.EventVersionDefault, decoded.Version)
+	s.Equal(event.ID, decoded.ID)
+	s.Equal(event.Timestamp.UnixNano(), decoded.Timestamp.UnixNano())
+	s.Equal(event.Context.Parents, decoded.Context.Parents)
+	s.Equal(event.Payload.GetAfter(), decoded.Payload.GetAfter())
+	s.Equal(event.Payload.GetSenderId(), decoded.Payload.GetSenderId())
+}
+
+func (s *UpcastTestSuite) TestUpcasters() {
+	chain := events.NewUpcasters("1", "2", "3")
+
+	chain.Register("1", func(doc events.Document, kind string) error {
+		if kind == "ctrlplane.events.v1.Push" {
+			doc.Payload().Rename("branch", "ref")
+		}
+
+		return nil
+	})
+
+	chain.Register("2", func(doc events.Document, _ string) error {
+		if doc.Context()["action"] == "merged" {
+			doc.Context()["action"] = string(events.ActionCompleted)
+		}
+
+		return nil
+	})
+
+	data := []byte(`{
+		"version": "1",
+		"id": "0191a9b4-6d1d-7b7e-8a32-8e2b0a6a2f10",
+		"context": {"scope": "push", "action": "merged"},
+		"payload": {"branch": "refs/heads/main", "sender_id": 9007199254740993}
+	}`)
+
+	decoded := &push{}
+	s.Require().NoError(chain.Unmarshal(data, decoded))
+
+	s.Equal(events.EventVersion("3"), decoded.Version)
+	s.Equal(events.ActionCompleted, decoded.Context.Action)
+	s.Equal("refs/heads/main", decoded.Payload.GetRef())
+	s.Equal(int64(9007199254740993), decoded.Payload.GetSenderId())
+
+	s.Panics(func() { chain.Register("3", func(events.Document, string) error { return nil }) })
+}
+
+func (s *UpcastTestSuite) TestUnknownVersion() {
+	data := []byte(`{"version": "9.9.9", "context": {}, "payload": {}}`)
+
+	s.ErrorIs(events.Unmarshal(data, &push{}), events.ErrUnknownVersion)
+}
+
+func TestUpcast(t *testing.T) {
+	suite.Run(t, new(UpcastTestSuite))
+}
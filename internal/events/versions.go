@@ -14,6 +14,13 @@ const (
 	Version_0_1_1 EventVersion = "0.1.1" // version 0.1.1.
 )
 
+var (
+	// Versions lists the versions of the event schema, oldest first, up to the default version. A new version must be
+	// appended, along with the upcasters reshaping the events of the previous version, see RegisterUpcaster.
+	// Version_0_1_1 was never the default, no event carries it.
+	Versions = []EventVersion{Version_0_1_0}
+)
+
 const (
 	// EventVersionDefault alias for the default version. This allows for easy versioning without chaniging the code base.
 	EventVersionDefault = Version_0_1_0
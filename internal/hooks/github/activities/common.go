@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
+	"go.breu.io/quantm/internal/audit"
+	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/core/repos"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
@@ -19,8 +22,9 @@ import (
 )
 
 // HydrateRepoEvent enriches a repository event using database data. It fetches GitHub installation and repository
-// details, optionally adding user information if an email is provided. For non-default branches, it retrieves the
-// parent event ID from the core workflow, accounting for potential asynchronous delays.
+// details, optionally adding user information if an email is provided. The team is the team owning the repo, or the
+// changed paths of the repo, falling back to the team of the user. For non-default branches, it retrieves the parent
+// event ID from the core workflow, accounting for potential asynchronous delays.
 func HydrateRepoEvent(ctx context.Context, payload *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
 	install, err := db.Queries().GetGithubInstallationByInstallationID(ctx, payload.InstallationID)
 	if err != nil {
@@ -52,6 +56,11 @@ func HydrateRepoEvent(ctx context.Context, payload *defs.HydratedRepoEventPayloa
 		hydrated.User = &user
 	}
 
+	hydrated.Team, err = auth.ResolveTeam(ctx, hydrated.Repo, payload.Paths, hydrated.User)
+	if err != nil {
+		return nil, err
+	}
+
 	if payload.Branch != "" || payload.Branch != hydrated.Repo.DefaultBranch || payload.ShouldFetchParent {
 		parent, err := durable.
 			OnCore().
@@ -66,9 +75,11 @@ func HydrateRepoEvent(ctx context.Context, payload *defs.HydratedRepoEventPayloa
 }
 
 // AddRepo adds a GitHub repository or activates an existing one using a database transaction.  It retrieves the
-// repository; if found, it activates it. Otherwise, it creates database entries for both the GitHub and core
-// repositories.
+// repository; if found, it activates both its GitHub and core repository entries. Otherwise, it creates database
+// entries for both the GitHub and core repositories. The change is recorded in the audit log of the org.
 func AddRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	ctx = audit.WithActor(ctx, sender_actor(payload))
+
 	tx, qtx, err := db.Transaction(ctx)
 	if err != nil {
 		return err
@@ -76,13 +87,21 @@ func AddRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
 
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	repo, err := db.Queries().GetGithubRepoByInstallationIDAndGithubID(ctx, entities.GetGithubRepoByInstallationIDAndGithubIDParams{
+	repo, err := qtx.GetGithubRepoByInstallationIDAndGithubID(ctx, entities.GetGithubRepoByInstallationIDAndGithubIDParams{
 		InstallationID: payload.InstallationID,
 		GithubID:       payload.Repo.ID,
 	})
 
 	if err == nil {
-		return qtx.ActivateGithubRepo(ctx, repo.ID)
+		if err := qtx.ActivateGithubRepo(ctx, repo.ID); err != nil {
+			return err
+		}
+
+		if err := set_repo_active(ctx, qtx, repo.ID, true); err != nil {
+			return err
+		}
+
+		return tx.Commit(ctx)
 	}
 
 	if !errors.Is(err, pgx.ErrNoRows) {
@@ -110,7 +129,18 @@ func AddRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
 		Url:    fmt.Sprintf("https://github.com/%s", payload.Repo.FullName),
 	}
 
-	_, err = qtx.CreateRepo(ctx, reqst)
+	core, err := qtx.CreateRepo(ctx, reqst)
+	if err != nil {
+		return err
+	}
+
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      core.OrgID,
+		Action:     audit.ActionRepoCreate,
+		TargetKind: audit.TargetRepo,
+		TargetID:   core.ID.String(),
+		After:      repo_state(&core),
+	})
 	if err != nil {
 		return err
 	}
@@ -119,8 +149,11 @@ func AddRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
 }
 
 // SuspendRepo suspends a GitHub repository, handling cases where it doesn't exist.  It retrieves the repository and, if
-// found, suspends both its GitHub and core repository entries using a database transaction.
+// found, suspends both its GitHub and core repository entries using a database transaction. The change is recorded in
+// the audit log of the org.
 func SuspendRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	ctx = audit.WithActor(ctx, sender_actor(payload))
+
 	repo, err := db.Queries().
 		GetGithubRepoByInstallationIDAndGithubID(
 			ctx,
@@ -149,13 +182,63 @@ func SuspendRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
 		return err
 	}
 
-	if err := qtx.SuspendedRepoByHookID(ctx, repo.ID); err != nil {
+	if err := set_repo_active(ctx, qtx, repo.ID, false); err != nil {
 		return err
 	}
 
 	return tx.Commit(ctx)
 }
 
+// set_repo_active activates or suspends the core repository of the GitHub repository, and records the change in the
+// audit log of the org.
+func set_repo_active(ctx context.Context, qtx *entities.Queries, hook_id uuid.UUID, active bool) error {
+	before, err := qtx.GetReposByHookAndHookID(ctx, entities.GetReposByHookAndHookIDParams{
+		Hook:   int32(eventsv1.RepoHook_REPO_HOOK_GITHUB),
+		HookID: hook_id,
+	})
+	if err != nil {
+		return err
+	}
+
+	action := audit.ActionRepoSuspend
+	if active {
+		action = audit.ActionRepoActivate
+		err = qtx.ActivateRepoByHookID(ctx, hook_id)
+	} else {
+		err = qtx.SuspendedRepoByHookID(ctx, hook_id)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	after := before
+	after.IsActive = active
+
+	return audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      before.OrgID,
+		Action:     action,
+		TargetKind: audit.TargetRepo,
+		TargetID:   before.ID.String(),
+		Before:     repo_state(&before),
+		After:      repo_state(&after),
+	})
+}
+
+// repo_state is the state of a repository in the audit log.
+func repo_state(repo *entities.Repo) map[string]any {
+	return map[string]any{"name": repo.Name, "url": repo.Url, "is_active": repo.IsActive}
+}
+
+// sender_actor returns the GitHub user making the change, or the system if the sender is unknown.
+func sender_actor(payload *defs.SyncRepoPayload) audit.Actor {
+	if payload.Sender == "" {
+		return audit.System
+	}
+
+	return audit.Actor{Kind: audit.ActorGithub, ID: payload.Sender, OrgID: payload.OrgID}
+}
+
 // SignalRepo signals a GitHub repository event to the core workflow.  Error handling is included.
 func SignalRepo[P events.Payload](ctx context.Context, hydrated *defs.HydratedQuantmEvent[P]) error {
 	_, err := durable.OnCore().SignalWithStartWorkflow(
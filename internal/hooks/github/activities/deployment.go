@@ -0,0 +1,32 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/hooks/github/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Deployment groups all the activities required for the Github Deployment and Deployment Status.
+	Deployment struct{}
+)
+
+// HydrateGithubDeploymentEvent hydrates the deployment event with the given parameters.
+func (d *Deployment) HydrateGithubDeploymentEvent(
+	ctx context.Context, params *defs.HydratedRepoEventPayload,
+) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+// SignalRepoWithGithubDeployment signals the repository with the hydrated deployment event.
+func (d *Deployment) SignalRepoWithGithubDeployment(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.Deployment]) error {
+	return SignalRepo(ctx, hydrated)
+}
+
+// SignalRepoWithGithubDeploymentStatus signals the repository with the hydrated deployment status event.
+func (d *Deployment) SignalRepoWithGithubDeploymentStatus(
+	ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.DeploymentStatus],
+) error {
+	return SignalRepo(ctx, hydrated)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 
+	"go.breu.io/quantm/internal/audit"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/hooks/github/defs"
@@ -16,36 +17,105 @@ type (
 )
 
 // GetOrCreateInstallation retrieves a Github installation from the database by installation ID.
-// If the installation does not exist, it creates a new one.
+// If the installation does not exist, it creates a new one. If the account of the installation has changed, e.g. it was
+// renamed, the installation is updated. The org of an existing installation is kept. Changes are recorded in the audit
+// log of the org.
 func (a *Install) GetOrCreateInstallation(
 	ctx context.Context, install *entities.GithubInstallation,
 ) (*entities.GithubInstallation, error) {
-	response, err := db.Queries().GetGithubInstallationByInstallationID(ctx, install.InstallationID)
-	if err == nil {
-		return &response, nil
+	ctx = audit.WithActor(ctx, audit.Actor{Kind: audit.ActorGithub, ID: install.SenderLogin, OrgID: install.OrgID})
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if errors.Is(err, pgx.ErrNoRows) {
-		create := entities.CreateGithubInstallationParams{
-			OrgID:             install.OrgID,
-			InstallationID:    install.InstallationID,
-			InstallationLogin: install.InstallationLogin,
-			InstallationType:  install.InstallationType,
-			SenderID:          install.SenderID,
-			SenderLogin:       install.SenderLogin,
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	existing, err := qtx.GetGithubInstallationByInstallationID(ctx, install.InstallationID)
+	if err == nil {
+		if !installation_changed(&existing, install) {
+			return &existing, nil
+		}
+
+		updated, err := qtx.UpdateGithubInstallation(ctx, entities.UpdateGithubInstallationParams{
+			ID:                  existing.ID,
+			OrgID:               existing.OrgID,
+			InstallationID:      existing.InstallationID,
+			InstallationLogin:   install.InstallationLogin,
+			InstallationLoginID: install.InstallationLoginID,
+			InstallationType:    install.InstallationType,
+			SenderID:            install.SenderID,
+			SenderLogin:         install.SenderLogin,
+			IsActive:            existing.IsActive,
+		})
+		if err != nil {
+			return nil, err
 		}
 
-		response, err = db.Queries().CreateGithubInstallation(ctx, create)
+		err = audit.Record(ctx, qtx, audit.Entry{
+			OrgID:      updated.OrgID,
+			Action:     audit.ActionGithubInstallationUpdate,
+			TargetKind: audit.TargetGithubInstallation,
+			TargetID:   updated.ID.String(),
+			Before:     existing,
+			After:      updated,
+		})
 		if err != nil {
 			return nil, err
 		}
 
-		return &response, nil
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+
+		return &updated, nil
 	}
 
-	return nil, err
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	create := entities.CreateGithubInstallationParams{
+		OrgID:               install.OrgID,
+		InstallationID:      install.InstallationID,
+		InstallationLogin:   install.InstallationLogin,
+		InstallationLoginID: install.InstallationLoginID,
+		InstallationType:    install.InstallationType,
+		SenderID:            install.SenderID,
+		SenderLogin:         install.SenderLogin,
+	}
+
+	created, err := qtx.CreateGithubInstallation(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      created.OrgID,
+		Action:     audit.ActionGithubInstallationCreate,
+		TargetKind: audit.TargetGithubInstallation,
+		TargetID:   created.ID.String(),
+		After:      created,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
 }
 
 func (a *Install) AddRepoToInstall(ctx context.Context, payload *defs.SyncRepoPayload) error {
 	return AddRepo(ctx, payload)
 }
+
+// installation_changed reports whether the account of the installation differs from the stored one.
+func installation_changed(stored, install *entities.GithubInstallation) bool {
+	return stored.InstallationLogin != install.InstallationLogin ||
+		stored.InstallationLoginID != install.InstallationLoginID ||
+		stored.InstallationType != install.InstallationType
+}
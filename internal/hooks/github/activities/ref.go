@@ -21,3 +21,8 @@ func (b *Ref) HydrateGithubRefEvent(ctx context.Context, params *defs.HydratedRe
 func (b *Ref) SignalRepoWithGithubRef(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.GitRef]) error {
 	return SignalRepo(ctx, hydrated)
 }
+
+// SignalRepoWithGithubTag signals the repository with the hydrated tag event.
+func (b *Ref) SignalRepoWithGithubTag(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.Tag]) error {
+	return SignalRepo(ctx, hydrated)
+}
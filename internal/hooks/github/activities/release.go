@@ -0,0 +1,23 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/hooks/github/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Release groups all the activities required for the Github Release.
+	Release struct{}
+)
+
+// HydrateGithubReleaseEvent hydrates the release event with the given parameters.
+func (r *Release) HydrateGithubReleaseEvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+// SignalRepoWithGithubRelease signals the repository with the hydrated release event.
+func (r *Release) SignalRepoWithGithubRelease(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.Release]) error {
+	return SignalRepo(ctx, hydrated)
+}
@@ -14,6 +14,8 @@ type (
 	PushActivity         = activities.Push
 	RefActivity          = activities.Ref
 	PullRequestActivity  = activities.PullRequest
+	ReleaseActivity      = activities.Release
+	DeploymentActivity   = activities.Deployment
 
 	KernelImpl = activities.Kernel
 
@@ -31,6 +33,10 @@ var (
 	PushWorkflow        = workflows.Push
 	PullRequestWorkflow = workflows.PullRequest
 	SyncReposWorkflow   = workflows.SyncRepos
+	ReleaseWorkflow     = workflows.Release
+
+	DeploymentWorkflow       = workflows.Deployment
+	DeploymentStatusWorkflow = workflows.DeploymentStatus
 
 	NomadHandler = nomad.NewGithubServiceHandler
 )
@@ -105,3 +105,58 @@ func PrReviewCommentToProto(prrc *defs.PrReviewComment) eventsv1.PullRequestRevi
 		SubmittedAt:       timestamppb.New(prrc.GetSubmittedAt()),
 	}
 }
+
+func TagToProto(ref *defs.WebhookRef) eventsv1.Tag {
+	return eventsv1.Tag{
+		Name:       ref.GetRef(),
+		Repository: ref.GetRepositoryName(),
+	}
+}
+
+func ReleaseToProto(release *defs.WebhookRelease) eventsv1.Release {
+	return eventsv1.Release{
+		Id:           release.Release.ID,
+		Tag:          release.GetTagName(),
+		Name:         release.GetName(),
+		Body:         release.GetBody(),
+		Target:       release.Release.TargetCommitish,
+		Author:       release.Release.Author.Login,
+		Url:          release.Release.HTMLURL,
+		IsDraft:      release.Release.Draft,
+		IsPrerelease: release.Release.Prerelease,
+		Repository:   release.Repository.Name,
+		Timestamp:    timestamppb.New(release.GetTimestamp()),
+	}
+}
+
+func DeploymentToProto(deployment *defs.WebhookDeployment) eventsv1.Deployment {
+	return eventsv1.Deployment{
+		Id:           deployment.Deployment.ID,
+		Environment:  deployment.Deployment.Environment,
+		Ref:          deployment.Deployment.Ref,
+		Sha:          deployment.Deployment.SHA,
+		Task:         deployment.Deployment.Task,
+		Description:  deployment.GetDescription(),
+		Creator:      deployment.Deployment.Creator.Login,
+		IsProduction: deployment.Deployment.ProductionEnvironment,
+		Repository:   deployment.Repository.Name,
+		Timestamp:    timestamppb.New(deployment.Deployment.CreatedAt),
+	}
+}
+
+func DeploymentStatusToProto(status *defs.WebhookDeploymentStatus) eventsv1.DeploymentStatus {
+	return eventsv1.DeploymentStatus{
+		Id:             status.DeploymentStatus.ID,
+		DeploymentId:   status.Deployment.ID,
+		State:          status.GetState(),
+		Environment:    status.DeploymentStatus.Environment,
+		Ref:            status.Deployment.Ref,
+		Sha:            status.Deployment.SHA,
+		Description:    status.DeploymentStatus.Description,
+		LogUrl:         status.DeploymentStatus.LogURL,
+		EnvironmentUrl: status.DeploymentStatus.EnvironmentURL,
+		Creator:        status.DeploymentStatus.Creator.Login,
+		Repository:     status.Repository.Name,
+		Timestamp:      timestamppb.New(status.DeploymentStatus.UpdatedAt),
+	}
+}
@@ -0,0 +1,156 @@
+package cast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/hooks/github/cast"
+	"go.breu.io/quantm/internal/hooks/github/defs"
+)
+
+type (
+	ProtoTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *ProtoTestSuite) Test_TagToProto() {
+	ref := &defs.WebhookRef{Ref: "v1.2.0", RefType: "tag", Repository: defs.Repository{Name: "quantm"}}
+
+	tag := cast.TagToProto(ref)
+
+	s.Equal("v1.2.0", tag.GetName())
+	s.Equal("quantm", tag.GetRepository())
+}
+
+func (s *ProtoTestSuite) Test_ReleaseToProto() {
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	published := created.Add(time.Hour)
+	name, body := "v1.2.0", "notes"
+
+	tests := []struct {
+		name      string
+		release   defs.Release
+		timestamp time.Time
+	}{
+		{
+			"published",
+			defs.Release{ID: 7, TagName: "v1.2.0", Name: &name, Body: &body, CreatedAt: created, PublishedAt: &published},
+			published,
+		},
+		{
+			"draft",
+			defs.Release{ID: 7, TagName: "v1.2.0", Draft: true, CreatedAt: created},
+			created,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.release.TargetCommitish = "main"
+			tt.release.Author = defs.User{Login: "octocat"}
+			tt.release.HTMLURL = "https://github.com/breuhq/quantm/releases/tag/v1.2.0"
+
+			release := cast.ReleaseToProto(&defs.WebhookRelease{Release: tt.release, Repository: defs.Repository{Name: "quantm"}})
+
+			s.Equal(int64(7), release.GetId())
+			s.Equal("v1.2.0", release.GetTag())
+			s.Equal(tt.release.Draft, release.GetIsDraft())
+			s.Equal("main", release.GetTarget())
+			s.Equal("octocat", release.GetAuthor())
+			s.Equal(tt.release.HTMLURL, release.GetUrl())
+			s.Equal("quantm", release.GetRepository())
+			s.Equal(tt.timestamp, release.GetTimestamp().AsTime())
+
+			if tt.release.Name != nil {
+				s.Equal(name, release.GetName())
+				s.Equal(body, release.GetBody())
+			} else {
+				s.Empty(release.GetName())
+				s.Empty(release.GetBody())
+			}
+		})
+	}
+}
+
+func (s *ProtoTestSuite) Test_DeploymentToProto() {
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	description := "deploy to production"
+
+	tests := []struct {
+		name        string
+		description *string
+		want        string
+	}{
+		{"with description", &description, description},
+		{"without description", nil, ""},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			deployment := cast.DeploymentToProto(&defs.WebhookDeployment{
+				Deployment: defs.Deployment{
+					ID:                    42,
+					SHA:                   "abc123",
+					Ref:                   "main",
+					Task:                  "deploy",
+					Environment:           "production",
+					Description:           tt.description,
+					Creator:               defs.User{Login: "octocat"},
+					ProductionEnvironment: true,
+					CreatedAt:             created,
+				},
+				Repository: defs.Repository{Name: "quantm"},
+			})
+
+			s.Equal(int64(42), deployment.GetId())
+			s.Equal("production", deployment.GetEnvironment())
+			s.Equal("main", deployment.GetRef())
+			s.Equal("abc123", deployment.GetSha())
+			s.Equal("deploy", deployment.GetTask())
+			s.Equal(tt.want, deployment.GetDescription())
+			s.Equal("octocat", deployment.GetCreator())
+			s.True(deployment.GetIsProduction())
+			s.Equal("quantm", deployment.GetRepository())
+			s.Equal(created, deployment.GetTimestamp().AsTime())
+		})
+	}
+}
+
+func (s *ProtoTestSuite) Test_DeploymentStatusToProto() {
+	updated := time.Date(2024, 5, 1, 10, 5, 0, 0, time.UTC)
+
+	status := cast.DeploymentStatusToProto(&defs.WebhookDeploymentStatus{
+		DeploymentStatus: defs.DeploymentStatus{
+			ID:             9,
+			State:          "success",
+			Description:    "deployed",
+			Environment:    "production",
+			LogURL:         "https://ci.example.com/logs/9",
+			EnvironmentURL: "https://example.com",
+			Creator:        defs.User{Login: "deploy-bot"},
+			UpdatedAt:      updated,
+		},
+		Deployment: defs.Deployment{ID: 42, Ref: "main", SHA: "abc123"},
+		Repository: defs.Repository{Name: "quantm"},
+	})
+
+	s.Equal(int64(9), status.GetId())
+	s.Equal(int64(42), status.GetDeploymentId())
+	s.Equal("success", status.GetState())
+	s.Equal("production", status.GetEnvironment())
+	s.Equal("main", status.GetRef())
+	s.Equal("abc123", status.GetSha())
+	s.Equal("deployed", status.GetDescription())
+	s.Equal("https://ci.example.com/logs/9", status.GetLogUrl())
+	s.Equal("https://example.com", status.GetEnvironmentUrl())
+	s.Equal("deploy-bot", status.GetCreator())
+	s.Equal("quantm", status.GetRepository())
+	s.Equal(updated, status.GetTimestamp().AsTime())
+}
+
+func TestProtoSuite(t *testing.T) {
+	suite.Run(t, new(ProtoTestSuite))
+}
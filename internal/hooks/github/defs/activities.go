@@ -18,15 +18,17 @@ type (
 		Repo           PartialRepository `json:"repo"`
 		IsDeleted      bool              `json:"is_deleted"`
 		OrgID          uuid.UUID         `json:"org_id"`
+		Sender         string            `json:"sender"` // login of the github user making the change
 	}
 
 	// HydratedRepoEventPayload is the payload for the HydrateRepoEvent activity.
 	HydratedRepoEventPayload struct {
-		RepoID            int64  `json:"repo_id"`
-		InstallationID    int64  `json:"installation_id"`
-		Email             string `json:"email"`
-		Branch            string `json:"branch"`
-		ShouldFetchParent bool   `json:"should_fetch_parent"`
+		RepoID            int64    `json:"repo_id"`
+		InstallationID    int64    `json:"installation_id"`
+		Email             string   `json:"email"`
+		Branch            string   `json:"branch"`
+		ShouldFetchParent bool     `json:"should_fetch_parent"`
+		Paths             []string `json:"paths"` // changed files, used to resolve the team owning the event.
 	}
 
 	// ChatLinks contains the possible chat_links channels for a HydratedRepoEvent.
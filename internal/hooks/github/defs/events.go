@@ -92,6 +92,25 @@ func (p *Push) GetPusherEmail() string {
 	return p.Pusher.Email
 }
 
+// GetPaths returns the files added, removed or modified by the commits of the push, without duplicates.
+func (p *Push) GetPaths() []string {
+	seen := make(map[string]bool)
+	paths := make([]string, 0)
+
+	for _, commit := range p.Commits {
+		for _, files := range [][]string{commit.Added, commit.Removed, commit.Modified} {
+			for _, file := range files {
+				if !seen[file] {
+					seen[file] = true
+					paths = append(paths, file)
+				}
+			}
+		}
+	}
+
+	return paths
+}
+
 // ---------------------------------- Pull Request Event ----------------------------------.
 func (pr *PR) GetTitle() string {
 	return pr.PullRequest.Title
@@ -121,6 +140,10 @@ func (pr *PR) GetBaseBranch() string {
 	return pr.PullRequest.Base.Ref
 }
 
+func (pr *PR) GetMerged() bool {
+	return pr.PullRequest.Merged
+}
+
 func (pr *PR) GetTimestamp() time.Time {
 	return pr.PullRequest.UpdatedAt
 }
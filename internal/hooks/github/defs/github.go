@@ -407,6 +407,53 @@ type (
 		Eyes       *int    `json:"eyes,omitempty"`
 		URL        *string `json:"url,omitempty"`
 	}
+
+	// https://docs.github.com/en/rest/releases/releases?apiVersion=2022-11-28#get-a-release
+	Release struct {
+		ID              int64      `json:"id"`
+		NodeID          string     `json:"node_id"`
+		TagName         string     `json:"tag_name"`
+		TargetCommitish string     `json:"target_commitish"`
+		Name            *string    `json:"name"`
+		Body            *string    `json:"body"`
+		Draft           bool       `json:"draft"`
+		Prerelease      bool       `json:"prerelease"`
+		Author          User       `json:"author"`
+		URL             string     `json:"url"`
+		HTMLURL         string     `json:"html_url"`
+		CreatedAt       time.Time  `json:"created_at"`
+		PublishedAt     *time.Time `json:"published_at"`
+	}
+
+	// https://docs.github.com/en/rest/deployments/deployments?apiVersion=2022-11-28#get-a-deployment
+	Deployment struct {
+		ID                    int64     `json:"id"`
+		NodeID                string    `json:"node_id"`
+		SHA                   string    `json:"sha"`
+		Ref                   string    `json:"ref"`
+		Task                  string    `json:"task"`
+		Environment           string    `json:"environment"`
+		Description           *string   `json:"description"`
+		Creator               User      `json:"creator"`
+		ProductionEnvironment bool      `json:"production_environment"`
+		TransientEnvironment  bool      `json:"transient_environment"`
+		CreatedAt             time.Time `json:"created_at"`
+		UpdatedAt             time.Time `json:"updated_at"`
+	}
+
+	// https://docs.github.com/en/rest/deployments/statuses?apiVersion=2022-11-28#get-a-deployment-status
+	DeploymentStatus struct {
+		ID             int64     `json:"id"`
+		NodeID         string    `json:"node_id"`
+		State          string    `json:"state"`
+		Description    string    `json:"description"`
+		Environment    string    `json:"environment"`
+		LogURL         string    `json:"log_url"`
+		EnvironmentURL string    `json:"environment_url"`
+		Creator        User      `json:"creator"`
+		CreatedAt      time.Time `json:"created_at"`
+		UpdatedAt      time.Time `json:"updated_at"`
+	}
 )
 
 func (c *Commit) GetID() string {
@@ -1,5 +1,9 @@
 package defs
 
+import (
+	"time"
+)
+
 type (
 	WebhookInstall struct {
 		Action       string              `json:"action"`
@@ -14,6 +18,7 @@ type (
 		RepositorySelection string              `json:"repository_selection"`
 		RepositoriesAdded   []PartialRepository `json:"repositories_added"`
 		RepositoriesRemoved []PartialRepository `json:"repositories_removed"`
+		Sender              User                `json:"sender"`
 	}
 
 	WebhookRef struct {
@@ -28,6 +33,34 @@ type (
 		Installation InstallationID `json:"installation"`
 		IsCreated    bool           `json:"is_created"`
 	}
+
+	WebhookRelease struct {
+		Action       string         `json:"action"`
+		Release      Release        `json:"release"`
+		Repository   Repository     `json:"repository"`
+		Organization Organization   `json:"organization"`
+		Sender       User           `json:"sender"`
+		Installation InstallationID `json:"installation"`
+	}
+
+	WebhookDeployment struct {
+		Action       string         `json:"action"`
+		Deployment   Deployment     `json:"deployment"`
+		Repository   Repository     `json:"repository"`
+		Organization Organization   `json:"organization"`
+		Sender       User           `json:"sender"`
+		Installation InstallationID `json:"installation"`
+	}
+
+	WebhookDeploymentStatus struct {
+		Action           string           `json:"action"`
+		DeploymentStatus DeploymentStatus `json:"deployment_status"`
+		Deployment       Deployment       `json:"deployment"`
+		Repository       Repository       `json:"repository"`
+		Organization     Organization     `json:"organization"`
+		Sender           User             `json:"sender"`
+		Installation     InstallationID   `json:"installation"`
+	}
 )
 
 func (wr *WebhookRef) GetRef() string {
@@ -37,3 +70,96 @@ func (wr *WebhookRef) GetRef() string {
 func (wr *WebhookRef) GetRefType() string {
 	return wr.RefType
 }
+
+func (wr *WebhookRef) GetRepositoryName() string {
+	return wr.Repository.Name
+}
+
+func (wr *WebhookRelease) GetAction() string {
+	return wr.Action
+}
+
+func (wr *WebhookRelease) GetTagName() string {
+	return wr.Release.TagName
+}
+
+func (wr *WebhookRelease) GetName() string {
+	if wr.Release.Name == nil {
+		return ""
+	}
+
+	return *wr.Release.Name
+}
+
+func (wr *WebhookRelease) GetBody() string {
+	if wr.Release.Body == nil {
+		return ""
+	}
+
+	return *wr.Release.Body
+}
+
+// GetTimestamp returns the time the release was published, or created if it is not published yet.
+func (wr *WebhookRelease) GetTimestamp() time.Time {
+	if wr.Release.PublishedAt != nil {
+		return *wr.Release.PublishedAt
+	}
+
+	return wr.Release.CreatedAt
+}
+
+func (wr *WebhookRelease) GetRepositoryID() int64 {
+	return wr.Repository.ID
+}
+
+func (wr *WebhookRelease) GetInstallationID() int64 {
+	return wr.Installation.ID
+}
+
+func (wr *WebhookRelease) GetSenderEmail() *string {
+	return wr.Sender.Email
+}
+
+func (wd *WebhookDeployment) GetAction() string {
+	return wd.Action
+}
+
+func (wd *WebhookDeployment) GetDescription() string {
+	if wd.Deployment.Description == nil {
+		return ""
+	}
+
+	return *wd.Deployment.Description
+}
+
+func (wd *WebhookDeployment) GetRepositoryID() int64 {
+	return wd.Repository.ID
+}
+
+func (wd *WebhookDeployment) GetInstallationID() int64 {
+	return wd.Installation.ID
+}
+
+func (wd *WebhookDeployment) GetSenderEmail() *string {
+	return wd.Sender.Email
+}
+
+func (ws *WebhookDeploymentStatus) GetAction() string {
+	return ws.Action
+}
+
+func (ws *WebhookDeploymentStatus) GetState() string {
+	return ws.DeploymentStatus.State
+}
+
+func (ws *WebhookDeploymentStatus) GetRepositoryID() int64 {
+	return ws.Repository.ID
+}
+
+func (ws *WebhookDeploymentStatus) GetInstallationID() int64 {
+	return ws.Installation.ID
+}
+
+func (ws *WebhookDeploymentStatus) GetSenderEmail() *string {
+	return ws.Sender.Email
+}
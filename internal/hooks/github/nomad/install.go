@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/erratic"
 	"go.breu.io/quantm/internal/hooks/github/defs"
@@ -22,6 +23,13 @@ type (
 	}
 )
 
+var (
+	// GithubServicePolicy declares the permissions required by the procedures of the GithubService.
+	GithubServicePolicy = auth.Policy{
+		githubv1connect.GithubServiceInstallProcedure: {auth.PermissionHooksWrite},
+	}
+)
+
 func (s *GithubService) Install(
 	ctx context.Context, req *connect.Request[githubv1.InstallRequest],
 ) (*connect.Response[emptypb.Empty], error) {
@@ -29,14 +37,25 @@ func (s *GithubService) Install(
 		return connect.NewResponse(&emptypb.Empty{}), nil
 	}
 
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	requested, err := uuid.Parse(req.Msg.OrgId)
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksGithubModule).WithReason("invalid org id").Wrap(err)
+	}
+
+	if requested != org_id {
+		return nil, erratic.NewAuthzError(erratic.HooksGithubModule).WithReason("org does not match the authenticated org")
+	}
+
 	opts := defs.NewInstallWorkflowOptions(req.Msg.InstallationId, req.Msg.Action)
 	args := defs.RequestInstall{
 		InstallationID: req.Msg.InstallationId,
 		SetupAction:    req.Msg.Action,
-		OrgID:          uuid.MustParse(req.Msg.OrgId),
+		OrgID:          org_id,
 	}
 
-	_, err := durable.OnHooks().SignalWithStartWorkflow(ctx, opts, defs.SignalRequestInstall, args, workflows.Install)
+	_, err = durable.OnHooks().SignalWithStartWorkflow(ctx, opts, defs.SignalRequestInstall, args, workflows.Install)
 	if err != nil {
 		return nil, erratic.NewSystemError(erratic.HooksGithubModule).WithReason("unable to signal hook")
 	}
@@ -45,5 +64,7 @@ func (s *GithubService) Install(
 }
 
 func NewGithubServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	auth.Declare(GithubServicePolicy)
+
 	return githubv1connect.NewGithubServiceHandler(&GithubService{}, opts...)
 }
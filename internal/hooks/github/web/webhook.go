@@ -86,6 +86,9 @@ func (h *Webhook) on(event defs.WebhookEvent) (WebhookEventHandler, bool) {
 		defs.WebhookEventPullRequest:              h.pr,
 		defs.WebhookEventPullRequestReview:        h.pr_review,
 		defs.WebhookEventPullRequestReviewComment: h.pr_review_comment,
+		defs.WebhookEventRelease:                  h.release,
+		defs.WebhookEventDeployment:               h.deployment,
+		defs.WebhookEventDeploymentStatus:         h.deployment_status,
 	}
 
 	fn, ok := handlers[event]
@@ -161,7 +164,7 @@ func (h *Webhook) ref(ctx echo.Context, event defs.WebhookEvent, id string) erro
 
 	opts := defs.NewRefWorkflowOptions(payload.Repository.ID, payload.Ref, payload.RefType, "", event.String(), id)
 
-	if payload.RefType == "branch" {
+	if payload.RefType == "branch" || payload.RefType == "tag" {
 		_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.Ref, payload, event)
 		if err != nil {
 			return erratic.NewSystemError(erratic.HooksGithubModule).Wrap(err)
@@ -273,3 +276,73 @@ func (h *Webhook) pr_review_comment(ctx echo.Context, event defs.WebhookEvent, i
 
 	return ctx.NoContent(http.StatusNoContent)
 }
+
+// release handles the release event.
+func (h *Webhook) release(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.WebhookRelease{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGithubModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	opts := defs.NewRefWorkflowOptions(
+		payload.GetRepositoryID(), payload.GetTagName(), "release", fmt.Sprintf("%d", payload.Release.ID), payload.GetAction(), id,
+	)
+
+	_, err := durable.
+		OnHooks().
+		ExecuteWorkflow(ctx.Request().Context(), opts, workflows.Release, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGithubModule).Wrap(err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// deployment handles the deployment event.
+func (h *Webhook) deployment(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.WebhookDeployment{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGithubModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	opts := defs.NewRefWorkflowOptions(
+		payload.GetRepositoryID(), payload.Deployment.Ref, "deployment", fmt.Sprintf("%d", payload.Deployment.ID), payload.GetAction(), id,
+	)
+
+	_, err := durable.
+		OnHooks().
+		ExecuteWorkflow(ctx.Request().Context(), opts, workflows.Deployment, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGithubModule).Wrap(err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// deployment_status handles the deployment status event.
+func (h *Webhook) deployment_status(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.WebhookDeploymentStatus{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGithubModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	opts := defs.NewRefWorkflowOptions(
+		payload.GetRepositoryID(), payload.Deployment.Ref, "deployment_status",
+		fmt.Sprintf("%d", payload.DeploymentStatus.ID), payload.GetState(), id,
+	)
+
+	_, err := durable.
+		OnHooks().
+		ExecuteWorkflow(ctx.Request().Context(), opts, workflows.DeploymentStatus, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGithubModule).Wrap(err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
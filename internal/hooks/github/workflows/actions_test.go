@@ -0,0 +1,71 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/events"
+)
+
+type (
+	ActionsTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *ActionsTestSuite) Test_ReleaseAction() {
+	tests := []struct {
+		name   string
+		action string
+		want   events.Action
+		ok     bool
+	}{
+		{"created", "created", events.ActionCreated, true},
+		{"edited", "edited", events.ActionUpdated, true},
+		{"deleted", "deleted", events.ActionDeleted, true},
+		{"published", "published", events.ActionPublished, true},
+		{"unpublished", "unpublished", events.ActionUnpublished, true},
+		{"prereleased is ignored", "prereleased", "", false},
+		{"released is ignored", "released", "", false},
+		{"empty is ignored", "", "", false},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			action, ok := release_action(tt.action)
+
+			s.Equal(tt.ok, ok)
+			s.Equal(tt.want, action)
+		})
+	}
+}
+
+func (s *ActionsTestSuite) Test_DeploymentStatusAction() {
+	tests := []struct {
+		name  string
+		state string
+		want  events.Action
+	}{
+		{"success", "success", events.ActionCompleted},
+		{"failure", "failure", events.ActionFailure},
+		{"error", "error", events.ActionFailure},
+		{"in progress", "in_progress", events.ActionStarted},
+		{"queued", "queued", events.ActionRequested},
+		{"pending", "pending", events.ActionRequested},
+		{"waiting", "waiting", events.ActionRequested},
+		{"inactive", "inactive", events.ActionClosed},
+		{"unknown", "unknown", events.ActionUpdated},
+		{"empty", "", events.ActionUpdated},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, deployment_status_action(tt.state))
+		})
+	}
+}
+
+func TestActionsSuite(t *testing.T) {
+	suite.Run(t, new(ActionsTestSuite))
+}
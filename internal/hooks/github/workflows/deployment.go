@@ -0,0 +1,148 @@
+package workflows
+
+import (
+	"github.com/google/uuid"
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/github/activities"
+	"go.breu.io/quantm/internal/hooks/github/cast"
+	"go.breu.io/quantm/internal/hooks/github/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The Deployment workflow processes GitHub webhook deployment events. It hydrates the event with repository,
+// installation, user, and team metadata, converts the defs.WebhookDeployment payload into a QuantmEvent, persists it,
+// and signals the repository. When the deployed ref is a branch, the event is linked to the branch.
+func Deployment(ctx workflow.Context, deployment *defs.WebhookDeployment) error {
+	acts := &activities.Deployment{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	email := ""
+	if deployment.GetSenderEmail() != nil {
+		email = *deployment.GetSenderEmail()
+	}
+
+	meta := &defs.HydratedRepoEvent{}
+	payload := &defs.HydratedRepoEventPayload{
+		RepoID:         deployment.GetRepositoryID(),
+		InstallationID: deployment.GetInstallationID(),
+		Email:          email,
+		Branch:         repos.BranchNameFromRef(deployment.Deployment.Ref),
+	}
+
+	if err := workflow.ExecuteActivity(ctx, acts.HydrateGithubDeploymentEvent, payload).Get(ctx, meta); err != nil {
+		return err
+	}
+
+	proto := cast.DeploymentToProto(deployment)
+
+	evt := events.
+		New[eventsv1.RepoHook, eventsv1.Deployment]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
+		SetScope(events.ScopeDeployment).
+		SetAction(events.ActionCreated).
+		SetSource(meta.GetRepoUrl()).
+		SetOrg(meta.GetOrgID()).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(meta.GetRepoID()).
+		SetPayload(&proto)
+
+	if meta.GetParentID() != uuid.Nil {
+		evt.SetParents(meta.GetParentID())
+	}
+
+	if meta.GetTeam() != nil {
+		evt.SetTeam(meta.GetTeamID())
+	}
+
+	if meta.GetUser() != nil {
+		evt.SetUser(meta.GetUserID())
+	}
+
+	if err := pulse.Persist(ctx, evt); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.Deployment]{Event: evt, Meta: meta, Signal: repos.SignalDeployment}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubDeployment, hevent).Get(ctx, nil)
+}
+
+// The DeploymentStatus workflow processes GitHub webhook deployment status events, the same way as the Deployment
+// workflow. The state of the status sets the action of the event, a successful deployment is completed.
+func DeploymentStatus(ctx workflow.Context, status *defs.WebhookDeploymentStatus) error {
+	acts := &activities.Deployment{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	email := ""
+	if status.GetSenderEmail() != nil {
+		email = *status.GetSenderEmail()
+	}
+
+	meta := &defs.HydratedRepoEvent{}
+	payload := &defs.HydratedRepoEventPayload{
+		RepoID:         status.GetRepositoryID(),
+		InstallationID: status.GetInstallationID(),
+		Email:          email,
+		Branch:         repos.BranchNameFromRef(status.Deployment.Ref),
+	}
+
+	if err := workflow.ExecuteActivity(ctx, acts.HydrateGithubDeploymentEvent, payload).Get(ctx, meta); err != nil {
+		return err
+	}
+
+	proto := cast.DeploymentStatusToProto(status)
+
+	evt := events.
+		New[eventsv1.RepoHook, eventsv1.DeploymentStatus]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
+		SetScope(events.ScopeDeploymentStatus).
+		SetAction(deployment_status_action(status.GetState())).
+		SetSource(meta.GetRepoUrl()).
+		SetOrg(meta.GetOrgID()).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(meta.GetRepoID()).
+		SetPayload(&proto)
+
+	if meta.GetParentID() != uuid.Nil {
+		evt.SetParents(meta.GetParentID())
+	}
+
+	if meta.GetTeam() != nil {
+		evt.SetTeam(meta.GetTeamID())
+	}
+
+	if meta.GetUser() != nil {
+		evt.SetUser(meta.GetUserID())
+	}
+
+	if err := pulse.Persist(ctx, evt); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.DeploymentStatus]{Event: evt, Meta: meta, Signal: repos.SignalDeploymentStatus}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubDeploymentStatus, hevent).Get(ctx, nil)
+}
+
+// deployment_status_action maps the state of a deployment status to the event action.
+func deployment_status_action(state string) events.Action {
+	switch state {
+	case "success":
+		return events.ActionCompleted
+	case "failure", "error":
+		return events.ActionFailure
+	case "in_progress":
+		return events.ActionStarted
+	case "queued", "pending", "waiting":
+		return events.ActionRequested
+	case "inactive":
+		return events.ActionClosed
+	}
+
+	return events.ActionUpdated
+}
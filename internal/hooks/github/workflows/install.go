@@ -54,7 +54,9 @@ func Install(ctx workflow.Context) error {
 	}
 
 	for _, repo := range state.webhook.Repositories {
-		payload := &defs.SyncRepoPayload{InstallationID: state.entity.ID, Repo: repo, OrgID: state.entity.OrgID}
+		payload := &defs.SyncRepoPayload{
+			InstallationID: state.entity.ID, Repo: repo, OrgID: state.entity.OrgID, Sender: state.webhook.Sender.Login,
+		}
 		selector.AddFuture(workflow.ExecuteActivity(ctx, state.do.AddRepoToInstall, payload), func(f workflow.Future) {})
 	}
 
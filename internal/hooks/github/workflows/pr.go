@@ -50,12 +50,19 @@ func PullRequest(ctx workflow.Context, pr *defs.PR) error {
 func handle_pr(ctx workflow.Context, pr *defs.PR, repo_evt *defs.HydratedRepoEvent) error {
 	acts := &activities.PullRequest{}
 	proto := cast.PullRequestToProto(pr)
+
+	// A merged pull request is completed, rather than just closed.
+	action := events.Action(pr.GetAction())
+	if action == events.ActionClosed && pr.GetMerged() {
+		action = events.ActionCompleted
+	}
+
 	// handle actions
 	event := events.
 		New[eventsv1.RepoHook, eventsv1.PullRequest]().
 		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
 		SetScope(events.ScopePr).
-		SetAction(events.Action(pr.GetAction())). // TODO - handle the PR actions
+		SetAction(action).
 		SetSource(repo_evt.GetRepoUrl()).
 		SetOrg(repo_evt.GetOrgID()).
 		SetSubjectName(events.SubjectNameRepos).
@@ -31,6 +31,7 @@ func Push(ctx workflow.Context, push *defs.Push) error {
 			InstallationID: push.GetInstallationID(),
 			Email:          push.GetPusherEmail(),
 			Branch:         repos.BranchNameFromRef(push.GetRef()),
+			Paths:          push.GetPaths(),
 		}
 		if err := workflow.ExecuteActivity(ctx, acts.HydrateGithubPushEvent, payload).Get(ctx, hre); err != nil {
 			return err
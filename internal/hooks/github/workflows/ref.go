@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	"go.breu.io/durex/dispatch"
 	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.breu.io/quantm/internal/core/repos"
 	"go.breu.io/quantm/internal/events"
@@ -17,7 +18,7 @@ import (
 // The Ref workflow processes GitHub webhook ref events, converting the defs.WebhookRef payload into a QuantmEvent.
 // This involves hydrating the event with repository, installation, user, and team metadata, determining the
 // event action (create or delete), constructing and persisting a QuantmEvent encompassing the hydrated details
-// and original payload, and finally signaling the repository.
+// and original payload, and finally signaling the repository. Tags are signaled as tag events, with their own payload.
 func Ref(ctx workflow.Context, payload *defs.WebhookRef, event defs.WebhookEvent) error {
 	acts := &activities.Ref{}
 	ctx = dispatch.WithDefaultActivityContext(ctx)
@@ -40,16 +41,20 @@ func Ref(ctx workflow.Context, payload *defs.WebhookRef, event defs.WebhookEvent
 	signal := repos.SignalRef
 	scope := events.ScopeBranch
 
-	if payload.RefType != "branch" {
-		logger.Warn("ref: unhandled ref event", "type", payload.RefType)
-		return nil
-	}
-
 	action := events.ActionCreated
 	if event == defs.WebhookEventDelete {
 		action = events.ActionDeleted
 	}
 
+	if payload.RefType == "tag" {
+		return handle_tag(ctx, payload, action, meta)
+	}
+
+	if payload.RefType != "branch" {
+		logger.Warn("ref: unhandled ref event", "type", payload.RefType)
+		return nil
+	}
+
 	evt := events.
 		New[eventsv1.RepoHook, eventsv1.GitRef]().
 		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
@@ -81,3 +86,37 @@ func Ref(ctx workflow.Context, payload *defs.WebhookRef, event defs.WebhookEvent
 
 	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubRef, hevent).Get(ctx, nil)
 }
+
+// handle_tag processes a tag event, creating a QuantmEvent and signaling the repository.
+func handle_tag(ctx workflow.Context, payload *defs.WebhookRef, action events.Action, meta *defs.HydratedRepoEvent) error {
+	acts := &activities.Ref{}
+	proto := cast.TagToProto(payload)
+	proto.Timestamp = timestamppb.New(workflow.Now(ctx))
+
+	evt := events.
+		New[eventsv1.RepoHook, eventsv1.Tag]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
+		SetScope(events.ScopeTag).
+		SetAction(action).
+		SetSource(meta.GetRepoUrl()).
+		SetOrg(meta.GetOrgID()).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(meta.GetRepoID()).
+		SetPayload(&proto)
+
+	if meta.GetTeam() != nil {
+		evt.SetTeam(meta.GetTeamID())
+	}
+
+	if meta.GetUser() != nil {
+		evt.SetUser(meta.GetUserID())
+	}
+
+	if err := pulse.Persist(ctx, evt); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.Tag]{Event: evt, Meta: meta, Signal: repos.SignalTag}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubTag, hevent).Get(ctx, nil)
+}
@@ -0,0 +1,95 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/github/activities"
+	"go.breu.io/quantm/internal/hooks/github/cast"
+	"go.breu.io/quantm/internal/hooks/github/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The Release workflow processes GitHub webhook release events. It hydrates the event with repository, installation,
+// user, and team metadata, converts the defs.WebhookRelease payload into a QuantmEvent, persists it, and signals the
+// repository.
+//
+// GitHub sends released and prereleased along with published, so only created, edited, deleted, published and
+// unpublished are handled.
+func Release(ctx workflow.Context, release *defs.WebhookRelease) error {
+	acts := &activities.Release{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+	logger := workflow.GetLogger(ctx)
+
+	action, ok := release_action(release.GetAction())
+	if !ok {
+		logger.Info("release: skipping action", "action", release.GetAction())
+		return nil
+	}
+
+	email := ""
+	if release.GetSenderEmail() != nil {
+		email = *release.GetSenderEmail()
+	}
+
+	meta := &defs.HydratedRepoEvent{}
+	payload := &defs.HydratedRepoEventPayload{
+		RepoID:         release.GetRepositoryID(),
+		InstallationID: release.GetInstallationID(),
+		Email:          email,
+	}
+
+	if err := workflow.ExecuteActivity(ctx, acts.HydrateGithubReleaseEvent, payload).Get(ctx, meta); err != nil {
+		return err
+	}
+
+	proto := cast.ReleaseToProto(release)
+
+	evt := events.
+		New[eventsv1.RepoHook, eventsv1.Release]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
+		SetScope(events.ScopeRelease).
+		SetAction(action).
+		SetSource(meta.GetRepoUrl()).
+		SetOrg(meta.GetOrgID()).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(meta.GetRepoID()).
+		SetPayload(&proto)
+
+	if meta.GetTeam() != nil {
+		evt.SetTeam(meta.GetTeamID())
+	}
+
+	if meta.GetUser() != nil {
+		evt.SetUser(meta.GetUserID())
+	}
+
+	if err := pulse.Persist(ctx, evt); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.Release]{Event: evt, Meta: meta, Signal: repos.SignalRelease}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubRelease, hevent).Get(ctx, nil)
+}
+
+// release_action maps the action of a release webhook to the event action.
+func release_action(action string) (events.Action, bool) {
+	switch action {
+	case "created":
+		return events.ActionCreated, true
+	case "edited":
+		return events.ActionUpdated, true
+	case "deleted":
+		return events.ActionDeleted, true
+	case "published":
+		return events.ActionPublished, true
+	case "unpublished":
+		return events.ActionUnpublished, true
+	}
+
+	return "", false
+}
@@ -33,14 +33,16 @@ func SyncRepos(ctx workflow.Context, payload *defs.WebhookInstallRepos) error {
 		return err
 	}
 
+	sender := payload.Sender.Login
+
 	for _, repo := range payload.RepositoriesAdded {
-		payload := &defs.SyncRepoPayload{InstallationID: install.ID, Repo: repo, OrgID: install.OrgID}
+		payload := &defs.SyncRepoPayload{InstallationID: install.ID, Repo: repo, OrgID: install.OrgID, Sender: sender}
 
 		selector.AddFuture(workflow.ExecuteActivity(ctx, acts.RepoAdded, payload), func(f workflow.Future) {})
 	}
 
 	for _, repo := range payload.RepositoriesRemoved {
-		payload := &defs.SyncRepoPayload{InstallationID: install.ID, Repo: repo}
+		payload := &defs.SyncRepoPayload{InstallationID: install.ID, Repo: repo, OrgID: install.OrgID, Sender: sender}
 
 		selector.AddFuture(workflow.ExecuteActivity(ctx, acts.RepoRemoved, payload), func(f workflow.Future) {})
 	}
@@ -31,7 +31,17 @@ func fields_merge_conflict(event *events.Event[eventsv1.ChatHook, eventsv1.Merge
 		attach.BranchMerge(event),
 		attach.CurrentHead(event),
 		attach.ConflictHead(),
-		attach.AffectedFiles(),
+		attach.AffectedFiles(event),
+	}
+
+	return fields
+}
+
+func fields_merge_conflict_resolved(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) []slack.AttachmentField {
+	fields := []slack.AttachmentField{
+		attach.Repo(event),
+		attach.BranchMerge(event),
+		attach.CurrentHead(event),
 	}
 
 	return fields
@@ -9,7 +9,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/slack-go/slack"
+	"go.temporal.io/sdk/temporal"
 
+	"go.breu.io/quantm/internal/core/kernel"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/events"
 	"go.breu.io/quantm/internal/hooks/slack/cast"
@@ -31,24 +33,35 @@ var (
 	ts = json.Number(strconv.FormatInt(time.Now().Unix(), 10))
 )
 
-func (k *Kernel) NotifyLinesExceed(
-	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff],
-) error {
-	var err error
+func (k *Kernel) StartThread(
+	ctx context.Context, subject events.Subject, source string, thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	if thread.IsStarted() {
+		return thread, nil
+	}
 
-	token := ""
-	target := ""
+	client, target, err := k.client(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
 
-	if event.Subject.UserID != uuid.Nil {
-		token, target, err = k.to_user(ctx, event.Subject.UserID)
-		if err != nil {
-			return err
-		}
-	} else {
-		token, target, err = k.to_repo(ctx, event.Subject.ID)
-		if err != nil {
-			return err
-		}
+	channel, id, err := fns.SendMessage(client, target, thread_parent(source, thread))
+	if err != nil {
+		return nil, err
+	}
+
+	started := *thread
+	started.Channel, started.ID = channel, id
+
+	return &started, nil
+}
+
+func (k *Kernel) NotifyLinesExceed(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	client, _, err := k.client(ctx, event.Subject)
+	if err != nil {
+		return nil, err
 	}
 
 	attachment := slack.Attachment{
@@ -61,52 +74,137 @@ func (k *Kernel) NotifyLinesExceed(
 		Ts:         ts,
 	}
 
-	client, err := config.GetSlackClient(token)
+	return k.threaded(client, event.Context.Source, thread, kernel.ChatThreadStatusLinesExceeded, attachment)
+}
+
+func (k *Kernel) NotifyMergeConflict(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	client, _, err := k.client(ctx, event.Subject)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	pretext := fmt.Sprintf(`We've detected a merge conflict in your feature branch, <%s/tree/%s|%s>.
+    This means there are changes in your branch that clash with recent updates on the main branch (trunk).`,
+		event.Context.Source, event.Payload.HeadBranch, event.Payload.HeadBranch)
+
+	if event.Context.Action == events.ActionUpdated {
+		pretext = fmt.Sprintf(`Some of the conflicts in your feature branch, <%s/tree/%s|%s>, have been resolved.
+    The files listed below still clash with recent updates on the main branch (trunk).`,
+			event.Context.Source, event.Payload.HeadBranch, event.Payload.HeadBranch)
+	}
+
+	attachment := slack.Attachment{
+		Color:      "warning",
+		Pretext:    pretext,
+		Fallback:   "Merge Conflict Detected",
+		MarkdownIn: []string{"fields"},
+		Footer:     footer,
+		Fields:     fields_merge_conflict(event),
+		Ts:         ts,
 	}
 
-	return fns.SendMessage(client, target, attachment)
+	return k.threaded(client, event.Context.Source, thread, kernel.ChatThreadStatusMergeConflict, attachment)
 }
 
-func (k *Kernel) NotifyMergeConflict(
-	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge],
-) error {
+func (k *Kernel) NotifyMergeConflictResolved(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	client, _, err := k.client(ctx, event.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := slack.Attachment{
+		Color: "good",
+		Pretext: fmt.Sprintf(`The merge conflict in your feature branch, <%s/tree/%s|%s>, has been resolved.
+    Your branch is clean against the main branch (trunk) again.`,
+			event.Context.Source, event.Payload.HeadBranch, event.Payload.HeadBranch),
+		Fallback:   "Merge Conflict Resolved",
+		MarkdownIn: []string{"fields"},
+		Footer:     footer,
+		Fields:     fields_merge_conflict_resolved(event),
+		Ts:         ts,
+	}
+
+	return k.threaded(client, event.Context.Source, thread, kernel.ChatThreadStatusResolved, attachment)
+}
+
+func (k *Kernel) NotifyMerged(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	if !thread.IsStarted() {
+		return thread, nil
+	}
+
+	client, _, err := k.client(ctx, event.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := slack.Attachment{
+		Color: "good",
+		Pretext: fmt.Sprintf(`The pull request <%s/pull/%d|#%d> of your feature branch, <%s/tree/%s|%s>, has been merged.`,
+			event.Context.Source, event.Payload.GetNumber(), event.Payload.GetNumber(),
+			event.Context.Source, thread.Branch, thread.Branch),
+		Fallback: "Pull Request Merged",
+		Footer:   footer,
+		Ts:       ts,
+	}
+
+	return k.threaded(client, event.Context.Source, thread, kernel.ChatThreadStatusMerged, attachment)
+}
+
+// threaded posts the attachment as a reply in the branch thread, and updates the parent message to reflect the new
+// status. The thread must be started, see StartThread, so that a retried reply never posts the parent message again.
+func (k *Kernel) threaded(
+	client *slack.Client, source string, thread *kernel.ChatThread, status kernel.ChatThreadStatus, attachment slack.Attachment,
+) (*kernel.ChatThread, error) {
+	if !thread.IsStarted() {
+		return nil, temporal.NewNonRetryableApplicationError("slack: thread not started", "ThreadNotStarted", nil)
+	}
+
+	updated := *thread
+	updated.Status = status
+
+	if err := fns.UpdateMessage(client, updated.Channel, updated.ID, thread_parent(source, &updated)); err != nil {
+		return nil, err
+	}
+
+	if err := fns.SendThreadReply(client, updated.Channel, updated.ID, attachment); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// client resolves the slack client and the target channel for the subject. The user linked to the subject is
+// preferred, falling back to the channel linked to the repo.
+func (k *Kernel) client(ctx context.Context, subject events.Subject) (*slack.Client, string, error) {
 	var err error
 
 	token := ""
 	target := ""
 
-	if event.Subject.UserID != uuid.Nil {
-		token, target, err = k.to_user(ctx, event.Subject.UserID)
+	if subject.UserID != uuid.Nil {
+		token, target, err = k.to_user(ctx, subject.UserID)
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 	} else {
-		token, target, err = k.to_repo(ctx, event.Subject.ID)
+		token, target, err = k.to_repo(ctx, subject.ID)
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 	}
 
 	client, err := config.GetSlackClient(token)
 	if err != nil {
-		return err
-	}
-
-	attachment := slack.Attachment{
-		Color: "warning",
-		Pretext: fmt.Sprintf(`We've detected a merge conflict in your feature branch, <%s/tree/%s|%s>.
-    This means there are changes in your branch that clash with recent updates on the main branch (trunk).`,
-			event.Context.Source, event.Payload.HeadBranch, event.Payload.HeadBranch),
-		Fallback:   "Merge Conflict Detected",
-		MarkdownIn: []string{"fields"},
-		Footer:     footer,
-		Fields:     fields_merge_conflict(event),
-		Ts:         ts,
+		return nil, "", err
 	}
 
-	return fns.SendMessage(client, target, attachment)
+	return client, target, nil
 }
 
 func (k *Kernel) to_user(ctx context.Context, link_to uuid.UUID) (string, string, error) {
@@ -0,0 +1,46 @@
+package activities
+
+import (
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"go.breu.io/quantm/internal/core/kernel"
+)
+
+type (
+	// thread_status is how a thread status is rendered on the parent message.
+	thread_status struct {
+		color string
+		text  string
+	}
+)
+
+var (
+	statuses = map[kernel.ChatThreadStatus]thread_status{
+		kernel.ChatThreadStatusLinesExceeded: {color: "warning", text: "Changes exceed the allowed threshold"},
+		kernel.ChatThreadStatusMergeConflict: {color: "danger", text: "Merge conflict with the default branch"},
+		kernel.ChatThreadStatusResolved:      {color: "good", text: "Merge conflict resolved"},
+		kernel.ChatThreadStatusMerged:        {color: "good", text: "Merged"},
+	}
+)
+
+// thread_parent creates the parent attachment of a branch thread, summarizing the latest status of the branch.
+func thread_parent(source string, thread *kernel.ChatThread) slack.Attachment {
+	status, ok := statuses[thread.Status]
+	if !ok {
+		status = thread_status{color: "#808080", text: "Tracking"}
+	}
+
+	return slack.Attachment{
+		Color:      status.color,
+		Pretext:    fmt.Sprintf("Updates for the branch <%s/tree/%s|%s>, see the thread for details.", source, thread.Branch, thread.Branch),
+		Fallback:   fmt.Sprintf("%s: %s", thread.Branch, status.text),
+		MarkdownIn: []string{"fields"},
+		Footer:     footer,
+		Fields: []slack.AttachmentField{
+			{Title: "*Status*", Value: status.text, Short: true},
+		},
+		Ts: ts,
+	}
+}
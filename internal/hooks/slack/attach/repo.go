@@ -32,7 +32,7 @@ func Branch(event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) slack.Attachm
 func BranchMerge(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) slack.AttachmentField {
 	return slack.AttachmentField{
 		Title: "*Branch*",
-		Value: fmt.Sprintf("<%s/tree/%s|%s>", event.Context.Source, event.Payload.BaseBranch, event.Payload.BaseBranch),
+		Value: fmt.Sprintf("<%s/tree/%s|%s>", event.Context.Source, event.Payload.HeadBranch, event.Payload.HeadBranch),
 		Short: true,
 	}
 }
@@ -109,11 +109,11 @@ func RenameFiles(event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) slack.At
 	}
 }
 
-// CurrentHead creates an attachment field for current head in merge context.
+// CurrentHead creates an attachment field for the trunk the branch is merged into, in merge context.
 func CurrentHead(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) slack.AttachmentField {
 	return slack.AttachmentField{
 		Title: "Current HEAD",
-		Value: fmt.Sprintf("<%s/tree/%s|%s>", event.Context.Source, event.Payload.HeadBranch, event.Payload.HeadBranch),
+		Value: fmt.Sprintf("<%s/tree/%s|%s>", event.Context.Source, event.Payload.BaseBranch, event.Payload.BaseBranch),
 		Short: true,
 	}
 }
@@ -128,10 +128,10 @@ func ConflictHead() slack.AttachmentField {
 }
 
 // AffectedFiles creates an attachment field for affected files in merge context.
-func AffectedFiles() slack.AttachmentField {
+func AffectedFiles(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) slack.AttachmentField {
 	return slack.AttachmentField{
 		Title: "Affected Files",
-		Value: fmt.Sprintf("%s", ""), // nolint: gosimple
+		Value: format_files(event.Payload.GetFiles()),
 		Short: false,
 	}
 }
@@ -6,9 +6,11 @@ import (
 	"github.com/slack-go/slack"
 )
 
-func SendMessage(client *slack.Client, channelID string, attachment slack.Attachment) error {
+// SendMessage posts the attachment to the channel. Returns the channel and the timestamp of the posted message. When
+// the channel is a user, the returned channel is the direct message channel.
+func SendMessage(client *slack.Client, channelID string, attachment slack.Attachment) (string, string, error) {
 	// Send message
-	_, _, err := client.PostMessage(
+	channel, ts, err := client.PostMessage(
 		channelID,
 		slack.MsgOptionAttachments(attachment),
 		slack.MsgOptionAsUser(true),
@@ -16,6 +18,40 @@ func SendMessage(client *slack.Client, channelID string, attachment slack.Attach
 
 	if err != nil {
 		slog.Error("Error sending message to channel ", ": ", slog.Any("e", err))
+		return "", "", err
+	}
+
+	return channel, ts, nil
+}
+
+// SendThreadReply posts the attachment as a reply to the message identified by ts.
+func SendThreadReply(client *slack.Client, channelID, ts string, attachment slack.Attachment) error {
+	_, _, err := client.PostMessage(
+		channelID,
+		slack.MsgOptionAttachments(attachment),
+		slack.MsgOptionAsUser(true),
+		slack.MsgOptionTS(ts),
+	)
+
+	if err != nil {
+		slog.Error("Error sending reply to thread", "channel", channelID, "ts", ts, slog.Any("e", err))
+		return err
+	}
+
+	return nil
+}
+
+// UpdateMessage replaces the attachments of the message identified by ts.
+func UpdateMessage(client *slack.Client, channelID, ts string, attachment slack.Attachment) error {
+	_, _, _, err := client.UpdateMessage(
+		channelID,
+		ts,
+		slack.MsgOptionAttachments(attachment),
+		slack.MsgOptionAsUser(true),
+	)
+
+	if err != nil {
+		slog.Error("Error updating message", "channel", channelID, "ts", ts, slog.Any("e", err))
 		return err
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/slack-go/slack"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/erratic"
 	"go.breu.io/quantm/internal/hooks/slack/config"
@@ -24,6 +25,13 @@ type (
 	}
 )
 
+var (
+	// SlackServicePolicy declares the permissions required by the procedures of the SlackService.
+	SlackServicePolicy = auth.Policy{
+		slackv1connect.SlackServiceOauthProcedure: {auth.PermissionHooksWrite},
+	}
+)
+
 func (s *SlackService) Oauth(
 	ctx context.Context, req *connect.Request[slackv1.OauthRequest],
 ) (*connect.Response[emptypb.Empty], error) {
@@ -76,5 +84,7 @@ func (s *SlackService) Oauth(
 }
 
 func NewSlackServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	auth.Declare(SlackServicePolicy)
+
 	return slackv1connect.NewSlackServiceHandler(&SlackService{}, opts...)
 }
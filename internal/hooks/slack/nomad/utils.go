@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/slack-go/slack"
 
+	"go.breu.io/quantm/internal/audit"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/hooks/slack/config"
@@ -68,12 +69,7 @@ func _user(
 		Data:   data,
 	}
 
-	_, err = db.Queries().CreateChatLink(ctx, m)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return create_link(ctx, m)
 }
 
 func _bot(
@@ -115,10 +111,34 @@ func _bot(
 		Data:   data,
 	}
 
-	_, err = db.Queries().CreateChatLink(ctx, m)
+	return create_link(ctx, m)
+}
+
+// create_link saves the chat link, and records it in the audit log of the org of the actor. The data of the link holds
+// the tokens of slack, it is left out of the audit log.
+func create_link(ctx context.Context, params entities.CreateChatLinkParams) error {
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	link, err := qtx.CreateChatLink(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = audit.Record(ctx, qtx, audit.Entry{
+		OrgID:      audit.ActorFrom(ctx).OrgID,
+		Action:     audit.ActionSlackLinkCreate,
+		TargetKind: audit.TargetChatLink,
+		TargetID:   link.ID.String(),
+		After:      map[string]any{"hook": link.Hook, "kind": link.Kind, "link_to": link.LinkTo},
+	})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Commit(ctx)
 }
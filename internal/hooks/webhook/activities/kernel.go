@@ -0,0 +1,142 @@
+package activities
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+	"go.breu.io/quantm/internal/hooks/webhook/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Kernel implements kernel.Chat by posting the signed event to the webhook configured for the org. Webhooks have
+	// no notion of threads, so the thread is returned untouched.
+	Kernel struct{}
+)
+
+// ActivityOptions returns the options the webhook activities must be dispatched with. The delivery is attempted
+// defs.MaxAttempts times, the last attempt records the event as a dead letter instead of failing.
+func ActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		StartToCloseTimeout: defs.Timeout + 5*time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    defs.MaxAttempts,
+		},
+	}
+}
+
+func (k *Kernel) StartThread(
+	_ context.Context, _ events.Subject, _ string, thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	return thread, nil
+}
+
+func (k *Kernel) NotifyLinesExceed(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	return thread, deliver(ctx, event)
+}
+
+func (k *Kernel) NotifyMergeConflict(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	return thread, deliver(ctx, event)
+}
+
+func (k *Kernel) NotifyMergeConflictResolved(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	return thread, deliver(ctx, event)
+}
+
+func (k *Kernel) NotifyMerged(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest], thread *kernel.ChatThread,
+) (*kernel.ChatThread, error) {
+	return thread, deliver(ctx, event)
+}
+
+// deliver posts the event to the webhook of the org. A failed delivery returns an error so that the activity is
+// retried with backoff. Once the attempts are exhausted, the event is recorded as a dead letter and the activity
+// completes.
+func deliver[P events.Payload](ctx context.Context, event *events.Event[eventsv1.ChatHook, P]) error {
+	hook, err := db.Queries().GetWebhookByOrgID(ctx, event.Subject.OrgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Debug("webhook: not configured, skipping ...", "org_id", event.Subject.OrgID)
+			return nil
+		}
+
+		return err
+	}
+
+	if !hook.IsActive {
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s.%s", event.Context.Scope, event.Context.Action)
+
+	err = fns.Send(ctx, hook.Url, hook.Secret.String(), name, event.ID.String(), body)
+	if err == nil {
+		return nil
+	}
+
+	attempt := attempts(ctx)
+
+	slog.Warn("webhook: unable to deliver", "org_id", hook.OrgID, "event_id", event.ID, "attempt", attempt, "error", err.Error())
+
+	if attempt < defs.MaxAttempts {
+		return err
+	}
+
+	return dead_letter(ctx, &hook, event.ID, attempt, err, body)
+}
+
+// dead_letter records an event that could not be delivered.
+func dead_letter(ctx context.Context, hook *entities.Webhook, id uuid.UUID, attempt int32, cause error, body []byte) error {
+	params := entities.CreateWebhookDeadLetterParams{
+		WebhookID: hook.ID,
+		EventID:   id,
+		Attempts:  attempt,
+		Error:     cause.Error(),
+		Payload:   body,
+	}
+
+	if _, err := db.Queries().CreateWebhookDeadLetter(ctx, params); err != nil {
+		slog.Error("webhook: unable to record dead letter", "webhook_id", hook.ID, "event_id", id, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// attempts returns the current attempt of the activity. Outside an activity, there are no retries, so every
+// delivery is the last one.
+func attempts(ctx context.Context) int32 {
+	if !activity.IsActivity(ctx) {
+		return defs.MaxAttempts
+	}
+
+	return activity.GetInfo(ctx).Attempt
+}
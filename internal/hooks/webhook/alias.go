@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	"go.breu.io/quantm/internal/hooks/webhook/activities"
+	"go.breu.io/quantm/internal/hooks/webhook/fns"
+	"go.breu.io/quantm/internal/hooks/webhook/nomad"
+)
+
+type (
+	KernelImpl = activities.Kernel
+)
+
+var (
+	Sign   = fns.Sign
+	Verify = fns.Verify
+
+	ActivityOptions = activities.ActivityOptions
+
+	NomadHandler = nomad.NewWebhookServiceHandler
+)
@@ -0,0 +1,43 @@
+package cast
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/db/entities"
+	webhookv1 "go.breu.io/quantm/internal/proto/hooks/webhook/v1"
+)
+
+// WebhookToProto converts a Webhook entity to a Webhook proto. The secret is never included.
+func WebhookToProto(hook *entities.Webhook) *webhookv1.Webhook {
+	return &webhookv1.Webhook{
+		Id:        hook.ID.String(),
+		CreatedAt: timestamppb.New(hook.CreatedAt),
+		UpdatedAt: timestamppb.New(hook.UpdatedAt),
+		OrgId:     hook.OrgID.String(),
+		Url:       hook.Url,
+		IsActive:  hook.IsActive,
+	}
+}
+
+// DeadLetterToProto converts a WebhookDeadLetter entity to a DeadLetter proto.
+func DeadLetterToProto(letter *entities.WebhookDeadLetter) *webhookv1.DeadLetter {
+	return &webhookv1.DeadLetter{
+		Id:        letter.ID.String(),
+		CreatedAt: timestamppb.New(letter.CreatedAt),
+		WebhookId: letter.WebhookID.String(),
+		EventId:   letter.EventID.String(),
+		Attempts:  letter.Attempts,
+		Error:     letter.Error,
+		Payload:   letter.Payload,
+	}
+}
+
+// DeadLettersToProto converts a slice of WebhookDeadLetter entities to a slice of DeadLetter protos.
+func DeadLettersToProto(letters []entities.WebhookDeadLetter) []*webhookv1.DeadLetter {
+	protos := make([]*webhookv1.DeadLetter, 0, len(letters))
+	for _, letter := range letters {
+		protos = append(protos, DeadLetterToProto(&letter))
+	}
+
+	return protos
+}
@@ -0,0 +1,27 @@
+package defs
+
+import (
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Quantm-Event"     // HeaderEvent carries the scope and action of the event, e.g. "merge.failure".
+	HeaderDelivery  = "X-Quantm-Delivery"  // HeaderDelivery carries the id of the event, stable across retries.
+	HeaderTimestamp = "X-Quantm-Timestamp" // HeaderTimestamp carries the unix time the request was signed at.
+	HeaderSignature = "X-Quantm-Signature" // HeaderSignature carries the hex encoded HMAC-SHA256 signature.
+
+	// SignaturePrefix prefixes the signature so that the algorithm can be changed without breaking receivers.
+	SignaturePrefix = "sha256="
+
+	// SecretPrefix prefixes the generated signing secrets.
+	SecretPrefix = "whsec_"
+)
+
+const (
+	// MaxAttempts is the number of delivery attempts after which the event is recorded as a dead letter. The
+	// backoff between attempts is governed by the retry policy of the activity.
+	MaxAttempts = 5
+
+	// Timeout is the time allowed for a single delivery attempt.
+	Timeout = 10 * time.Second
+)
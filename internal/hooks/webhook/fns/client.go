@@ -0,0 +1,97 @@
+package fns
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+)
+
+var (
+	ErrInsecureURL  = errors.New("webhook: url must be an absolute https url")
+	ErrInternalHost = errors.New("webhook: url must not point to an internal host")
+)
+
+var (
+	// shared is the address space shared by the carriers, see RFC 6598, never reachable from the internet.
+	shared = netip.MustParsePrefix("100.64.0.0/10")
+
+	// client posts the webhooks. Only public addresses are dialed, and redirects are not followed, so that the url of a
+	// webhook never reaches the internal network, see NewClient.
+	client = NewClient(IsPublicAddr)
+)
+
+// NewClient returns the http client posting the webhooks. The client dials only the addresses allowed, after the host
+// is resolved, so that a host resolving to an internal address is refused as well. Redirects are not followed, the
+// redirect is returned as the response, and is a failed delivery. Proxies are not used, since the address dialed would
+// be the one of the proxy.
+func NewClient(allow func(netip.Addr) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: defs.Timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addr, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !allow(addr.Addr().Unmap()) {
+				return ErrInternalHost
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// IsPublicAddr reports whether the address is reachable from the internet, i.e. it is not a loopback, private,
+// link-local, shared, multicast or unspecified address.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!shared.Contains(addr)
+}
+
+// ValidateURL checks that the url of a webhook is an absolute https url, without credentials, and that its host is not
+// an internal host. Hosts given by name are checked again once resolved, when the webhook is sent.
+func ValidateURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" || parsed.User != nil {
+		return ErrInsecureURL
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrInternalHost
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return ErrInternalHost
+	}
+
+	return nil
+}
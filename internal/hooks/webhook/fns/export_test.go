@@ -0,0 +1,20 @@
+package fns
+
+import (
+	"net/http"
+	"net/netip"
+)
+
+// AllowAddrs replaces the client posting the webhooks with one dialing the addresses allowed, so that the tests reach
+// the loopback address of their servers. The returned func restores the client.
+func AllowAddrs(allow func(netip.Addr) bool) func() {
+	previous := client
+	client = NewClient(allow)
+
+	return func() { client = previous }
+}
+
+// Client returns the client posting the webhooks.
+func Client() *http.Client {
+	return client
+}
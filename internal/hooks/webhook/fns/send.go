@@ -0,0 +1,49 @@
+package fns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+)
+
+// Send posts the signed body to the url, see NewClient. Any response other than 2xx, redirects included, is treated as a
+// failed delivery.
+func Send(ctx context.Context, url, secret, event, delivery string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, defs.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "quantm-webhook")
+	req.Header.Set(defs.HeaderEvent, event)
+	req.Header.Set(defs.HeaderDelivery, delivery)
+	req.Header.Set(defs.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(defs.HeaderSignature, Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
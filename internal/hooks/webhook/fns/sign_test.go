@@ -0,0 +1,50 @@
+package fns_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+	"go.breu.io/quantm/internal/hooks/webhook/fns"
+)
+
+type (
+	SignTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *SignTestSuite) TestSign() {
+	// hmac-sha256 of "1700000000.{"id":1}" keyed with whsec_test.
+	expected := "sha256=2f441ba4b3b2d50d28a9ab9d9fd8880376ecd1eb5d0435401553f5d8d0a5dcf8"
+
+	s.Equal(expected, fns.Sign("whsec_test", 1700000000, []byte(`{"id":1}`)))
+}
+
+func (s *SignTestSuite) TestVerify() {
+	body := []byte(`{"id":1}`)
+	signature := fns.Sign("whsec_test", 1700000000, body)
+
+	s.True(fns.Verify("whsec_test", 1700000000, body, signature))
+	s.False(fns.Verify("whsec_test", 1700000001, body, signature), "the timestamp is signed")
+	s.False(fns.Verify("whsec_other", 1700000000, body, signature))
+	s.False(fns.Verify("whsec_test", 1700000000, []byte(`{"id":2}`), signature))
+}
+
+func (s *SignTestSuite) TestNewSecret() {
+	first, err := fns.NewSecret()
+	s.Require().NoError(err)
+
+	second, err := fns.NewSecret()
+	s.Require().NoError(err)
+
+	s.True(strings.HasPrefix(first, defs.SecretPrefix))
+	s.Len(first, len(defs.SecretPrefix)+64)
+	s.NotEqual(first, second)
+}
+
+func TestSign(t *testing.T) {
+	suite.Run(t, new(SignTestSuite))
+}
@@ -0,0 +1,142 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/db/fields"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/webhook/cast"
+	"go.breu.io/quantm/internal/hooks/webhook/fns"
+	webhookv1 "go.breu.io/quantm/internal/proto/hooks/webhook/v1"
+	"go.breu.io/quantm/internal/proto/hooks/webhook/v1/webhookv1connect"
+)
+
+type (
+	WebhookService struct {
+		webhookv1connect.UnimplementedWebhookServiceHandler
+	}
+)
+
+var (
+	// WebhookServicePolicy declares the permissions required by the procedures of the WebhookService.
+	WebhookServicePolicy = auth.Policy{
+		webhookv1connect.WebhookServiceSetWebhookProcedure:      {auth.PermissionWebhooksWrite},
+		webhookv1connect.WebhookServiceGetWebhookProcedure:      {auth.PermissionWebhooksRead},
+		webhookv1connect.WebhookServiceDeleteWebhookProcedure:   {auth.PermissionWebhooksWrite},
+		webhookv1connect.WebhookServiceListDeadLettersProcedure: {auth.PermissionWebhooksRead},
+	}
+)
+
+// SetWebhook creates or updates the webhook of the org. A new secret is generated when the webhook is created or when
+// rotation is requested, and returned only in that response. The url must be an absolute https url, not pointing to an
+// internal host, see fns.ValidateURL. Without is_active, an existing webhook keeps its state, a new one is active.
+func (s *WebhookService) SetWebhook(
+	ctx context.Context, req *connect.Request[webhookv1.SetWebhookRequest],
+) (*connect.Response[webhookv1.SetWebhookResponse], error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	if req.Msg.GetUrl() == "" {
+		return nil, erratic.NewBadRequestError(erratic.HooksWebhookModule).WithReason("missing url")
+	}
+
+	if err := fns.ValidateURL(req.Msg.GetUrl()); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksWebhookModule).WithReason("invalid url").AddHint("url", err.Error())
+	}
+
+	existing, err := db.Queries().GetWebhookByOrgID(ctx, org_id)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, erratic.NewDatabaseError(erratic.HooksWebhookModule).Wrap(err)
+	}
+
+	created := errors.Is(err, pgx.ErrNoRows)
+	secret := existing.Secret.String()
+	reveal := ""
+	active := created || existing.IsActive
+
+	if req.Msg.IsActive != nil {
+		active = req.Msg.GetIsActive()
+	}
+
+	if created || req.Msg.GetRotateSecret() {
+		secret, err = fns.NewSecret()
+		if err != nil {
+			return nil, erratic.NewSystemError(erratic.HooksWebhookModule).WithReason("unable to generate secret").Wrap(err)
+		}
+
+		reveal = secret
+	}
+
+	params := entities.SetWebhookParams{
+		OrgID:    org_id,
+		Url:      req.Msg.GetUrl(),
+		Secret:   fields.Sensitive(secret),
+		IsActive: active,
+	}
+
+	hook, err := db.Queries().SetWebhook(ctx, params)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.HooksWebhookModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&webhookv1.SetWebhookResponse{Webhook: cast.WebhookToProto(&hook), Secret: reveal}), nil
+}
+
+// GetWebhook returns the webhook of the org.
+func (s *WebhookService) GetWebhook(
+	ctx context.Context, req *connect.Request[emptypb.Empty],
+) (*connect.Response[webhookv1.GetWebhookResponse], error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	hook, err := db.Queries().GetWebhookByOrgID(ctx, org_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.HooksWebhookModule).WithReason("webhook not configured")
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.HooksWebhookModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&webhookv1.GetWebhookResponse{Webhook: cast.WebhookToProto(&hook)}), nil
+}
+
+// DeleteWebhook removes the webhook of the org, along with its dead letters.
+func (s *WebhookService) DeleteWebhook(
+	ctx context.Context, req *connect.Request[emptypb.Empty],
+) (*connect.Response[emptypb.Empty], error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	if err := db.Queries().DeleteWebhookByOrgID(ctx, org_id); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.HooksWebhookModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ListDeadLetters returns the most recent events that could not be delivered to the webhook of the org.
+func (s *WebhookService) ListDeadLetters(
+	ctx context.Context, req *connect.Request[emptypb.Empty],
+) (*connect.Response[webhookv1.ListDeadLettersResponse], error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	letters, err := db.Queries().ListWebhookDeadLettersByOrgID(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.HooksWebhookModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&webhookv1.ListDeadLettersResponse{DeadLetters: cast.DeadLettersToProto(letters)}), nil
+}
+
+func NewWebhookServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	auth.Declare(WebhookServicePolicy)
+
+	return webhookv1connect.NewWebhookServiceHandler(&WebhookService{}, opts...)
+}
@@ -0,0 +1,131 @@
+package intercepts
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/netip"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/audit"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+
+	forwarded_for_header = "X-Forwarded-For"
+)
+
+// AuditRequest returns a unary interceptor that puts the request id and the ip of the client into the context of the
+// audit log, see audit.WithRequest.
+//
+// The request id is the X-Request-ID header of the request, or a generated id, and it is sent back in the X-Request-ID
+// header of the response.
+//
+// The ip is the address of the peer. When the peer is one of the trusted proxies, given as addresses or CIDRs, the
+// X-Forwarded-For header is read from right to left, and the ip is the first address that is not a trusted proxy. The
+// addresses on the left of it are set by the client, and are never trusted. Without trusted proxies, the header is
+// ignored.
+func AuditRequest(proxies ...string) connect.UnaryInterceptorFunc {
+	trusted := parse_proxies(proxies)
+
+	intercept := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			id := req.Header().Get(RequestIDHeader)
+			if id == "" {
+				id = uuid.NewString()
+			}
+
+			ctx = audit.WithRequest(ctx, id, client_ip(trusted, req.Peer().Addr, req.Header().Values(forwarded_for_header)))
+
+			resp, err := next(ctx, req)
+			if resp != nil {
+				resp.Header().Set(RequestIDHeader, id)
+			}
+
+			return resp, err
+		})
+	}
+
+	return connect.UnaryInterceptorFunc(intercept)
+}
+
+// parse_proxies parses the addresses and CIDRs of the trusted proxies, each entry being a comma separated list. Invalid
+// entries are skipped.
+func parse_proxies(proxies []string) []netip.Prefix {
+	trusted := make([]netip.Prefix, 0, len(proxies))
+
+	for _, entry := range proxies {
+		for _, proxy := range strings.Split(entry, ",") {
+			proxy = strings.TrimSpace(proxy)
+			if proxy == "" {
+				continue
+			}
+
+			if prefix, err := netip.ParsePrefix(proxy); err == nil {
+				trusted = append(trusted, prefix.Masked())
+				continue
+			}
+
+			if addr, err := netip.ParseAddr(proxy); err == nil {
+				addr = addr.Unmap()
+				trusted = append(trusted, netip.PrefixFrom(addr, addr.BitLen()))
+
+				continue
+			}
+
+			slog.Warn("nomad: invalid trusted proxy, skipping", "proxy", proxy)
+		}
+	}
+
+	return trusted
+}
+
+// client_ip returns the ip of the client, given the address of the peer and the values of the X-Forwarded-For header.
+func client_ip(trusted []netip.Prefix, peer string, forwarded []string) string {
+	host, _, err := net.SplitHostPort(peer)
+	if err != nil {
+		host = peer
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !is_trusted(trusted, addr) {
+		return host
+	}
+
+	hops := make([]string, 0)
+	for _, value := range forwarded {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			// the hop is garbage, the last known address is the proxy that forwarded it.
+			return addr.String()
+		}
+
+		addr = hop.Unmap()
+
+		if !is_trusted(trusted, addr) {
+			return addr.String()
+		}
+	}
+
+	return addr.String()
+}
+
+func is_trusted(trusted []netip.Prefix, addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,65 @@
+package intercepts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	AuditRequestTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *AuditRequestTestSuite) Test_ClientIP() {
+	trusted := parse_proxies([]string{"10.0.0.0/8", "192.0.2.1", "not a proxy", ""})
+
+	tests := []struct {
+		name      string
+		trusted   bool
+		peer      string
+		forwarded []string
+		want      string
+	}{
+		{"peer without header", true, "203.0.113.7:443", nil, "203.0.113.7"},
+		{"untrusted peer ignores header", true, "203.0.113.7:443", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"no trusted proxies ignores header", false, "10.0.0.1:443", []string{"198.51.100.1"}, "10.0.0.1"},
+		{"trusted peer", true, "10.0.0.1:443", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"trusted single address", true, "192.0.2.1:443", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"spoofed hops are skipped", true, "10.0.0.1:443", []string{"1.2.3.4, 198.51.100.1"}, "198.51.100.1"},
+		{"trusted hops are skipped", true, "10.0.0.1:443", []string{"1.2.3.4, 198.51.100.1, 10.0.0.2"}, "198.51.100.1"},
+		{"multiple headers", true, "10.0.0.1:443", []string{"1.2.3.4", "198.51.100.1, 10.0.0.2"}, "198.51.100.1"},
+		{"all hops trusted", true, "10.0.0.1:443", []string{"10.0.0.3, 10.0.0.2"}, "10.0.0.3"},
+		{"garbage hop", true, "10.0.0.1:443", []string{"198.51.100.1, garbage, 10.0.0.2"}, "10.0.0.2"},
+		{"ipv6 peer", true, "[2001:db8::1]:443", []string{"198.51.100.1"}, "2001:db8::1"},
+		{"mapped ipv4 peer", true, "[::ffff:10.0.0.1]:443", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"peer without port", true, "203.0.113.7", nil, "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			proxies := trusted
+			if !tt.trusted {
+				proxies = parse_proxies(nil)
+			}
+
+			s.Equal(tt.want, client_ip(proxies, tt.peer, tt.forwarded))
+		})
+	}
+}
+
+func (s *AuditRequestTestSuite) Test_ParseProxies() {
+	trusted := parse_proxies([]string{"10.1.2.3/8", " 192.0.2.1 ,::ffff:192.0.2.2", "2001:db8::/32,", "garbage"})
+
+	if s.Len(trusted, 4) {
+		s.Equal("10.0.0.0/8", trusted[0].String())
+		s.Equal("192.0.2.1/32", trusted[1].String())
+		s.Equal("192.0.2.2/32", trusted[2].String())
+		s.Equal("2001:db8::/32", trusted[3].String())
+	}
+}
+
+func TestAuditRequestSuite(t *testing.T) {
+	suite.Run(t, new(AuditRequestTestSuite))
+}
@@ -14,6 +14,15 @@ type (
 	Config struct {
 		Port      int  `json:"port" koanf:"PORT"`             // Server port.
 		EnableSSL bool `json:"enable_ssl" koanf:"ENABLE_SSL"` // Enables TLS/SSL.
+
+		// TrustedProxies are the addresses or CIDRs of the proxies in front of the server, e.g. the load balancer. The
+		// X-Forwarded-For header is read only when the request comes from a trusted proxy. In the environment, the
+		// proxies are comma separated, e.g. NOMAD__TRUSTED_PROXIES=10.0.0.0/8,192.0.2.1.
+		TrustedProxies []string `json:"trusted_proxies" koanf:"TRUSTED_PROXIES"`
+
+		// ExternalURL is the url of the server as seen by the browsers, e.g. https://api.quantm.io, behind the proxies.
+		// The single sign-on registers its callback under it, at the providers of the orgs.
+		ExternalURL string `json:"external_url" koanf:"EXTERNAL_URL"`
 	}
 
 	ConfigOption func(*Config) // ConfigOption is a function that modifies the Config.
@@ -46,6 +55,20 @@ func WithSSLConfig(enableSSL bool) ConfigOption {
 	}
 }
 
+// WithTrustedProxiesConfig returns a ConfigOption that sets the trusted proxies.
+func WithTrustedProxiesConfig(proxies ...string) ConfigOption {
+	return func(c *Config) {
+		c.TrustedProxies = proxies // Set the trusted proxies.
+	}
+}
+
+// WithExternalURLConfig returns a ConfigOption that sets the external url.
+func WithExternalURLConfig(external string) ConfigOption {
+	return func(c *Config) {
+		c.ExternalURL = external // Set the external url.
+	}
+}
+
 // WithEnvironmentConfig returns a ConfigOption that loads configuration from environment variables.
 //
 // It reads environment variables prefixed with the specified prefix, or "NOMAD__" if no prefix is provided.
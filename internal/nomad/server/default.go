@@ -3,39 +3,59 @@ package server
 import (
 	"connectrpc.com/connect"
 
+	auditnomad "go.breu.io/quantm/internal/audit/nomad"
 	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/core/repos"
 	"go.breu.io/quantm/internal/hooks/github"
 	"go.breu.io/quantm/internal/hooks/slack"
+	"go.breu.io/quantm/internal/hooks/webhook"
 	"go.breu.io/quantm/internal/nomad/intercepts"
+	pulsenomad "go.breu.io/quantm/internal/pulse/nomad"
 )
 
 // DefaultServer creates a new Nomad server instance with the provided options.
 //
-// FIXME: create an insecure handler for user registration and login. AccountServiceHandler?
+// Every handler requires a bearer token. The web app calls the procedures of its users with their token, and the
+// procedures of the AccountService and the UserService, e.g. to register a user, with a service key. The CLI and the
+// automations call the procedures with a personal access token or an org API key, see the TokenService. The single
+// sign-on of the orgs is served at /auth/sso/, without a bearer token.
 func DefaultServer(opts ...Option) *Server {
 	srv := New(opts...)
 
 	// -- config/interceptors --
 
+	config := &DefaultConfig
+	if srv.config != nil {
+		config = srv.config
+	}
+
 	interceptors := []connect.Interceptor{
 		intercepts.RequestLogger(),
+		intercepts.AuditRequest(config.TrustedProxies...),
 	}
 
+	// every handler must declare the permissions of its procedures with auth.Declare, procedures without a declared
+	// policy are denied.
+	interceptors = append(interceptors, auth.NomadInterceptor(), auth.NomadAuthzInterceptor())
+
 	// -- config/handlers --
 	options := []connect.HandlerOption{
 		connect.WithInterceptors(interceptors...),
 	}
 
-	// - insecure handlers -
 	// -- auth --
 	srv.add(auth.NomadAccountServiceHandler(options...))
 	srv.add(auth.NomadOrgServiceHandler(options...))
+	srv.add(auth.NomadSessionServiceHandler(options...))
+	srv.add(auth.NomadTeamServiceHandler(options...))
+	srv.add(auth.NomadTokenServiceHandler(options...))
 	srv.add(auth.NomadUserServiceHandler(options...))
 
-	// - secure handlers -
+	// the single sign-on is a browser flow, it is served outside of connect and its interceptors.
+	srv.add(auth.NomadSSOHandler(config.ExternalURL))
 
-	options = append(options, connect.WithInterceptors(auth.NomadInterceptor()))
+	// -- audit --
+	srv.add(auditnomad.NewAuditServiceHandler(options...))
 
 	// -- core/repos --
 	srv.add(repos.NomadHandler(options...))
@@ -46,5 +66,12 @@ func DefaultServer(opts ...Option) *Server {
 	// -- hooks/slack --
 	srv.add(slack.NomadHandler(options...))
 
+	// -- hooks/webhook --
+	srv.add(webhook.NomadHandler(options...))
+
+	// -- pulse --
+	srv.add(pulsenomad.NewEventServiceHandler(options...))
+	srv.add(pulsenomad.NewMetricsServiceHandler(options...))
+
 	return srv
 }
@@ -40,14 +40,52 @@ const (
 	OrgServiceGetOrgByIDProcedure = "/ctrlplane.auth.v1.OrgService/GetOrgByID"
 	// OrgServiceSetOrgHooksProcedure is the fully-qualified name of the OrgService's SetOrgHooks RPC.
 	OrgServiceSetOrgHooksProcedure = "/ctrlplane.auth.v1.OrgService/SetOrgHooks"
+	// OrgServiceCreateInvitationProcedure is the fully-qualified name of the OrgService's
+	// CreateInvitation RPC.
+	OrgServiceCreateInvitationProcedure = "/ctrlplane.auth.v1.OrgService/CreateInvitation"
+	// OrgServiceListInvitationsProcedure is the fully-qualified name of the OrgService's
+	// ListInvitations RPC.
+	OrgServiceListInvitationsProcedure = "/ctrlplane.auth.v1.OrgService/ListInvitations"
+	// OrgServiceRevokeInvitationProcedure is the fully-qualified name of the OrgService's
+	// RevokeInvitation RPC.
+	OrgServiceRevokeInvitationProcedure = "/ctrlplane.auth.v1.OrgService/RevokeInvitation"
+	// OrgServiceGetDomainProcedure is the fully-qualified name of the OrgService's GetDomain RPC.
+	OrgServiceGetDomainProcedure = "/ctrlplane.auth.v1.OrgService/GetDomain"
+	// OrgServiceVerifyDomainProcedure is the fully-qualified name of the OrgService's VerifyDomain RPC.
+	OrgServiceVerifyDomainProcedure = "/ctrlplane.auth.v1.OrgService/VerifyDomain"
+	// OrgServiceSetDomainAutoJoinProcedure is the fully-qualified name of the OrgService's
+	// SetDomainAutoJoin RPC.
+	OrgServiceSetDomainAutoJoinProcedure = "/ctrlplane.auth.v1.OrgService/SetDomainAutoJoin"
+	// OrgServiceTransferAdminProcedure is the fully-qualified name of the OrgService's TransferAdmin
+	// RPC.
+	OrgServiceTransferAdminProcedure = "/ctrlplane.auth.v1.OrgService/TransferAdmin"
+	// OrgServiceRemoveMemberProcedure is the fully-qualified name of the OrgService's RemoveMember RPC.
+	OrgServiceRemoveMemberProcedure = "/ctrlplane.auth.v1.OrgService/RemoveMember"
+	// OrgServiceGetSSOProcedure is the fully-qualified name of the OrgService's GetSSO RPC.
+	OrgServiceGetSSOProcedure = "/ctrlplane.auth.v1.OrgService/GetSSO"
+	// OrgServiceSetSSOProcedure is the fully-qualified name of the OrgService's SetSSO RPC.
+	OrgServiceSetSSOProcedure = "/ctrlplane.auth.v1.OrgService/SetSSO"
+	// OrgServiceDeleteSSOProcedure is the fully-qualified name of the OrgService's DeleteSSO RPC.
+	OrgServiceDeleteSSOProcedure = "/ctrlplane.auth.v1.OrgService/DeleteSSO"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	orgServiceServiceDescriptor           = v1.File_ctrlplane_auth_v1_orgs_proto.Services().ByName("OrgService")
-	orgServiceCreateOrgMethodDescriptor   = orgServiceServiceDescriptor.Methods().ByName("CreateOrg")
-	orgServiceGetOrgByIDMethodDescriptor  = orgServiceServiceDescriptor.Methods().ByName("GetOrgByID")
-	orgServiceSetOrgHooksMethodDescriptor = orgServiceServiceDescriptor.Methods().ByName("SetOrgHooks")
+	orgServiceServiceDescriptor                 = v1.File_ctrlplane_auth_v1_orgs_proto.Services().ByName("OrgService")
+	orgServiceCreateOrgMethodDescriptor         = orgServiceServiceDescriptor.Methods().ByName("CreateOrg")
+	orgServiceGetOrgByIDMethodDescriptor        = orgServiceServiceDescriptor.Methods().ByName("GetOrgByID")
+	orgServiceSetOrgHooksMethodDescriptor       = orgServiceServiceDescriptor.Methods().ByName("SetOrgHooks")
+	orgServiceCreateInvitationMethodDescriptor  = orgServiceServiceDescriptor.Methods().ByName("CreateInvitation")
+	orgServiceListInvitationsMethodDescriptor   = orgServiceServiceDescriptor.Methods().ByName("ListInvitations")
+	orgServiceRevokeInvitationMethodDescriptor  = orgServiceServiceDescriptor.Methods().ByName("RevokeInvitation")
+	orgServiceGetDomainMethodDescriptor         = orgServiceServiceDescriptor.Methods().ByName("GetDomain")
+	orgServiceVerifyDomainMethodDescriptor      = orgServiceServiceDescriptor.Methods().ByName("VerifyDomain")
+	orgServiceSetDomainAutoJoinMethodDescriptor = orgServiceServiceDescriptor.Methods().ByName("SetDomainAutoJoin")
+	orgServiceTransferAdminMethodDescriptor     = orgServiceServiceDescriptor.Methods().ByName("TransferAdmin")
+	orgServiceRemoveMemberMethodDescriptor      = orgServiceServiceDescriptor.Methods().ByName("RemoveMember")
+	orgServiceGetSSOMethodDescriptor            = orgServiceServiceDescriptor.Methods().ByName("GetSSO")
+	orgServiceSetSSOMethodDescriptor            = orgServiceServiceDescriptor.Methods().ByName("SetSSO")
+	orgServiceDeleteSSOMethodDescriptor         = orgServiceServiceDescriptor.Methods().ByName("DeleteSSO")
 )
 
 // OrgServiceClient is a client for the ctrlplane.auth.v1.OrgService service.
@@ -58,6 +96,30 @@ type OrgServiceClient interface {
 	GetOrgByID(context.Context, *connect.Request[v1.GetOrgByIDRequest]) (*connect.Response[v1.GetOrgByIDResponse], error)
 	// SetOrgHooks sets the hooks for an organization.
 	SetOrgHooks(context.Context, *connect.Request[v1.SetOrgHooksRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateInvitation invites a user to the organization by email, with a role. The token of the invitation is returned
+	// once, and is sent to the user by the caller.
+	CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error)
+	// ListInvitations lists the pending invitations of the organization.
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	// RevokeInvitation revokes a pending invitation.
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetDomain returns the TXT record verifying the domain of the organization, and the auto-join setting.
+	GetDomain(context.Context, *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error)
+	// VerifyDomain looks up the TXT record of the domain, and marks the domain as verified if the record is found.
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	// SetDomainAutoJoin enables or disables joining the organization by the domain of the email on sign up.
+	SetDomainAutoJoin(context.Context, *connect.Request[v1.SetDomainAutoJoinRequest]) (*connect.Response[v1.SetDomainAutoJoinResponse], error)
+	// TransferAdmin transfers the admin role of the authenticated user to another member of the organization.
+	TransferAdmin(context.Context, *connect.Request[v1.TransferAdminRequest]) (*connect.Response[emptypb.Empty], error)
+	// RemoveMember removes a member from the organization, with their roles and teams within it. The sessions of the
+	// member within the organization are rejected.
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetSSO returns the single sign-on of the organization.
+	GetSSO(context.Context, *connect.Request[v1.GetSSORequest]) (*connect.Response[v1.GetSSOResponse], error)
+	// SetSSO configures the single sign-on of the organization.
+	SetSSO(context.Context, *connect.Request[v1.SetSSORequest]) (*connect.Response[v1.SetSSOResponse], error)
+	// DeleteSSO removes the single sign-on of the organization.
+	DeleteSSO(context.Context, *connect.Request[v1.DeleteSSORequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewOrgServiceClient constructs a client for the ctrlplane.auth.v1.OrgService service. By default,
@@ -88,14 +150,91 @@ func NewOrgServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(orgServiceSetOrgHooksMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createInvitation: connect.NewClient[v1.CreateInvitationRequest, v1.CreateInvitationResponse](
+			httpClient,
+			baseURL+OrgServiceCreateInvitationProcedure,
+			connect.WithSchema(orgServiceCreateInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listInvitations: connect.NewClient[v1.ListInvitationsRequest, v1.ListInvitationsResponse](
+			httpClient,
+			baseURL+OrgServiceListInvitationsProcedure,
+			connect.WithSchema(orgServiceListInvitationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvitation: connect.NewClient[v1.RevokeInvitationRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrgServiceRevokeInvitationProcedure,
+			connect.WithSchema(orgServiceRevokeInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getDomain: connect.NewClient[v1.GetDomainRequest, v1.GetDomainResponse](
+			httpClient,
+			baseURL+OrgServiceGetDomainProcedure,
+			connect.WithSchema(orgServiceGetDomainMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		verifyDomain: connect.NewClient[v1.VerifyDomainRequest, v1.VerifyDomainResponse](
+			httpClient,
+			baseURL+OrgServiceVerifyDomainProcedure,
+			connect.WithSchema(orgServiceVerifyDomainMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setDomainAutoJoin: connect.NewClient[v1.SetDomainAutoJoinRequest, v1.SetDomainAutoJoinResponse](
+			httpClient,
+			baseURL+OrgServiceSetDomainAutoJoinProcedure,
+			connect.WithSchema(orgServiceSetDomainAutoJoinMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		transferAdmin: connect.NewClient[v1.TransferAdminRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrgServiceTransferAdminProcedure,
+			connect.WithSchema(orgServiceTransferAdminMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeMember: connect.NewClient[v1.RemoveMemberRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrgServiceRemoveMemberProcedure,
+			connect.WithSchema(orgServiceRemoveMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getSSO: connect.NewClient[v1.GetSSORequest, v1.GetSSOResponse](
+			httpClient,
+			baseURL+OrgServiceGetSSOProcedure,
+			connect.WithSchema(orgServiceGetSSOMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setSSO: connect.NewClient[v1.SetSSORequest, v1.SetSSOResponse](
+			httpClient,
+			baseURL+OrgServiceSetSSOProcedure,
+			connect.WithSchema(orgServiceSetSSOMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSSO: connect.NewClient[v1.DeleteSSORequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrgServiceDeleteSSOProcedure,
+			connect.WithSchema(orgServiceDeleteSSOMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // orgServiceClient implements OrgServiceClient.
 type orgServiceClient struct {
-	createOrg   *connect.Client[v1.CreateOrgRequest, v1.CreateOrgResponse]
-	getOrgByID  *connect.Client[v1.GetOrgByIDRequest, v1.GetOrgByIDResponse]
-	setOrgHooks *connect.Client[v1.SetOrgHooksRequest, emptypb.Empty]
+	createOrg         *connect.Client[v1.CreateOrgRequest, v1.CreateOrgResponse]
+	getOrgByID        *connect.Client[v1.GetOrgByIDRequest, v1.GetOrgByIDResponse]
+	setOrgHooks       *connect.Client[v1.SetOrgHooksRequest, emptypb.Empty]
+	createInvitation  *connect.Client[v1.CreateInvitationRequest, v1.CreateInvitationResponse]
+	listInvitations   *connect.Client[v1.ListInvitationsRequest, v1.ListInvitationsResponse]
+	revokeInvitation  *connect.Client[v1.RevokeInvitationRequest, emptypb.Empty]
+	getDomain         *connect.Client[v1.GetDomainRequest, v1.GetDomainResponse]
+	verifyDomain      *connect.Client[v1.VerifyDomainRequest, v1.VerifyDomainResponse]
+	setDomainAutoJoin *connect.Client[v1.SetDomainAutoJoinRequest, v1.SetDomainAutoJoinResponse]
+	transferAdmin     *connect.Client[v1.TransferAdminRequest, emptypb.Empty]
+	removeMember      *connect.Client[v1.RemoveMemberRequest, emptypb.Empty]
+	getSSO            *connect.Client[v1.GetSSORequest, v1.GetSSOResponse]
+	setSSO            *connect.Client[v1.SetSSORequest, v1.SetSSOResponse]
+	deleteSSO         *connect.Client[v1.DeleteSSORequest, emptypb.Empty]
 }
 
 // CreateOrg calls ctrlplane.auth.v1.OrgService.CreateOrg.
@@ -113,6 +252,61 @@ func (c *orgServiceClient) SetOrgHooks(ctx context.Context, req *connect.Request
 	return c.setOrgHooks.CallUnary(ctx, req)
 }
 
+// CreateInvitation calls ctrlplane.auth.v1.OrgService.CreateInvitation.
+func (c *orgServiceClient) CreateInvitation(ctx context.Context, req *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error) {
+	return c.createInvitation.CallUnary(ctx, req)
+}
+
+// ListInvitations calls ctrlplane.auth.v1.OrgService.ListInvitations.
+func (c *orgServiceClient) ListInvitations(ctx context.Context, req *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return c.listInvitations.CallUnary(ctx, req)
+}
+
+// RevokeInvitation calls ctrlplane.auth.v1.OrgService.RevokeInvitation.
+func (c *orgServiceClient) RevokeInvitation(ctx context.Context, req *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeInvitation.CallUnary(ctx, req)
+}
+
+// GetDomain calls ctrlplane.auth.v1.OrgService.GetDomain.
+func (c *orgServiceClient) GetDomain(ctx context.Context, req *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error) {
+	return c.getDomain.CallUnary(ctx, req)
+}
+
+// VerifyDomain calls ctrlplane.auth.v1.OrgService.VerifyDomain.
+func (c *orgServiceClient) VerifyDomain(ctx context.Context, req *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return c.verifyDomain.CallUnary(ctx, req)
+}
+
+// SetDomainAutoJoin calls ctrlplane.auth.v1.OrgService.SetDomainAutoJoin.
+func (c *orgServiceClient) SetDomainAutoJoin(ctx context.Context, req *connect.Request[v1.SetDomainAutoJoinRequest]) (*connect.Response[v1.SetDomainAutoJoinResponse], error) {
+	return c.setDomainAutoJoin.CallUnary(ctx, req)
+}
+
+// TransferAdmin calls ctrlplane.auth.v1.OrgService.TransferAdmin.
+func (c *orgServiceClient) TransferAdmin(ctx context.Context, req *connect.Request[v1.TransferAdminRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.transferAdmin.CallUnary(ctx, req)
+}
+
+// RemoveMember calls ctrlplane.auth.v1.OrgService.RemoveMember.
+func (c *orgServiceClient) RemoveMember(ctx context.Context, req *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeMember.CallUnary(ctx, req)
+}
+
+// GetSSO calls ctrlplane.auth.v1.OrgService.GetSSO.
+func (c *orgServiceClient) GetSSO(ctx context.Context, req *connect.Request[v1.GetSSORequest]) (*connect.Response[v1.GetSSOResponse], error) {
+	return c.getSSO.CallUnary(ctx, req)
+}
+
+// SetSSO calls ctrlplane.auth.v1.OrgService.SetSSO.
+func (c *orgServiceClient) SetSSO(ctx context.Context, req *connect.Request[v1.SetSSORequest]) (*connect.Response[v1.SetSSOResponse], error) {
+	return c.setSSO.CallUnary(ctx, req)
+}
+
+// DeleteSSO calls ctrlplane.auth.v1.OrgService.DeleteSSO.
+func (c *orgServiceClient) DeleteSSO(ctx context.Context, req *connect.Request[v1.DeleteSSORequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteSSO.CallUnary(ctx, req)
+}
+
 // OrgServiceHandler is an implementation of the ctrlplane.auth.v1.OrgService service.
 type OrgServiceHandler interface {
 	// CreateOrg creates a new organization.
@@ -121,6 +315,30 @@ type OrgServiceHandler interface {
 	GetOrgByID(context.Context, *connect.Request[v1.GetOrgByIDRequest]) (*connect.Response[v1.GetOrgByIDResponse], error)
 	// SetOrgHooks sets the hooks for an organization.
 	SetOrgHooks(context.Context, *connect.Request[v1.SetOrgHooksRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateInvitation invites a user to the organization by email, with a role. The token of the invitation is returned
+	// once, and is sent to the user by the caller.
+	CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error)
+	// ListInvitations lists the pending invitations of the organization.
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	// RevokeInvitation revokes a pending invitation.
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetDomain returns the TXT record verifying the domain of the organization, and the auto-join setting.
+	GetDomain(context.Context, *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error)
+	// VerifyDomain looks up the TXT record of the domain, and marks the domain as verified if the record is found.
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	// SetDomainAutoJoin enables or disables joining the organization by the domain of the email on sign up.
+	SetDomainAutoJoin(context.Context, *connect.Request[v1.SetDomainAutoJoinRequest]) (*connect.Response[v1.SetDomainAutoJoinResponse], error)
+	// TransferAdmin transfers the admin role of the authenticated user to another member of the organization.
+	TransferAdmin(context.Context, *connect.Request[v1.TransferAdminRequest]) (*connect.Response[emptypb.Empty], error)
+	// RemoveMember removes a member from the organization, with their roles and teams within it. The sessions of the
+	// member within the organization are rejected.
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetSSO returns the single sign-on of the organization.
+	GetSSO(context.Context, *connect.Request[v1.GetSSORequest]) (*connect.Response[v1.GetSSOResponse], error)
+	// SetSSO configures the single sign-on of the organization.
+	SetSSO(context.Context, *connect.Request[v1.SetSSORequest]) (*connect.Response[v1.SetSSOResponse], error)
+	// DeleteSSO removes the single sign-on of the organization.
+	DeleteSSO(context.Context, *connect.Request[v1.DeleteSSORequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewOrgServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -147,6 +365,72 @@ func NewOrgServiceHandler(svc OrgServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(orgServiceSetOrgHooksMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	orgServiceCreateInvitationHandler := connect.NewUnaryHandler(
+		OrgServiceCreateInvitationProcedure,
+		svc.CreateInvitation,
+		connect.WithSchema(orgServiceCreateInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceListInvitationsHandler := connect.NewUnaryHandler(
+		OrgServiceListInvitationsProcedure,
+		svc.ListInvitations,
+		connect.WithSchema(orgServiceListInvitationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceRevokeInvitationHandler := connect.NewUnaryHandler(
+		OrgServiceRevokeInvitationProcedure,
+		svc.RevokeInvitation,
+		connect.WithSchema(orgServiceRevokeInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceGetDomainHandler := connect.NewUnaryHandler(
+		OrgServiceGetDomainProcedure,
+		svc.GetDomain,
+		connect.WithSchema(orgServiceGetDomainMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceVerifyDomainHandler := connect.NewUnaryHandler(
+		OrgServiceVerifyDomainProcedure,
+		svc.VerifyDomain,
+		connect.WithSchema(orgServiceVerifyDomainMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceSetDomainAutoJoinHandler := connect.NewUnaryHandler(
+		OrgServiceSetDomainAutoJoinProcedure,
+		svc.SetDomainAutoJoin,
+		connect.WithSchema(orgServiceSetDomainAutoJoinMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceTransferAdminHandler := connect.NewUnaryHandler(
+		OrgServiceTransferAdminProcedure,
+		svc.TransferAdmin,
+		connect.WithSchema(orgServiceTransferAdminMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceRemoveMemberHandler := connect.NewUnaryHandler(
+		OrgServiceRemoveMemberProcedure,
+		svc.RemoveMember,
+		connect.WithSchema(orgServiceRemoveMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceGetSSOHandler := connect.NewUnaryHandler(
+		OrgServiceGetSSOProcedure,
+		svc.GetSSO,
+		connect.WithSchema(orgServiceGetSSOMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceSetSSOHandler := connect.NewUnaryHandler(
+		OrgServiceSetSSOProcedure,
+		svc.SetSSO,
+		connect.WithSchema(orgServiceSetSSOMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	orgServiceDeleteSSOHandler := connect.NewUnaryHandler(
+		OrgServiceDeleteSSOProcedure,
+		svc.DeleteSSO,
+		connect.WithSchema(orgServiceDeleteSSOMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ctrlplane.auth.v1.OrgService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrgServiceCreateOrgProcedure:
@@ -155,6 +439,28 @@ func NewOrgServiceHandler(svc OrgServiceHandler, opts ...connect.HandlerOption)
 			orgServiceGetOrgByIDHandler.ServeHTTP(w, r)
 		case OrgServiceSetOrgHooksProcedure:
 			orgServiceSetOrgHooksHandler.ServeHTTP(w, r)
+		case OrgServiceCreateInvitationProcedure:
+			orgServiceCreateInvitationHandler.ServeHTTP(w, r)
+		case OrgServiceListInvitationsProcedure:
+			orgServiceListInvitationsHandler.ServeHTTP(w, r)
+		case OrgServiceRevokeInvitationProcedure:
+			orgServiceRevokeInvitationHandler.ServeHTTP(w, r)
+		case OrgServiceGetDomainProcedure:
+			orgServiceGetDomainHandler.ServeHTTP(w, r)
+		case OrgServiceVerifyDomainProcedure:
+			orgServiceVerifyDomainHandler.ServeHTTP(w, r)
+		case OrgServiceSetDomainAutoJoinProcedure:
+			orgServiceSetDomainAutoJoinHandler.ServeHTTP(w, r)
+		case OrgServiceTransferAdminProcedure:
+			orgServiceTransferAdminHandler.ServeHTTP(w, r)
+		case OrgServiceRemoveMemberProcedure:
+			orgServiceRemoveMemberHandler.ServeHTTP(w, r)
+		case OrgServiceGetSSOProcedure:
+			orgServiceGetSSOHandler.ServeHTTP(w, r)
+		case OrgServiceSetSSOProcedure:
+			orgServiceSetSSOHandler.ServeHTTP(w, r)
+		case OrgServiceDeleteSSOProcedure:
+			orgServiceDeleteSSOHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -175,3 +481,47 @@ func (UnimplementedOrgServiceHandler) GetOrgByID(context.Context, *connect.Reque
 func (UnimplementedOrgServiceHandler) SetOrgHooks(context.Context, *connect.Request[v1.SetOrgHooksRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.SetOrgHooks is not implemented"))
 }
+
+func (UnimplementedOrgServiceHandler) CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.CreateInvitation is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.ListInvitations is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.RevokeInvitation is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) GetDomain(context.Context, *connect.Request[v1.GetDomainRequest]) (*connect.Response[v1.GetDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.GetDomain is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.VerifyDomain is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) SetDomainAutoJoin(context.Context, *connect.Request[v1.SetDomainAutoJoinRequest]) (*connect.Response[v1.SetDomainAutoJoinResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.SetDomainAutoJoin is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) TransferAdmin(context.Context, *connect.Request[v1.TransferAdminRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.TransferAdmin is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.RemoveMember is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) GetSSO(context.Context, *connect.Request[v1.GetSSORequest]) (*connect.Response[v1.GetSSOResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.GetSSO is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) SetSSO(context.Context, *connect.Request[v1.SetSSORequest]) (*connect.Response[v1.SetSSOResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.SetSSO is not implemented"))
+}
+
+func (UnimplementedOrgServiceHandler) DeleteSSO(context.Context, *connect.Request[v1.DeleteSSORequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.OrgService.DeleteSSO is not implemented"))
+}
@@ -9,6 +9,7 @@ import (
 	context "context"
 	errors "errors"
 	v1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -35,18 +36,77 @@ const (
 const (
 	// TeamServiceCreateTeamProcedure is the fully-qualified name of the TeamService's CreateTeam RPC.
 	TeamServiceCreateTeamProcedure = "/ctrlplane.auth.v1.TeamService/CreateTeam"
+	// TeamServiceGetTeamProcedure is the fully-qualified name of the TeamService's GetTeam RPC.
+	TeamServiceGetTeamProcedure = "/ctrlplane.auth.v1.TeamService/GetTeam"
+	// TeamServiceListTeamsProcedure is the fully-qualified name of the TeamService's ListTeams RPC.
+	TeamServiceListTeamsProcedure = "/ctrlplane.auth.v1.TeamService/ListTeams"
+	// TeamServiceUpdateTeamProcedure is the fully-qualified name of the TeamService's UpdateTeam RPC.
+	TeamServiceUpdateTeamProcedure = "/ctrlplane.auth.v1.TeamService/UpdateTeam"
+	// TeamServiceDeleteTeamProcedure is the fully-qualified name of the TeamService's DeleteTeam RPC.
+	TeamServiceDeleteTeamProcedure = "/ctrlplane.auth.v1.TeamService/DeleteTeam"
+	// TeamServiceAddTeamMemberProcedure is the fully-qualified name of the TeamService's AddTeamMember
+	// RPC.
+	TeamServiceAddTeamMemberProcedure = "/ctrlplane.auth.v1.TeamService/AddTeamMember"
+	// TeamServiceRemoveTeamMemberProcedure is the fully-qualified name of the TeamService's
+	// RemoveTeamMember RPC.
+	TeamServiceRemoveTeamMemberProcedure = "/ctrlplane.auth.v1.TeamService/RemoveTeamMember"
+	// TeamServiceListTeamMembersProcedure is the fully-qualified name of the TeamService's
+	// ListTeamMembers RPC.
+	TeamServiceListTeamMembersProcedure = "/ctrlplane.auth.v1.TeamService/ListTeamMembers"
+	// TeamServiceAssignRepoProcedure is the fully-qualified name of the TeamService's AssignRepo RPC.
+	TeamServiceAssignRepoProcedure = "/ctrlplane.auth.v1.TeamService/AssignRepo"
+	// TeamServiceUnassignRepoProcedure is the fully-qualified name of the TeamService's UnassignRepo
+	// RPC.
+	TeamServiceUnassignRepoProcedure = "/ctrlplane.auth.v1.TeamService/UnassignRepo"
+	// TeamServiceListTeamReposProcedure is the fully-qualified name of the TeamService's ListTeamRepos
+	// RPC.
+	TeamServiceListTeamReposProcedure = "/ctrlplane.auth.v1.TeamService/ListTeamRepos"
+	// TeamServiceGetTeamDigestSubscriptionProcedure is the fully-qualified name of the TeamService's
+	// GetTeamDigestSubscription RPC.
+	TeamServiceGetTeamDigestSubscriptionProcedure = "/ctrlplane.auth.v1.TeamService/GetTeamDigestSubscription"
+	// TeamServiceSetTeamDigestSubscriptionProcedure is the fully-qualified name of the TeamService's
+	// SetTeamDigestSubscription RPC.
+	TeamServiceSetTeamDigestSubscriptionProcedure = "/ctrlplane.auth.v1.TeamService/SetTeamDigestSubscription"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	teamServiceServiceDescriptor          = v1.File_ctrlplane_auth_v1_teams_proto.Services().ByName("TeamService")
-	teamServiceCreateTeamMethodDescriptor = teamServiceServiceDescriptor.Methods().ByName("CreateTeam")
+	teamServiceServiceDescriptor                         = v1.File_ctrlplane_auth_v1_teams_proto.Services().ByName("TeamService")
+	teamServiceCreateTeamMethodDescriptor                = teamServiceServiceDescriptor.Methods().ByName("CreateTeam")
+	teamServiceGetTeamMethodDescriptor                   = teamServiceServiceDescriptor.Methods().ByName("GetTeam")
+	teamServiceListTeamsMethodDescriptor                 = teamServiceServiceDescriptor.Methods().ByName("ListTeams")
+	teamServiceUpdateTeamMethodDescriptor                = teamServiceServiceDescriptor.Methods().ByName("UpdateTeam")
+	teamServiceDeleteTeamMethodDescriptor                = teamServiceServiceDescriptor.Methods().ByName("DeleteTeam")
+	teamServiceAddTeamMemberMethodDescriptor             = teamServiceServiceDescriptor.Methods().ByName("AddTeamMember")
+	teamServiceRemoveTeamMemberMethodDescriptor          = teamServiceServiceDescriptor.Methods().ByName("RemoveTeamMember")
+	teamServiceListTeamMembersMethodDescriptor           = teamServiceServiceDescriptor.Methods().ByName("ListTeamMembers")
+	teamServiceAssignRepoMethodDescriptor                = teamServiceServiceDescriptor.Methods().ByName("AssignRepo")
+	teamServiceUnassignRepoMethodDescriptor              = teamServiceServiceDescriptor.Methods().ByName("UnassignRepo")
+	teamServiceListTeamReposMethodDescriptor             = teamServiceServiceDescriptor.Methods().ByName("ListTeamRepos")
+	teamServiceGetTeamDigestSubscriptionMethodDescriptor = teamServiceServiceDescriptor.Methods().ByName("GetTeamDigestSubscription")
+	teamServiceSetTeamDigestSubscriptionMethodDescriptor = teamServiceServiceDescriptor.Methods().ByName("SetTeamDigestSubscription")
 )
 
 // TeamServiceClient is a client for the ctrlplane.auth.v1.TeamService service.
 type TeamServiceClient interface {
-	// CreateTeam creates a new team.
 	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	GetTeam(context.Context, *connect.Request[v1.GetTeamRequest]) (*connect.Response[v1.GetTeamResponse], error)
+	// Lists the teams of the org.
+	ListTeams(context.Context, *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error)
+	UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error)
+	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[emptypb.Empty], error)
+	// Adds a member to a team, or changes the role of a member.
+	AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error)
+	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[emptypb.Empty], error)
+	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
+	// Assigns a repo, or a path within a repo, to a team.
+	AssignRepo(context.Context, *connect.Request[v1.AssignRepoRequest]) (*connect.Response[v1.AssignRepoResponse], error)
+	UnassignRepo(context.Context, *connect.Request[v1.UnassignRepoRequest]) (*connect.Response[emptypb.Empty], error)
+	ListTeamRepos(context.Context, *connect.Request[v1.ListTeamReposRequest]) (*connect.Response[v1.ListTeamReposResponse], error)
+	// Retrieves the email digest subscription of a team. Teams without a subscription are reported as opted out.
+	GetTeamDigestSubscription(context.Context, *connect.Request[v1.GetTeamDigestSubscriptionRequest]) (*connect.Response[v1.GetTeamDigestSubscriptionResponse], error)
+	// Opts a team in or out of the email digest, and sets where and how often the digest is sent.
+	SetTeamDigestSubscription(context.Context, *connect.Request[v1.SetTeamDigestSubscriptionRequest]) (*connect.Response[v1.SetTeamDigestSubscriptionResponse], error)
 }
 
 // NewTeamServiceClient constructs a client for the ctrlplane.auth.v1.TeamService service. By
@@ -65,12 +125,96 @@ func NewTeamServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(teamServiceCreateTeamMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getTeam: connect.NewClient[v1.GetTeamRequest, v1.GetTeamResponse](
+			httpClient,
+			baseURL+TeamServiceGetTeamProcedure,
+			connect.WithSchema(teamServiceGetTeamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTeams: connect.NewClient[v1.ListTeamsRequest, v1.ListTeamsResponse](
+			httpClient,
+			baseURL+TeamServiceListTeamsProcedure,
+			connect.WithSchema(teamServiceListTeamsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateTeam: connect.NewClient[v1.UpdateTeamRequest, v1.UpdateTeamResponse](
+			httpClient,
+			baseURL+TeamServiceUpdateTeamProcedure,
+			connect.WithSchema(teamServiceUpdateTeamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTeam: connect.NewClient[v1.DeleteTeamRequest, emptypb.Empty](
+			httpClient,
+			baseURL+TeamServiceDeleteTeamProcedure,
+			connect.WithSchema(teamServiceDeleteTeamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		addTeamMember: connect.NewClient[v1.AddTeamMemberRequest, v1.AddTeamMemberResponse](
+			httpClient,
+			baseURL+TeamServiceAddTeamMemberProcedure,
+			connect.WithSchema(teamServiceAddTeamMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeTeamMember: connect.NewClient[v1.RemoveTeamMemberRequest, emptypb.Empty](
+			httpClient,
+			baseURL+TeamServiceRemoveTeamMemberProcedure,
+			connect.WithSchema(teamServiceRemoveTeamMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTeamMembers: connect.NewClient[v1.ListTeamMembersRequest, v1.ListTeamMembersResponse](
+			httpClient,
+			baseURL+TeamServiceListTeamMembersProcedure,
+			connect.WithSchema(teamServiceListTeamMembersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		assignRepo: connect.NewClient[v1.AssignRepoRequest, v1.AssignRepoResponse](
+			httpClient,
+			baseURL+TeamServiceAssignRepoProcedure,
+			connect.WithSchema(teamServiceAssignRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unassignRepo: connect.NewClient[v1.UnassignRepoRequest, emptypb.Empty](
+			httpClient,
+			baseURL+TeamServiceUnassignRepoProcedure,
+			connect.WithSchema(teamServiceUnassignRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTeamRepos: connect.NewClient[v1.ListTeamReposRequest, v1.ListTeamReposResponse](
+			httpClient,
+			baseURL+TeamServiceListTeamReposProcedure,
+			connect.WithSchema(teamServiceListTeamReposMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTeamDigestSubscription: connect.NewClient[v1.GetTeamDigestSubscriptionRequest, v1.GetTeamDigestSubscriptionResponse](
+			httpClient,
+			baseURL+TeamServiceGetTeamDigestSubscriptionProcedure,
+			connect.WithSchema(teamServiceGetTeamDigestSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setTeamDigestSubscription: connect.NewClient[v1.SetTeamDigestSubscriptionRequest, v1.SetTeamDigestSubscriptionResponse](
+			httpClient,
+			baseURL+TeamServiceSetTeamDigestSubscriptionProcedure,
+			connect.WithSchema(teamServiceSetTeamDigestSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // teamServiceClient implements TeamServiceClient.
 type teamServiceClient struct {
-	createTeam *connect.Client[v1.CreateTeamRequest, v1.CreateTeamResponse]
+	createTeam                *connect.Client[v1.CreateTeamRequest, v1.CreateTeamResponse]
+	getTeam                   *connect.Client[v1.GetTeamRequest, v1.GetTeamResponse]
+	listTeams                 *connect.Client[v1.ListTeamsRequest, v1.ListTeamsResponse]
+	updateTeam                *connect.Client[v1.UpdateTeamRequest, v1.UpdateTeamResponse]
+	deleteTeam                *connect.Client[v1.DeleteTeamRequest, emptypb.Empty]
+	addTeamMember             *connect.Client[v1.AddTeamMemberRequest, v1.AddTeamMemberResponse]
+	removeTeamMember          *connect.Client[v1.RemoveTeamMemberRequest, emptypb.Empty]
+	listTeamMembers           *connect.Client[v1.ListTeamMembersRequest, v1.ListTeamMembersResponse]
+	assignRepo                *connect.Client[v1.AssignRepoRequest, v1.AssignRepoResponse]
+	unassignRepo              *connect.Client[v1.UnassignRepoRequest, emptypb.Empty]
+	listTeamRepos             *connect.Client[v1.ListTeamReposRequest, v1.ListTeamReposResponse]
+	getTeamDigestSubscription *connect.Client[v1.GetTeamDigestSubscriptionRequest, v1.GetTeamDigestSubscriptionResponse]
+	setTeamDigestSubscription *connect.Client[v1.SetTeamDigestSubscriptionRequest, v1.SetTeamDigestSubscriptionResponse]
 }
 
 // CreateTeam calls ctrlplane.auth.v1.TeamService.CreateTeam.
@@ -78,10 +222,86 @@ func (c *teamServiceClient) CreateTeam(ctx context.Context, req *connect.Request
 	return c.createTeam.CallUnary(ctx, req)
 }
 
+// GetTeam calls ctrlplane.auth.v1.TeamService.GetTeam.
+func (c *teamServiceClient) GetTeam(ctx context.Context, req *connect.Request[v1.GetTeamRequest]) (*connect.Response[v1.GetTeamResponse], error) {
+	return c.getTeam.CallUnary(ctx, req)
+}
+
+// ListTeams calls ctrlplane.auth.v1.TeamService.ListTeams.
+func (c *teamServiceClient) ListTeams(ctx context.Context, req *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error) {
+	return c.listTeams.CallUnary(ctx, req)
+}
+
+// UpdateTeam calls ctrlplane.auth.v1.TeamService.UpdateTeam.
+func (c *teamServiceClient) UpdateTeam(ctx context.Context, req *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error) {
+	return c.updateTeam.CallUnary(ctx, req)
+}
+
+// DeleteTeam calls ctrlplane.auth.v1.TeamService.DeleteTeam.
+func (c *teamServiceClient) DeleteTeam(ctx context.Context, req *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteTeam.CallUnary(ctx, req)
+}
+
+// AddTeamMember calls ctrlplane.auth.v1.TeamService.AddTeamMember.
+func (c *teamServiceClient) AddTeamMember(ctx context.Context, req *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error) {
+	return c.addTeamMember.CallUnary(ctx, req)
+}
+
+// RemoveTeamMember calls ctrlplane.auth.v1.TeamService.RemoveTeamMember.
+func (c *teamServiceClient) RemoveTeamMember(ctx context.Context, req *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeTeamMember.CallUnary(ctx, req)
+}
+
+// ListTeamMembers calls ctrlplane.auth.v1.TeamService.ListTeamMembers.
+func (c *teamServiceClient) ListTeamMembers(ctx context.Context, req *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error) {
+	return c.listTeamMembers.CallUnary(ctx, req)
+}
+
+// AssignRepo calls ctrlplane.auth.v1.TeamService.AssignRepo.
+func (c *teamServiceClient) AssignRepo(ctx context.Context, req *connect.Request[v1.AssignRepoRequest]) (*connect.Response[v1.AssignRepoResponse], error) {
+	return c.assignRepo.CallUnary(ctx, req)
+}
+
+// UnassignRepo calls ctrlplane.auth.v1.TeamService.UnassignRepo.
+func (c *teamServiceClient) UnassignRepo(ctx context.Context, req *connect.Request[v1.UnassignRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.unassignRepo.CallUnary(ctx, req)
+}
+
+// ListTeamRepos calls ctrlplane.auth.v1.TeamService.ListTeamRepos.
+func (c *teamServiceClient) ListTeamRepos(ctx context.Context, req *connect.Request[v1.ListTeamReposRequest]) (*connect.Response[v1.ListTeamReposResponse], error) {
+	return c.listTeamRepos.CallUnary(ctx, req)
+}
+
+// GetTeamDigestSubscription calls ctrlplane.auth.v1.TeamService.GetTeamDigestSubscription.
+func (c *teamServiceClient) GetTeamDigestSubscription(ctx context.Context, req *connect.Request[v1.GetTeamDigestSubscriptionRequest]) (*connect.Response[v1.GetTeamDigestSubscriptionResponse], error) {
+	return c.getTeamDigestSubscription.CallUnary(ctx, req)
+}
+
+// SetTeamDigestSubscription calls ctrlplane.auth.v1.TeamService.SetTeamDigestSubscription.
+func (c *teamServiceClient) SetTeamDigestSubscription(ctx context.Context, req *connect.Request[v1.SetTeamDigestSubscriptionRequest]) (*connect.Response[v1.SetTeamDigestSubscriptionResponse], error) {
+	return c.setTeamDigestSubscription.CallUnary(ctx, req)
+}
+
 // TeamServiceHandler is an implementation of the ctrlplane.auth.v1.TeamService service.
 type TeamServiceHandler interface {
-	// CreateTeam creates a new team.
 	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	GetTeam(context.Context, *connect.Request[v1.GetTeamRequest]) (*connect.Response[v1.GetTeamResponse], error)
+	// Lists the teams of the org.
+	ListTeams(context.Context, *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error)
+	UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error)
+	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[emptypb.Empty], error)
+	// Adds a member to a team, or changes the role of a member.
+	AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error)
+	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[emptypb.Empty], error)
+	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
+	// Assigns a repo, or a path within a repo, to a team.
+	AssignRepo(context.Context, *connect.Request[v1.AssignRepoRequest]) (*connect.Response[v1.AssignRepoResponse], error)
+	UnassignRepo(context.Context, *connect.Request[v1.UnassignRepoRequest]) (*connect.Response[emptypb.Empty], error)
+	ListTeamRepos(context.Context, *connect.Request[v1.ListTeamReposRequest]) (*connect.Response[v1.ListTeamReposResponse], error)
+	// Retrieves the email digest subscription of a team. Teams without a subscription are reported as opted out.
+	GetTeamDigestSubscription(context.Context, *connect.Request[v1.GetTeamDigestSubscriptionRequest]) (*connect.Response[v1.GetTeamDigestSubscriptionResponse], error)
+	// Opts a team in or out of the email digest, and sets where and how often the digest is sent.
+	SetTeamDigestSubscription(context.Context, *connect.Request[v1.SetTeamDigestSubscriptionRequest]) (*connect.Response[v1.SetTeamDigestSubscriptionResponse], error)
 }
 
 // NewTeamServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -96,10 +316,106 @@ func NewTeamServiceHandler(svc TeamServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(teamServiceCreateTeamMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	teamServiceGetTeamHandler := connect.NewUnaryHandler(
+		TeamServiceGetTeamProcedure,
+		svc.GetTeam,
+		connect.WithSchema(teamServiceGetTeamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceListTeamsHandler := connect.NewUnaryHandler(
+		TeamServiceListTeamsProcedure,
+		svc.ListTeams,
+		connect.WithSchema(teamServiceListTeamsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceUpdateTeamHandler := connect.NewUnaryHandler(
+		TeamServiceUpdateTeamProcedure,
+		svc.UpdateTeam,
+		connect.WithSchema(teamServiceUpdateTeamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceDeleteTeamHandler := connect.NewUnaryHandler(
+		TeamServiceDeleteTeamProcedure,
+		svc.DeleteTeam,
+		connect.WithSchema(teamServiceDeleteTeamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceAddTeamMemberHandler := connect.NewUnaryHandler(
+		TeamServiceAddTeamMemberProcedure,
+		svc.AddTeamMember,
+		connect.WithSchema(teamServiceAddTeamMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceRemoveTeamMemberHandler := connect.NewUnaryHandler(
+		TeamServiceRemoveTeamMemberProcedure,
+		svc.RemoveTeamMember,
+		connect.WithSchema(teamServiceRemoveTeamMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceListTeamMembersHandler := connect.NewUnaryHandler(
+		TeamServiceListTeamMembersProcedure,
+		svc.ListTeamMembers,
+		connect.WithSchema(teamServiceListTeamMembersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceAssignRepoHandler := connect.NewUnaryHandler(
+		TeamServiceAssignRepoProcedure,
+		svc.AssignRepo,
+		connect.WithSchema(teamServiceAssignRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceUnassignRepoHandler := connect.NewUnaryHandler(
+		TeamServiceUnassignRepoProcedure,
+		svc.UnassignRepo,
+		connect.WithSchema(teamServiceUnassignRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceListTeamReposHandler := connect.NewUnaryHandler(
+		TeamServiceListTeamReposProcedure,
+		svc.ListTeamRepos,
+		connect.WithSchema(teamServiceListTeamReposMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceGetTeamDigestSubscriptionHandler := connect.NewUnaryHandler(
+		TeamServiceGetTeamDigestSubscriptionProcedure,
+		svc.GetTeamDigestSubscription,
+		connect.WithSchema(teamServiceGetTeamDigestSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceSetTeamDigestSubscriptionHandler := connect.NewUnaryHandler(
+		TeamServiceSetTeamDigestSubscriptionProcedure,
+		svc.SetTeamDigestSubscription,
+		connect.WithSchema(teamServiceSetTeamDigestSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ctrlplane.auth.v1.TeamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TeamServiceCreateTeamProcedure:
 			teamServiceCreateTeamHandler.ServeHTTP(w, r)
+		case TeamServiceGetTeamProcedure:
+			teamServiceGetTeamHandler.ServeHTTP(w, r)
+		case TeamServiceListTeamsProcedure:
+			teamServiceListTeamsHandler.ServeHTTP(w, r)
+		case TeamServiceUpdateTeamProcedure:
+			teamServiceUpdateTeamHandler.ServeHTTP(w, r)
+		case TeamServiceDeleteTeamProcedure:
+			teamServiceDeleteTeamHandler.ServeHTTP(w, r)
+		case TeamServiceAddTeamMemberProcedure:
+			teamServiceAddTeamMemberHandler.ServeHTTP(w, r)
+		case TeamServiceRemoveTeamMemberProcedure:
+			teamServiceRemoveTeamMemberHandler.ServeHTTP(w, r)
+		case TeamServiceListTeamMembersProcedure:
+			teamServiceListTeamMembersHandler.ServeHTTP(w, r)
+		case TeamServiceAssignRepoProcedure:
+			teamServiceAssignRepoHandler.ServeHTTP(w, r)
+		case TeamServiceUnassignRepoProcedure:
+			teamServiceUnassignRepoHandler.ServeHTTP(w, r)
+		case TeamServiceListTeamReposProcedure:
+			teamServiceListTeamReposHandler.ServeHTTP(w, r)
+		case TeamServiceGetTeamDigestSubscriptionProcedure:
+			teamServiceGetTeamDigestSubscriptionHandler.ServeHTTP(w, r)
+		case TeamServiceSetTeamDigestSubscriptionProcedure:
+			teamServiceSetTeamDigestSubscriptionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -112,3 +428,51 @@ type UnimplementedTeamServiceHandler struct{}
 func (UnimplementedTeamServiceHandler) CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.CreateTeam is not implemented"))
 }
+
+func (UnimplementedTeamServiceHandler) GetTeam(context.Context, *connect.Request[v1.GetTeamRequest]) (*connect.Response[v1.GetTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.GetTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) ListTeams(context.Context, *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.ListTeams is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.UpdateTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.DeleteTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.AddTeamMember is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.RemoveTeamMember is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.ListTeamMembers is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) AssignRepo(context.Context, *connect.Request[v1.AssignRepoRequest]) (*connect.Response[v1.AssignRepoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.AssignRepo is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) UnassignRepo(context.Context, *connect.Request[v1.UnassignRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.UnassignRepo is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) ListTeamRepos(context.Context, *connect.Request[v1.ListTeamReposRequest]) (*connect.Response[v1.ListTeamReposResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.ListTeamRepos is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) GetTeamDigestSubscription(context.Context, *connect.Request[v1.GetTeamDigestSubscriptionRequest]) (*connect.Response[v1.GetTeamDigestSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.GetTeamDigestSubscription is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) SetTeamDigestSubscription(context.Context, *connect.Request[v1.SetTeamDigestSubscriptionRequest]) (*connect.Response[v1.SetTeamDigestSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.TeamService.SetTeamDigestSubscription is not implemented"))
+}
@@ -45,16 +45,40 @@ const (
 	UserServiceGetUserByIDProcedure = "/ctrlplane.auth.v1.UserService/GetUserByID"
 	// UserServiceUpdateUserProcedure is the fully-qualified name of the UserService's UpdateUser RPC.
 	UserServiceUpdateUserProcedure = "/ctrlplane.auth.v1.UserService/UpdateUser"
+	// UserServiceGetDigestSubscriptionProcedure is the fully-qualified name of the UserService's
+	// GetDigestSubscription RPC.
+	UserServiceGetDigestSubscriptionProcedure = "/ctrlplane.auth.v1.UserService/GetDigestSubscription"
+	// UserServiceSetDigestSubscriptionProcedure is the fully-qualified name of the UserService's
+	// SetDigestSubscription RPC.
+	UserServiceSetDigestSubscriptionProcedure = "/ctrlplane.auth.v1.UserService/SetDigestSubscription"
+	// UserServiceGetNotificationPreferencesProcedure is the fully-qualified name of the UserService's
+	// GetNotificationPreferences RPC.
+	UserServiceGetNotificationPreferencesProcedure = "/ctrlplane.auth.v1.UserService/GetNotificationPreferences"
+	// UserServiceSetNotificationPreferencesProcedure is the fully-qualified name of the UserService's
+	// SetNotificationPreferences RPC.
+	UserServiceSetNotificationPreferencesProcedure = "/ctrlplane.auth.v1.UserService/SetNotificationPreferences"
+	// UserServiceAcceptInvitationProcedure is the fully-qualified name of the UserService's
+	// AcceptInvitation RPC.
+	UserServiceAcceptInvitationProcedure = "/ctrlplane.auth.v1.UserService/AcceptInvitation"
+	// UserServiceSetUserActiveProcedure is the fully-qualified name of the UserService's SetUserActive
+	// RPC.
+	UserServiceSetUserActiveProcedure = "/ctrlplane.auth.v1.UserService/SetUserActive"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	userServiceServiceDescriptor                        = v1.File_ctrlplane_auth_v1_users_proto.Services().ByName("UserService")
-	userServiceCreateUserMethodDescriptor               = userServiceServiceDescriptor.Methods().ByName("CreateUser")
-	userServiceGetUserByProviderAccountMethodDescriptor = userServiceServiceDescriptor.Methods().ByName("GetUserByProviderAccount")
-	userServiceGetUserByEmailMethodDescriptor           = userServiceServiceDescriptor.Methods().ByName("GetUserByEmail")
-	userServiceGetUserByIDMethodDescriptor              = userServiceServiceDescriptor.Methods().ByName("GetUserByID")
-	userServiceUpdateUserMethodDescriptor               = userServiceServiceDescriptor.Methods().ByName("UpdateUser")
+	userServiceServiceDescriptor                          = v1.File_ctrlplane_auth_v1_users_proto.Services().ByName("UserService")
+	userServiceCreateUserMethodDescriptor                 = userServiceServiceDescriptor.Methods().ByName("CreateUser")
+	userServiceGetUserByProviderAccountMethodDescriptor   = userServiceServiceDescriptor.Methods().ByName("GetUserByProviderAccount")
+	userServiceGetUserByEmailMethodDescriptor             = userServiceServiceDescriptor.Methods().ByName("GetUserByEmail")
+	userServiceGetUserByIDMethodDescriptor                = userServiceServiceDescriptor.Methods().ByName("GetUserByID")
+	userServiceUpdateUserMethodDescriptor                 = userServiceServiceDescriptor.Methods().ByName("UpdateUser")
+	userServiceGetDigestSubscriptionMethodDescriptor      = userServiceServiceDescriptor.Methods().ByName("GetDigestSubscription")
+	userServiceSetDigestSubscriptionMethodDescriptor      = userServiceServiceDescriptor.Methods().ByName("SetDigestSubscription")
+	userServiceGetNotificationPreferencesMethodDescriptor = userServiceServiceDescriptor.Methods().ByName("GetNotificationPreferences")
+	userServiceSetNotificationPreferencesMethodDescriptor = userServiceServiceDescriptor.Methods().ByName("SetNotificationPreferences")
+	userServiceAcceptInvitationMethodDescriptor           = userServiceServiceDescriptor.Methods().ByName("AcceptInvitation")
+	userServiceSetUserActiveMethodDescriptor              = userServiceServiceDescriptor.Methods().ByName("SetUserActive")
 )
 
 // UserServiceClient is a client for the ctrlplane.auth.v1.UserService service.
@@ -70,6 +94,18 @@ type UserServiceClient interface {
 	GetUserByID(context.Context, *connect.Request[v1.GetUserByIDRequest]) (*connect.Response[v1.AuthUser], error)
 	// Updates an existing user account.
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
+	// Retrieves the email digest subscription of a user. Users without a subscription are reported as opted out.
+	GetDigestSubscription(context.Context, *connect.Request[v1.GetDigestSubscriptionRequest]) (*connect.Response[v1.GetDigestSubscriptionResponse], error)
+	// Opts a user in or out of the email digest, and sets how often the digest is sent.
+	SetDigestSubscription(context.Context, *connect.Request[v1.SetDigestSubscriptionRequest]) (*connect.Response[v1.SetDigestSubscriptionResponse], error)
+	// Retrieves the notification preferences of a user. Users without saved preferences get the defaults.
+	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
+	// Updates the notification preferences of a user.
+	SetNotificationPreferences(context.Context, *connect.Request[v1.SetNotificationPreferencesRequest]) (*connect.Response[v1.SetNotificationPreferencesResponse], error)
+	// Accepts an invitation for an existing user. The user joins the organization of the invitation with its role.
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AuthUser], error)
+	// Activates or deactivates a user. Deactivating a user revokes all their sessions.
+	SetUserActive(context.Context, *connect.Request[v1.SetUserActiveRequest]) (*connect.Response[v1.SetUserActiveResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the ctrlplane.auth.v1.UserService service. By
@@ -112,16 +148,58 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceUpdateUserMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getDigestSubscription: connect.NewClient[v1.GetDigestSubscriptionRequest, v1.GetDigestSubscriptionResponse](
+			httpClient,
+			baseURL+UserServiceGetDigestSubscriptionProcedure,
+			connect.WithSchema(userServiceGetDigestSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setDigestSubscription: connect.NewClient[v1.SetDigestSubscriptionRequest, v1.SetDigestSubscriptionResponse](
+			httpClient,
+			baseURL+UserServiceSetDigestSubscriptionProcedure,
+			connect.WithSchema(userServiceSetDigestSubscriptionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationPreferences: connect.NewClient[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+UserServiceGetNotificationPreferencesProcedure,
+			connect.WithSchema(userServiceGetNotificationPreferencesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setNotificationPreferences: connect.NewClient[v1.SetNotificationPreferencesRequest, v1.SetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+UserServiceSetNotificationPreferencesProcedure,
+			connect.WithSchema(userServiceSetNotificationPreferencesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[v1.AcceptInvitationRequest, v1.AuthUser](
+			httpClient,
+			baseURL+UserServiceAcceptInvitationProcedure,
+			connect.WithSchema(userServiceAcceptInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setUserActive: connect.NewClient[v1.SetUserActiveRequest, v1.SetUserActiveResponse](
+			httpClient,
+			baseURL+UserServiceSetUserActiveProcedure,
+			connect.WithSchema(userServiceSetUserActiveMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	createUser               *connect.Client[v1.CreateUserRequest, v1.AuthUser]
-	getUserByProviderAccount *connect.Client[v1.GetUserByProviderAccountRequest, v1.AuthUser]
-	getUserByEmail           *connect.Client[v1.GetUserByEmailRequest, v1.AuthUser]
-	getUserByID              *connect.Client[v1.GetUserByIDRequest, v1.AuthUser]
-	updateUser               *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	createUser                 *connect.Client[v1.CreateUserRequest, v1.AuthUser]
+	getUserByProviderAccount   *connect.Client[v1.GetUserByProviderAccountRequest, v1.AuthUser]
+	getUserByEmail             *connect.Client[v1.GetUserByEmailRequest, v1.AuthUser]
+	getUserByID                *connect.Client[v1.GetUserByIDRequest, v1.AuthUser]
+	updateUser                 *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	getDigestSubscription      *connect.Client[v1.GetDigestSubscriptionRequest, v1.GetDigestSubscriptionResponse]
+	setDigestSubscription      *connect.Client[v1.SetDigestSubscriptionRequest, v1.SetDigestSubscriptionResponse]
+	getNotificationPreferences *connect.Client[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse]
+	setNotificationPreferences *connect.Client[v1.SetNotificationPreferencesRequest, v1.SetNotificationPreferencesResponse]
+	acceptInvitation           *connect.Client[v1.AcceptInvitationRequest, v1.AuthUser]
+	setUserActive              *connect.Client[v1.SetUserActiveRequest, v1.SetUserActiveResponse]
 }
 
 // CreateUser calls ctrlplane.auth.v1.UserService.CreateUser.
@@ -149,6 +227,36 @@ func (c *userServiceClient) UpdateUser(ctx context.Context, req *connect.Request
 	return c.updateUser.CallUnary(ctx, req)
 }
 
+// GetDigestSubscription calls ctrlplane.auth.v1.UserService.GetDigestSubscription.
+func (c *userServiceClient) GetDigestSubscription(ctx context.Context, req *connect.Request[v1.GetDigestSubscriptionRequest]) (*connect.Response[v1.GetDigestSubscriptionResponse], error) {
+	return c.getDigestSubscription.CallUnary(ctx, req)
+}
+
+// SetDigestSubscription calls ctrlplane.auth.v1.UserService.SetDigestSubscription.
+func (c *userServiceClient) SetDigestSubscription(ctx context.Context, req *connect.Request[v1.SetDigestSubscriptionRequest]) (*connect.Response[v1.SetDigestSubscriptionResponse], error) {
+	return c.setDigestSubscription.CallUnary(ctx, req)
+}
+
+// GetNotificationPreferences calls ctrlplane.auth.v1.UserService.GetNotificationPreferences.
+func (c *userServiceClient) GetNotificationPreferences(ctx context.Context, req *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
+	return c.getNotificationPreferences.CallUnary(ctx, req)
+}
+
+// SetNotificationPreferences calls ctrlplane.auth.v1.UserService.SetNotificationPreferences.
+func (c *userServiceClient) SetNotificationPreferences(ctx context.Context, req *connect.Request[v1.SetNotificationPreferencesRequest]) (*connect.Response[v1.SetNotificationPreferencesResponse], error) {
+	return c.setNotificationPreferences.CallUnary(ctx, req)
+}
+
+// AcceptInvitation calls ctrlplane.auth.v1.UserService.AcceptInvitation.
+func (c *userServiceClient) AcceptInvitation(ctx context.Context, req *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AuthUser], error) {
+	return c.acceptInvitation.CallUnary(ctx, req)
+}
+
+// SetUserActive calls ctrlplane.auth.v1.UserService.SetUserActive.
+func (c *userServiceClient) SetUserActive(ctx context.Context, req *connect.Request[v1.SetUserActiveRequest]) (*connect.Response[v1.SetUserActiveResponse], error) {
+	return c.setUserActive.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the ctrlplane.auth.v1.UserService service.
 type UserServiceHandler interface {
 	// Creates a new user account associated with the given domain. Domains are unique to organizations. If the domain is
@@ -162,6 +270,18 @@ type UserServiceHandler interface {
 	GetUserByID(context.Context, *connect.Request[v1.GetUserByIDRequest]) (*connect.Response[v1.AuthUser], error)
 	// Updates an existing user account.
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
+	// Retrieves the email digest subscription of a user. Users without a subscription are reported as opted out.
+	GetDigestSubscription(context.Context, *connect.Request[v1.GetDigestSubscriptionRequest]) (*connect.Response[v1.GetDigestSubscriptionResponse], error)
+	// Opts a user in or out of the email digest, and sets how often the digest is sent.
+	SetDigestSubscription(context.Context, *connect.Request[v1.SetDigestSubscriptionRequest]) (*connect.Response[v1.SetDigestSubscriptionResponse], error)
+	// Retrieves the notification preferences of a user. Users without saved preferences get the defaults.
+	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
+	// Updates the notification preferences of a user.
+	SetNotificationPreferences(context.Context, *connect.Request[v1.SetNotificationPreferencesRequest]) (*connect.Response[v1.SetNotificationPreferencesResponse], error)
+	// Accepts an invitation for an existing user. The user joins the organization of the invitation with its role.
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AuthUser], error)
+	// Activates or deactivates a user. Deactivating a user revokes all their sessions.
+	SetUserActive(context.Context, *connect.Request[v1.SetUserActiveRequest]) (*connect.Response[v1.SetUserActiveResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -200,6 +320,42 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceUpdateUserMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetDigestSubscriptionHandler := connect.NewUnaryHandler(
+		UserServiceGetDigestSubscriptionProcedure,
+		svc.GetDigestSubscription,
+		connect.WithSchema(userServiceGetDigestSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetDigestSubscriptionHandler := connect.NewUnaryHandler(
+		UserServiceSetDigestSubscriptionProcedure,
+		svc.SetDigestSubscription,
+		connect.WithSchema(userServiceSetDigestSubscriptionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		UserServiceGetNotificationPreferencesProcedure,
+		svc.GetNotificationPreferences,
+		connect.WithSchema(userServiceGetNotificationPreferencesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		UserServiceSetNotificationPreferencesProcedure,
+		svc.SetNotificationPreferences,
+		connect.WithSchema(userServiceSetNotificationPreferencesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceAcceptInvitationHandler := connect.NewUnaryHandler(
+		UserServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(userServiceAcceptInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetUserActiveHandler := connect.NewUnaryHandler(
+		UserServiceSetUserActiveProcedure,
+		svc.SetUserActive,
+		connect.WithSchema(userServiceSetUserActiveMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ctrlplane.auth.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceCreateUserProcedure:
@@ -212,6 +368,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceGetUserByIDHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserProcedure:
 			userServiceUpdateUserHandler.ServeHTTP(w, r)
+		case UserServiceGetDigestSubscriptionProcedure:
+			userServiceGetDigestSubscriptionHandler.ServeHTTP(w, r)
+		case UserServiceSetDigestSubscriptionProcedure:
+			userServiceSetDigestSubscriptionHandler.ServeHTTP(w, r)
+		case UserServiceGetNotificationPreferencesProcedure:
+			userServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
+		case UserServiceSetNotificationPreferencesProcedure:
+			userServiceSetNotificationPreferencesHandler.ServeHTTP(w, r)
+		case UserServiceAcceptInvitationProcedure:
+			userServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case UserServiceSetUserActiveProcedure:
+			userServiceSetUserActiveHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -240,3 +408,27 @@ func (UnimplementedUserServiceHandler) GetUserByID(context.Context, *connect.Req
 func (UnimplementedUserServiceHandler) UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.UserService.UpdateUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) GetDigestSubscription(context.Context, *connect.Request[v1.GetDigestSubscriptionRequest]) (*connect.Response[v1.GetDigestSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.UserService.GetDigestSubscription is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetDigestSubscription(context.Context, *connect.Request[v1.SetDigestSubscriptionRequest]) (*connect.Response[v1.SetDigestSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.UserService.SetDigestSubscription is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.UserService.GetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetNotificationPreferences(context.Context, *connect.Request[v1.SetNotificationPreferencesRequest]) (*connect.Response[v1.SetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.UserService.SetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AuthUser], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.UserService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetUserActive(context.Context, *connect.Request[v1.SetUserActiveRequest]) (*connect.Response[v1.SetUserActiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.auth.v1.UserService.SetUserActive is not implemented"))
+}
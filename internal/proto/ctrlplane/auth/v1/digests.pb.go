@@ -0,0 +1,834 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: ctrlplane/auth/v1/digests.proto
+
+package authv1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DigestFrequency int32
+
+const (
+	DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED DigestFrequency = 0
+	DigestFrequency_DIGEST_FREQUENCY_DAILY       DigestFrequency = 1
+	DigestFrequency_DIGEST_FREQUENCY_WEEKLY      DigestFrequency = 2
+)
+
+// Enum value maps for DigestFrequency.
+var (
+	DigestFrequency_name = map[int32]string{
+		0: "DIGEST_FREQUENCY_UNSPECIFIED",
+		1: "DIGEST_FREQUENCY_DAILY",
+		2: "DIGEST_FREQUENCY_WEEKLY",
+	}
+	DigestFrequency_value = map[string]int32{
+		"DIGEST_FREQUENCY_UNSPECIFIED": 0,
+		"DIGEST_FREQUENCY_DAILY":       1,
+		"DIGEST_FREQUENCY_WEEKLY":      2,
+	}
+)
+
+func (x DigestFrequency) Enum() *DigestFrequency {
+	p := new(DigestFrequency)
+	*p = x
+	return p
+}
+
+func (x DigestFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DigestFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctrlplane_auth_v1_digests_proto_enumTypes[0].Descriptor()
+}
+
+func (DigestFrequency) Type() protoreflect.EnumType {
+	return &file_ctrlplane_auth_v1_digests_proto_enumTypes[0]
+}
+
+func (x DigestFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DigestFrequency.Descriptor instead.
+func (DigestFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{0}
+}
+
+// Represents the email digest subscription of a user. Users are opted out until they subscribe.
+type DigestSubscription struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Frequency DigestFrequency        `protobuf:"varint,2,opt,name=frequency,proto3,enum=ctrlplane.auth.v1.DigestFrequency" json:"frequency,omitempty"`
+	// If true, activity of the teams the user belongs to is included in the digest.
+	IncludeTeams  bool                   `protobuf:"varint,3,opt,name=include_teams,json=includeTeams,proto3" json:"include_teams,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	LastSentAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSubscription) Reset() {
+	*x = DigestSubscription{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSubscription) ProtoMessage() {}
+
+func (x *DigestSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSubscription.ProtoReflect.Descriptor instead.
+func (*DigestSubscription) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DigestSubscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DigestSubscription) GetFrequency() DigestFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *DigestSubscription) GetIncludeTeams() bool {
+	if x != nil {
+		return x.IncludeTeams
+	}
+	return false
+}
+
+func (x *DigestSubscription) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *DigestSubscription) GetLastSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return nil
+}
+
+// Request to retrieve the digest subscription of a user.
+type GetDigestSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSubscriptionRequest) Reset() {
+	*x = GetDigestSubscriptionRequest{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetDigestSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDigestSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Response containing the digest subscription of a user.
+type GetDigestSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *DigestSubscription    `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSubscriptionResponse) Reset() {
+	*x = GetDigestSubscriptionResponse{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSubscriptionResponse) ProtoMessage() {}
+
+func (x *GetDigestSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetDigestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDigestSubscriptionResponse) GetSubscription() *DigestSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// Request to opt in or out of the email digest.
+type SetDigestSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Frequency     DigestFrequency        `protobuf:"varint,2,opt,name=frequency,proto3,enum=ctrlplane.auth.v1.DigestFrequency" json:"frequency,omitempty"`
+	IncludeTeams  bool                   `protobuf:"varint,3,opt,name=include_teams,json=includeTeams,proto3" json:"include_teams,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestSubscriptionRequest) Reset() {
+	*x = SetDigestSubscriptionRequest{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSubscriptionRequest) ProtoMessage() {}
+
+func (x *SetDigestSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SetDigestSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetDigestSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetDigestSubscriptionRequest) GetFrequency() DigestFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *SetDigestSubscriptionRequest) GetIncludeTeams() bool {
+	if x != nil {
+		return x.IncludeTeams
+	}
+	return false
+}
+
+func (x *SetDigestSubscriptionRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// Response containing the updated digest subscription.
+type SetDigestSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *DigestSubscription    `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestSubscriptionResponse) Reset() {
+	*x = SetDigestSubscriptionResponse{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSubscriptionResponse) ProtoMessage() {}
+
+func (x *SetDigestSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*SetDigestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetDigestSubscriptionResponse) GetSubscription() *DigestSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// Represents the email digest subscription of a team. The digest covers the activity of the team and is sent to the
+// email of the subscription, e.g. a mailing list. Teams are opted out until they subscribe.
+type TeamDigestSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Frequency     DigestFrequency        `protobuf:"varint,3,opt,name=frequency,proto3,enum=ctrlplane.auth.v1.DigestFrequency" json:"frequency,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	LastSentAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamDigestSubscription) Reset() {
+	*x = TeamDigestSubscription{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamDigestSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamDigestSubscription) ProtoMessage() {}
+
+func (x *TeamDigestSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamDigestSubscription.ProtoReflect.Descriptor instead.
+func (*TeamDigestSubscription) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TeamDigestSubscription) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *TeamDigestSubscription) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TeamDigestSubscription) GetFrequency() DigestFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *TeamDigestSubscription) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamDigestSubscription) GetLastSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return nil
+}
+
+// Request to retrieve the digest subscription of a team.
+type GetTeamDigestSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamDigestSubscriptionRequest) Reset() {
+	*x = GetTeamDigestSubscriptionRequest{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamDigestSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamDigestSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetTeamDigestSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamDigestSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamDigestSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTeamDigestSubscriptionRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+// Response containing the digest subscription of a team.
+type GetTeamDigestSubscriptionResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Subscription  *TeamDigestSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamDigestSubscriptionResponse) Reset() {
+	*x = GetTeamDigestSubscriptionResponse{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamDigestSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamDigestSubscriptionResponse) ProtoMessage() {}
+
+func (x *GetTeamDigestSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamDigestSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamDigestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTeamDigestSubscriptionResponse) GetSubscription() *TeamDigestSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// Request to opt a team in or out of the email digest.
+type SetTeamDigestSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Frequency     DigestFrequency        `protobuf:"varint,3,opt,name=frequency,proto3,enum=ctrlplane.auth.v1.DigestFrequency" json:"frequency,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamDigestSubscriptionRequest) Reset() {
+	*x = SetTeamDigestSubscriptionRequest{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamDigestSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamDigestSubscriptionRequest) ProtoMessage() {}
+
+func (x *SetTeamDigestSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamDigestSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamDigestSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetTeamDigestSubscriptionRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *SetTeamDigestSubscriptionRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SetTeamDigestSubscriptionRequest) GetFrequency() DigestFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *SetTeamDigestSubscriptionRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// Response containing the updated digest subscription of a team.
+type SetTeamDigestSubscriptionResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Subscription  *TeamDigestSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamDigestSubscriptionResponse) Reset() {
+	*x = SetTeamDigestSubscriptionResponse{}
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamDigestSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamDigestSubscriptionResponse) ProtoMessage() {}
+
+func (x *SetTeamDigestSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_auth_v1_digests_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamDigestSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamDigestSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_auth_v1_digests_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetTeamDigestSubscriptionResponse) GetSubscription() *TeamDigestSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+var File_ctrlplane_auth_v1_digests_proto protoreflect.FileDescriptor
+
+var file_ctrlplane_auth_v1_digests_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x11, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xf9, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x09,
+	0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65,
+	0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22, 0x41,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x6a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcf, 0x01,
+	0x0a, 0x1c, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x4a, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22,
+	0x6a, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xee, 0x01, 0x0a, 0x16,
+	0x54, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x40, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3c,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x01, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x4a,
+	0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x72, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x54, 0x65,
+	0x61, 0x6d, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x6c, 0x0a, 0x0f, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x20,
+	0x0a, 0x1c, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x42, 0xc6, 0x01, 0x0a, 0x15, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x43,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ctrlplane_auth_v1_digests_proto_rawDescOnce sync.Once
+	file_ctrlplane_auth_v1_digests_proto_rawDescData = file_ctrlplane_auth_v1_digests_proto_rawDesc
+)
+
+func file_ctrlplane_auth_v1_digests_proto_rawDescGZIP() []byte {
+	file_ctrlplane_auth_v1_digests_proto_rawDescOnce.Do(func() {
+		file_ctrlplane_auth_v1_digests_proto_rawDescData = protoimpl.X.CompressGZIP(file_ctrlplane_auth_v1_digests_proto_rawDescData)
+	})
+	return file_ctrlplane_auth_v1_digests_proto_rawDescData
+}
+
+var file_ctrlplane_auth_v1_digests_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ctrlplane_auth_v1_digests_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ctrlplane_auth_v1_digests_proto_goTypes = []any{
+	(DigestFrequency)(0),                      // 0: ctrlplane.auth.v1.DigestFrequency
+	(*DigestSubscription)(nil),                // 1: ctrlplane.auth.v1.DigestSubscription
+	(*GetDigestSubscriptionRequest)(nil),      // 2: ctrlplane.auth.v1.GetDigestSubscriptionRequest
+	(*GetDigestSubscriptionResponse)(nil),     // 3: ctrlplane.auth.v1.GetDigestSubscriptionResponse
+	(*SetDigestSubscriptionRequest)(nil),      // 4: ctrlplane.auth.v1.SetDigestSubscriptionRequest
+	(*SetDigestSubscriptionResponse)(nil),     // 5: ctrlplane.auth.v1.SetDigestSubscriptionResponse
+	(*TeamDigestSubscription)(nil),            // 6: ctrlplane.auth.v1.TeamDigestSubscription
+	(*GetTeamDigestSubscriptionRequest)(nil),  // 7: ctrlplane.auth.v1.GetTeamDigestSubscriptionRequest
+	(*GetTeamDigestSubscriptionResponse)(nil), // 8: ctrlplane.auth.v1.GetTeamDigestSubscriptionResponse
+	(*SetTeamDigestSubscriptionRequest)(nil),  // 9: ctrlplane.auth.v1.SetTeamDigestSubscriptionRequest
+	(*SetTeamDigestSubscriptionResponse)(nil), // 10: ctrlplane.auth.v1.SetTeamDigestSubscriptionResponse
+	(*timestamppb.Timestamp)(nil),             // 11: google.protobuf.Timestamp
+}
+var file_ctrlplane_auth_v1_digests_proto_depIdxs = []int32{
+	0,  // 0: ctrlplane.auth.v1.DigestSubscription.frequency:type_name -> ctrlplane.auth.v1.DigestFrequency
+	11, // 1: ctrlplane.auth.v1.DigestSubscription.last_sent_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: ctrlplane.auth.v1.GetDigestSubscriptionResponse.subscription:type_name -> ctrlplane.auth.v1.DigestSubscription
+	0,  // 3: ctrlplane.auth.v1.SetDigestSubscriptionRequest.frequency:type_name -> ctrlplane.auth.v1.DigestFrequency
+	1,  // 4: ctrlplane.auth.v1.SetDigestSubscriptionResponse.subscription:type_name -> ctrlplane.auth.v1.DigestSubscription
+	0,  // 5: ctrlplane.auth.v1.TeamDigestSubscription.frequency:type_name -> ctrlplane.auth.v1.DigestFrequency
+	11, // 6: ctrlplane.auth.v1.TeamDigestSubscription.last_sent_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: ctrlplane.auth.v1.GetTeamDigestSubscriptionResponse.subscription:type_name -> ctrlplane.auth.v1.TeamDigestSubscription
+	0,  // 8: ctrlplane.auth.v1.SetTeamDigestSubscriptionRequest.frequency:type_name -> ctrlplane.auth.v1.DigestFrequency
+	6,  // 9: ctrlplane.auth.v1.SetTeamDigestSubscriptionResponse.subscription:type_name -> ctrlplane.auth.v1.TeamDigestSubscription
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_ctrlplane_auth_v1_digests_proto_init() }
+func file_ctrlplane_auth_v1_digests_proto_init() {
+	if File_ctrlplane_auth_v1_digests_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ctrlplane_auth_v1_digests_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ctrlplane_auth_v1_digests_proto_goTypes,
+		DependencyIndexes: file_ctrlplane_auth_v1_digests_proto_depIdxs,
+		EnumInfos:         file_ctrlplane_auth_v1_digests_proto_enumTypes,
+		MessageInfos:      file_ctrlplane_auth_v1_digests_proto_msgTypes,
+	}.Build()
+	File_ctrlplane_auth_v1_digests_proto = out.File
+	file_ctrlplane_auth_v1_digests_proto_rawDesc = nil
+	file_ctrlplane_auth_v1_digests_proto_goTypes = nil
+	file_ctrlplane_auth_v1_digests_proto_depIdxs = nil
+}
@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ctrlplane/events/v1/metrics.proto
+
+package eventsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MetricsServiceName is the fully-qualified name of the MetricsService service.
+	MetricsServiceName = "ctrlplane.events.v1.MetricsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MetricsServiceGetDeliveryMetricsProcedure is the fully-qualified name of the MetricsService's
+	// GetDeliveryMetrics RPC.
+	MetricsServiceGetDeliveryMetricsProcedure = "/ctrlplane.events.v1.MetricsService/GetDeliveryMetrics"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	metricsServiceServiceDescriptor                  = v1.File_ctrlplane_events_v1_metrics_proto.Services().ByName("MetricsService")
+	metricsServiceGetDeliveryMetricsMethodDescriptor = metricsServiceServiceDescriptor.Methods().ByName("GetDeliveryMetrics")
+)
+
+// MetricsServiceClient is a client for the ctrlplane.events.v1.MetricsService service.
+type MetricsServiceClient interface {
+	// Computes the lead time for changes, pull request cycle time, merge queue wait time and failure rate, deployment
+	// frequency and branch lifetime over a period. Metrics are computed from the rollups maintained alongside the events.
+	GetDeliveryMetrics(context.Context, *connect.Request[v1.GetDeliveryMetricsRequest]) (*connect.Response[v1.GetDeliveryMetricsResponse], error)
+}
+
+// NewMetricsServiceClient constructs a client for the ctrlplane.events.v1.MetricsService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMetricsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MetricsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &metricsServiceClient{
+		getDeliveryMetrics: connect.NewClient[v1.GetDeliveryMetricsRequest, v1.GetDeliveryMetricsResponse](
+			httpClient,
+			baseURL+MetricsServiceGetDeliveryMetricsProcedure,
+			connect.WithSchema(metricsServiceGetDeliveryMetricsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// metricsServiceClient implements MetricsServiceClient.
+type metricsServiceClient struct {
+	getDeliveryMetrics *connect.Client[v1.GetDeliveryMetricsRequest, v1.GetDeliveryMetricsResponse]
+}
+
+// GetDeliveryMetrics calls ctrlplane.events.v1.MetricsService.GetDeliveryMetrics.
+func (c *metricsServiceClient) GetDeliveryMetrics(ctx context.Context, req *connect.Request[v1.GetDeliveryMetricsRequest]) (*connect.Response[v1.GetDeliveryMetricsResponse], error) {
+	return c.getDeliveryMetrics.CallUnary(ctx, req)
+}
+
+// MetricsServiceHandler is an implementation of the ctrlplane.events.v1.MetricsService service.
+type MetricsServiceHandler interface {
+	// Computes the lead time for changes, pull request cycle time, merge queue wait time and failure rate, deployment
+	// frequency and branch lifetime over a period. Metrics are computed from the rollups maintained alongside the events.
+	GetDeliveryMetrics(context.Context, *connect.Request[v1.GetDeliveryMetricsRequest]) (*connect.Response[v1.GetDeliveryMetricsResponse], error)
+}
+
+// NewMetricsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMetricsServiceHandler(svc MetricsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	metricsServiceGetDeliveryMetricsHandler := connect.NewUnaryHandler(
+		MetricsServiceGetDeliveryMetricsProcedure,
+		svc.GetDeliveryMetrics,
+		connect.WithSchema(metricsServiceGetDeliveryMetricsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ctrlplane.events.v1.MetricsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MetricsServiceGetDeliveryMetricsProcedure:
+			metricsServiceGetDeliveryMetricsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMetricsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMetricsServiceHandler struct{}
+
+func (UnimplementedMetricsServiceHandler) GetDeliveryMetrics(context.Context, *connect.Request[v1.GetDeliveryMetricsRequest]) (*connect.Response[v1.GetDeliveryMetricsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.events.v1.MetricsService.GetDeliveryMetrics is not implemented"))
+}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ctrlplane/events/v1/service.proto
+
+package eventsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EventServiceName is the fully-qualified name of the EventService service.
+	EventServiceName = "ctrlplane.events.v1.EventService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EventServiceListEventsProcedure is the fully-qualified name of the EventService's ListEvents RPC.
+	EventServiceListEventsProcedure = "/ctrlplane.events.v1.EventService/ListEvents"
+	// EventServiceGetEventProcedure is the fully-qualified name of the EventService's GetEvent RPC.
+	EventServiceGetEventProcedure = "/ctrlplane.events.v1.EventService/GetEvent"
+	// EventServiceGetLineageProcedure is the fully-qualified name of the EventService's GetLineage RPC.
+	EventServiceGetLineageProcedure = "/ctrlplane.events.v1.EventService/GetLineage"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	eventServiceServiceDescriptor          = v1.File_ctrlplane_events_v1_service_proto.Services().ByName("EventService")
+	eventServiceListEventsMethodDescriptor = eventServiceServiceDescriptor.Methods().ByName("ListEvents")
+	eventServiceGetEventMethodDescriptor   = eventServiceServiceDescriptor.Methods().ByName("GetEvent")
+	eventServiceGetLineageMethodDescriptor = eventServiceServiceDescriptor.Methods().ByName("GetLineage")
+)
+
+// EventServiceClient is a client for the ctrlplane.events.v1.EventService service.
+type EventServiceClient interface {
+	// Lists the events of the organization, filtered by repo, branch, user, team, time range, scopes and actions. Results
+	// are paged with opaque cursors based on the timestamp and the id of the events.
+	ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error)
+	// Retrieves an event with its parent chain.
+	GetEvent(context.Context, *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error)
+	// Retrieves the causal graph of an event, built from the parents of the stored events.
+	GetLineage(context.Context, *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error)
+}
+
+// NewEventServiceClient constructs a client for the ctrlplane.events.v1.EventService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEventServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EventServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &eventServiceClient{
+		listEvents: connect.NewClient[v1.ListEventsRequest, v1.ListEventsResponse](
+			httpClient,
+			baseURL+EventServiceListEventsProcedure,
+			connect.WithSchema(eventServiceListEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getEvent: connect.NewClient[v1.GetEventRequest, v1.GetEventResponse](
+			httpClient,
+			baseURL+EventServiceGetEventProcedure,
+			connect.WithSchema(eventServiceGetEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getLineage: connect.NewClient[v1.GetLineageRequest, v1.GetLineageResponse](
+			httpClient,
+			baseURL+EventServiceGetLineageProcedure,
+			connect.WithSchema(eventServiceGetLineageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// eventServiceClient implements EventServiceClient.
+type eventServiceClient struct {
+	listEvents *connect.Client[v1.ListEventsRequest, v1.ListEventsResponse]
+	getEvent   *connect.Client[v1.GetEventRequest, v1.GetEventResponse]
+	getLineage *connect.Client[v1.GetLineageRequest, v1.GetLineageResponse]
+}
+
+// ListEvents calls ctrlplane.events.v1.EventService.ListEvents.
+func (c *eventServiceClient) ListEvents(ctx context.Context, req *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
+	return c.listEvents.CallUnary(ctx, req)
+}
+
+// GetEvent calls ctrlplane.events.v1.EventService.GetEvent.
+func (c *eventServiceClient) GetEvent(ctx context.Context, req *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error) {
+	return c.getEvent.CallUnary(ctx, req)
+}
+
+// GetLineage calls ctrlplane.events.v1.EventService.GetLineage.
+func (c *eventServiceClient) GetLineage(ctx context.Context, req *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error) {
+	return c.getLineage.CallUnary(ctx, req)
+}
+
+// EventServiceHandler is an implementation of the ctrlplane.events.v1.EventService service.
+type EventServiceHandler interface {
+	// Lists the events of the organization, filtered by repo, branch, user, team, time range, scopes and actions. Results
+	// are paged with opaque cursors based on the timestamp and the id of the events.
+	ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error)
+	// Retrieves an event with its parent chain.
+	GetEvent(context.Context, *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error)
+	// Retrieves the causal graph of an event, built from the parents of the stored events.
+	GetLineage(context.Context, *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error)
+}
+
+// NewEventServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEventServiceHandler(svc EventServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	eventServiceListEventsHandler := connect.NewUnaryHandler(
+		EventServiceListEventsProcedure,
+		svc.ListEvents,
+		connect.WithSchema(eventServiceListEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceGetEventHandler := connect.NewUnaryHandler(
+		EventServiceGetEventProcedure,
+		svc.GetEvent,
+		connect.WithSchema(eventServiceGetEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceGetLineageHandler := connect.NewUnaryHandler(
+		EventServiceGetLineageProcedure,
+		svc.GetLineage,
+		connect.WithSchema(eventServiceGetLineageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ctrlplane.events.v1.EventService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EventServiceListEventsProcedure:
+			eventServiceListEventsHandler.ServeHTTP(w, r)
+		case EventServiceGetEventProcedure:
+			eventServiceGetEventHandler.ServeHTTP(w, r)
+		case EventServiceGetLineageProcedure:
+			eventServiceGetLineageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEventServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEventServiceHandler struct{}
+
+func (UnimplementedEventServiceHandler) ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.events.v1.EventService.ListEvents is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) GetEvent(context.Context, *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.events.v1.EventService.GetEvent is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) GetLineage(context.Context, *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.events.v1.EventService.GetLineage is not implemented"))
+}
@@ -75,6 +75,7 @@ const (
 	ChatHook_CHAT_HOOK_UNSPECIFIED ChatHook = 0
 	ChatHook_CHAT_HOOK_SLACK       ChatHook = 2001
 	ChatHook_CHAT_HOOK_TEAMS       ChatHook = 2002
+	ChatHook_CHAT_HOOK_WEBHOOK     ChatHook = 2003
 )
 
 // Enum value maps for ChatHook.
@@ -83,11 +84,13 @@ var (
 		0:    "CHAT_HOOK_UNSPECIFIED",
 		2001: "CHAT_HOOK_SLACK",
 		2002: "CHAT_HOOK_TEAMS",
+		2003: "CHAT_HOOK_WEBHOOK",
 	}
 	ChatHook_value = map[string]int32{
 		"CHAT_HOOK_UNSPECIFIED": 0,
 		"CHAT_HOOK_SLACK":       2001,
 		"CHAT_HOOK_TEAMS":       2002,
+		"CHAT_HOOK_WEBHOOK":     2003,
 	}
 )
 
@@ -129,26 +132,28 @@ var file_ctrlplane_events_v1_hooks_proto_rawDesc = []byte{
 	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
 	0x10, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x47, 0x49, 0x54, 0x48, 0x55,
 	0x42, 0x10, 0xe9, 0x07, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x48, 0x4f, 0x4f,
-	0x4b, 0x5f, 0x47, 0x49, 0x54, 0x4c, 0x41, 0x42, 0x10, 0xea, 0x07, 0x2a, 0x51, 0x0a, 0x08, 0x43,
+	0x4b, 0x5f, 0x47, 0x49, 0x54, 0x4c, 0x41, 0x42, 0x10, 0xea, 0x07, 0x2a, 0x69, 0x0a, 0x08, 0x43,
 	0x68, 0x61, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x54, 0x5f,
 	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
 	0x10, 0x00, 0x12, 0x14, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f,
 	0x53, 0x4c, 0x41, 0x43, 0x4b, 0x10, 0xd1, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x54,
-	0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x53, 0x10, 0xd2, 0x0f, 0x42, 0xd2,
-	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x48, 0x6f, 0x6f, 0x6b,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65,
-	0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x72, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x58, 0xaa, 0x02, 0x13,
-	0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x74, 0x72, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x74,
-	0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x53, 0x10, 0xd2, 0x0f, 0x12, 0x16,
+	0x0a, 0x11, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x57, 0x45, 0x42, 0x48,
+	0x4f, 0x4f, 0x4b, 0x10, 0xd3, 0x0f, 0x42, 0xd2, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x3d, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x45, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x74,
+	0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1f, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x3a,
+	0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
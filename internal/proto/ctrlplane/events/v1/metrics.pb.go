@@ -0,0 +1,618 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: ctrlplane/events/v1/metrics.proto
+
+package eventsv1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Defines how the metrics are broken down.
+type MetricsGroup int32
+
+const (
+	MetricsGroup_METRICS_GROUP_UNSPECIFIED MetricsGroup = 0
+	MetricsGroup_METRICS_GROUP_ORG         MetricsGroup = 1
+	MetricsGroup_METRICS_GROUP_TEAM        MetricsGroup = 2
+	MetricsGroup_METRICS_GROUP_REPO        MetricsGroup = 3
+	MetricsGroup_METRICS_GROUP_USER        MetricsGroup = 4
+)
+
+// Enum value maps for MetricsGroup.
+var (
+	MetricsGroup_name = map[int32]string{
+		0: "METRICS_GROUP_UNSPECIFIED",
+		1: "METRICS_GROUP_ORG",
+		2: "METRICS_GROUP_TEAM",
+		3: "METRICS_GROUP_REPO",
+		4: "METRICS_GROUP_USER",
+	}
+	MetricsGroup_value = map[string]int32{
+		"METRICS_GROUP_UNSPECIFIED": 0,
+		"METRICS_GROUP_ORG":         1,
+		"METRICS_GROUP_TEAM":        2,
+		"METRICS_GROUP_REPO":        3,
+		"METRICS_GROUP_USER":        4,
+	}
+)
+
+func (x MetricsGroup) Enum() *MetricsGroup {
+	p := new(MetricsGroup)
+	*p = x
+	return p
+}
+
+func (x MetricsGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricsGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctrlplane_events_v1_metrics_proto_enumTypes[0].Descriptor()
+}
+
+func (MetricsGroup) Type() protoreflect.EnumType {
+	return &file_ctrlplane_events_v1_metrics_proto_enumTypes[0]
+}
+
+func (x MetricsGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricsGroup.Descriptor instead.
+func (MetricsGroup) EnumDescriptor() ([]byte, []int) {
+	return file_ctrlplane_events_v1_metrics_proto_rawDescGZIP(), []int{0}
+}
+
+// Summarizes a set of durations.
+type DurationStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         uint64                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Mean          *durationpb.Duration   `protobuf:"bytes,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	P50           *durationpb.Duration   `protobuf:"bytes,3,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90           *durationpb.Duration   `protobuf:"bytes,4,opt,name=p90,proto3" json:"p90,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DurationStats) Reset() {
+	*x = DurationStats{}
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DurationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationStats) ProtoMessage() {}
+
+func (x *DurationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationStats.ProtoReflect.Descriptor instead.
+func (*DurationStats) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_events_v1_metrics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DurationStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DurationStats) GetMean() *durationpb.Duration {
+	if x != nil {
+		return x.Mean
+	}
+	return nil
+}
+
+func (x *DurationStats) GetP50() *durationpb.Duration {
+	if x != nil {
+		return x.P50
+	}
+	return nil
+}
+
+func (x *DurationStats) GetP90() *durationpb.Duration {
+	if x != nil {
+		return x.P90
+	}
+	return nil
+}
+
+// Delivery metrics for an org, team, repo or user.
+type DeliveryMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the org, team, repo or user. Events without a team or user are grouped under the nil uuid.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// From the first push on the branch to the merge of its pull request.
+	LeadTime *DurationStats `protobuf:"bytes,2,opt,name=lead_time,json=leadTime,proto3" json:"lead_time,omitempty"`
+	// From the opening of a pull request to its merge.
+	CycleTime *DurationStats `protobuf:"bytes,3,opt,name=cycle_time,json=cycleTime,proto3" json:"cycle_time,omitempty"`
+	// From the opening of a pull request to its first review.
+	TimeToFirstReview *DurationStats `protobuf:"bytes,4,opt,name=time_to_first_review,json=timeToFirstReview,proto3" json:"time_to_first_review,omitempty"`
+	// From the first review of a pull request to its merge.
+	ReviewToMerge *DurationStats `protobuf:"bytes,5,opt,name=review_to_merge,json=reviewToMerge,proto3" json:"review_to_merge,omitempty"`
+	// From the addition of a pull request to the merge queue to its removal.
+	MergeQueueWait *DurationStats `protobuf:"bytes,6,opt,name=merge_queue_wait,json=mergeQueueWait,proto3" json:"merge_queue_wait,omitempty"`
+	// Number of pull requests removed from the merge queue.
+	MergeQueueTotal uint64 `protobuf:"varint,7,opt,name=merge_queue_total,json=mergeQueueTotal,proto3" json:"merge_queue_total,omitempty"`
+	// Number of pull requests removed from the merge queue without being merged.
+	MergeQueueFailed      uint64  `protobuf:"varint,8,opt,name=merge_queue_failed,json=mergeQueueFailed,proto3" json:"merge_queue_failed,omitempty"`
+	MergeQueueFailureRate float64 `protobuf:"fixed64,9,opt,name=merge_queue_failure_rate,json=mergeQueueFailureRate,proto3" json:"merge_queue_failure_rate,omitempty"`
+	// Number of successful deployments.
+	Deployments       uint64  `protobuf:"varint,10,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	DeploymentsPerDay float64 `protobuf:"fixed64,11,opt,name=deployments_per_day,json=deploymentsPerDay,proto3" json:"deployments_per_day,omitempty"`
+	// From the creation of a branch to its deletion.
+	BranchLifetime *DurationStats `protobuf:"bytes,12,opt,name=branch_lifetime,json=branchLifetime,proto3" json:"branch_lifetime,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeliveryMetrics) Reset() {
+	*x = DeliveryMetrics{}
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryMetrics) ProtoMessage() {}
+
+func (x *DeliveryMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryMetrics.ProtoReflect.Descriptor instead.
+func (*DeliveryMetrics) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_events_v1_metrics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeliveryMetrics) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeliveryMetrics) GetLeadTime() *DurationStats {
+	if x != nil {
+		return x.LeadTime
+	}
+	return nil
+}
+
+func (x *DeliveryMetrics) GetCycleTime() *DurationStats {
+	if x != nil {
+		return x.CycleTime
+	}
+	return nil
+}
+
+func (x *DeliveryMetrics) GetTimeToFirstReview() *DurationStats {
+	if x != nil {
+		return x.TimeToFirstReview
+	}
+	return nil
+}
+
+func (x *DeliveryMetrics) GetReviewToMerge() *DurationStats {
+	if x != nil {
+		return x.ReviewToMerge
+	}
+	return nil
+}
+
+func (x *DeliveryMetrics) GetMergeQueueWait() *DurationStats {
+	if x != nil {
+		return x.MergeQueueWait
+	}
+	return nil
+}
+
+func (x *DeliveryMetrics) GetMergeQueueTotal() uint64 {
+	if x != nil {
+		return x.MergeQueueTotal
+	}
+	return 0
+}
+
+func (x *DeliveryMetrics) GetMergeQueueFailed() uint64 {
+	if x != nil {
+		return x.MergeQueueFailed
+	}
+	return 0
+}
+
+func (x *DeliveryMetrics) GetMergeQueueFailureRate() float64 {
+	if x != nil {
+		return x.MergeQueueFailureRate
+	}
+	return 0
+}
+
+func (x *DeliveryMetrics) GetDeployments() uint64 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *DeliveryMetrics) GetDeploymentsPerDay() float64 {
+	if x != nil {
+		return x.DeploymentsPerDay
+	}
+	return 0
+}
+
+func (x *DeliveryMetrics) GetBranchLifetime() *DurationStats {
+	if x != nil {
+		return x.BranchLifetime
+	}
+	return nil
+}
+
+// Request to compute the delivery metrics of the organization.
+type GetDeliveryMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the period, required.
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	// End of the period, defaults to now.
+	Until *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	// Breakdown of the metrics, defaults to the organization.
+	GroupBy MetricsGroup `protobuf:"varint,3,opt,name=group_by,json=groupBy,proto3,enum=ctrlplane.events.v1.MetricsGroup" json:"group_by,omitempty"`
+	// Limits the metrics to a single repo.
+	RepoId        string `protobuf:"bytes,4,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryMetricsRequest) Reset() {
+	*x = GetDeliveryMetricsRequest{}
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryMetricsRequest) ProtoMessage() {}
+
+func (x *GetDeliveryMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_events_v1_metrics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeliveryMetricsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetDeliveryMetricsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *GetDeliveryMetricsRequest) GetGroupBy() MetricsGroup {
+	if x != nil {
+		return x.GroupBy
+	}
+	return MetricsGroup_METRICS_GROUP_UNSPECIFIED
+}
+
+func (x *GetDeliveryMetricsRequest) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+// Response containing the delivery metrics.
+type GetDeliveryMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       MetricsGroup           `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=ctrlplane.events.v1.MetricsGroup" json:"group_by,omitempty"`
+	Metrics       []*DeliveryMetrics     `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryMetricsResponse) Reset() {
+	*x = GetDeliveryMetricsResponse{}
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryMetricsResponse) ProtoMessage() {}
+
+func (x *GetDeliveryMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_events_v1_metrics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_events_v1_metrics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDeliveryMetricsResponse) GetGroupBy() MetricsGroup {
+	if x != nil {
+		return x.GroupBy
+	}
+	return MetricsGroup_METRICS_GROUP_UNSPECIFIED
+}
+
+func (x *GetDeliveryMetricsResponse) GetMetrics() []*DeliveryMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+var File_ctrlplane_events_v1_metrics_proto protoreflect.FileDescriptor
+
+var file_ctrlplane_events_v1_metrics_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d,
+	0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x2b, 0x0a,
+	0x03, 0x70, 0x35, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x2b, 0x0a, 0x03, 0x70, 0x39,
+	0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x03, 0x70, 0x39, 0x30, 0x22, 0xc8, 0x05, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a,
+	0x09, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41,
+	0x0a, 0x0a, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x53, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x4a, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x11, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x4b, 0x0a, 0x0f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0e, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x38, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba, 0x48, 0x08,
+	0xd8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64,
+	0x22, 0x9a, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x3e, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2a, 0x8c, 0x01,
+	0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d,
+	0x0a, 0x19, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4f,
+	0x52, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
+	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x52, 0x45,
+	0x50, 0x4f, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x32, 0x87, 0x01, 0x0a,
+	0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x75, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2e, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xd4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3d, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13,
+	0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ctrlplane_events_v1_metrics_proto_rawDescOnce sync.Once
+	file_ctrlplane_events_v1_metrics_proto_rawDescData = file_ctrlplane_events_v1_metrics_proto_rawDesc
+)
+
+func file_ctrlplane_events_v1_metrics_proto_rawDescGZIP() []byte {
+	file_ctrlplane_events_v1_metrics_proto_rawDescOnce.Do(func() {
+		file_ctrlplane_events_v1_metrics_proto_rawDescData = protoimpl.X.CompressGZIP(file_ctrlplane_events_v1_metrics_proto_rawDescData)
+	})
+	return file_ctrlplane_events_v1_metrics_proto_rawDescData
+}
+
+var file_ctrlplane_events_v1_metrics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ctrlplane_events_v1_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ctrlplane_events_v1_metrics_proto_goTypes = []any{
+	(MetricsGroup)(0),                  // 0: ctrlplane.events.v1.MetricsGroup
+	(*DurationStats)(nil),              // 1: ctrlplane.events.v1.DurationStats
+	(*DeliveryMetrics)(nil),            // 2: ctrlplane.events.v1.DeliveryMetrics
+	(*GetDeliveryMetricsRequest)(nil),  // 3: ctrlplane.events.v1.GetDeliveryMetricsRequest
+	(*GetDeliveryMetricsResponse)(nil), // 4: ctrlplane.events.v1.GetDeliveryMetricsResponse
+	(*durationpb.Duration)(nil),        // 5: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+}
+var file_ctrlplane_events_v1_metrics_proto_depIdxs = []int32{
+	5,  // 0: ctrlplane.events.v1.DurationStats.mean:type_name -> google.protobuf.Duration
+	5,  // 1: ctrlplane.events.v1.DurationStats.p50:type_name -> google.protobuf.Duration
+	5,  // 2: ctrlplane.events.v1.DurationStats.p90:type_name -> google.protobuf.Duration
+	1,  // 3: ctrlplane.events.v1.DeliveryMetrics.lead_time:type_name -> ctrlplane.events.v1.DurationStats
+	1,  // 4: ctrlplane.events.v1.DeliveryMetrics.cycle_time:type_name -> ctrlplane.events.v1.DurationStats
+	1,  // 5: ctrlplane.events.v1.DeliveryMetrics.time_to_first_review:type_name -> ctrlplane.events.v1.DurationStats
+	1,  // 6: ctrlplane.events.v1.DeliveryMetrics.review_to_merge:type_name -> ctrlplane.events.v1.DurationStats
+	1,  // 7: ctrlplane.events.v1.DeliveryMetrics.merge_queue_wait:type_name -> ctrlplane.events.v1.DurationStats
+	1,  // 8: ctrlplane.events.v1.DeliveryMetrics.branch_lifetime:type_name -> ctrlplane.events.v1.DurationStats
+	6,  // 9: ctrlplane.events.v1.GetDeliveryMetricsRequest.since:type_name -> google.protobuf.Timestamp
+	6,  // 10: ctrlplane.events.v1.GetDeliveryMetricsRequest.until:type_name -> google.protobuf.Timestamp
+	0,  // 11: ctrlplane.events.v1.GetDeliveryMetricsRequest.group_by:type_name -> ctrlplane.events.v1.MetricsGroup
+	0,  // 12: ctrlplane.events.v1.GetDeliveryMetricsResponse.group_by:type_name -> ctrlplane.events.v1.MetricsGroup
+	2,  // 13: ctrlplane.events.v1.GetDeliveryMetricsResponse.metrics:type_name -> ctrlplane.events.v1.DeliveryMetrics
+	3,  // 14: ctrlplane.events.v1.MetricsService.GetDeliveryMetrics:input_type -> ctrlplane.events.v1.GetDeliveryMetricsRequest
+	4,  // 15: ctrlplane.events.v1.MetricsService.GetDeliveryMetrics:output_type -> ctrlplane.events.v1.GetDeliveryMetricsResponse
+	15, // [15:16] is the sub-list for method output_type
+	14, // [14:15] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_ctrlplane_events_v1_metrics_proto_init() }
+func file_ctrlplane_events_v1_metrics_proto_init() {
+	if File_ctrlplane_events_v1_metrics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ctrlplane_events_v1_metrics_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ctrlplane_events_v1_metrics_proto_goTypes,
+		DependencyIndexes: file_ctrlplane_events_v1_metrics_proto_depIdxs,
+		EnumInfos:         file_ctrlplane_events_v1_metrics_proto_enumTypes,
+		MessageInfos:      file_ctrlplane_events_v1_metrics_proto_msgTypes,
+	}.Build()
+	File_ctrlplane_events_v1_metrics_proto = out.File
+	file_ctrlplane_events_v1_metrics_proto_rawDesc = nil
+	file_ctrlplane_events_v1_metrics_proto_goTypes = nil
+	file_ctrlplane_events_v1_metrics_proto_depIdxs = nil
+}
@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: hooks/webhook/v1/webhook.proto
+
+package webhookv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/hooks/webhook/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "hooks.webhook.v1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceSetWebhookProcedure is the fully-qualified name of the WebhookService's SetWebhook
+	// RPC.
+	WebhookServiceSetWebhookProcedure = "/hooks.webhook.v1.WebhookService/SetWebhook"
+	// WebhookServiceGetWebhookProcedure is the fully-qualified name of the WebhookService's GetWebhook
+	// RPC.
+	WebhookServiceGetWebhookProcedure = "/hooks.webhook.v1.WebhookService/GetWebhook"
+	// WebhookServiceDeleteWebhookProcedure is the fully-qualified name of the WebhookService's
+	// DeleteWebhook RPC.
+	WebhookServiceDeleteWebhookProcedure = "/hooks.webhook.v1.WebhookService/DeleteWebhook"
+	// WebhookServiceListDeadLettersProcedure is the fully-qualified name of the WebhookService's
+	// ListDeadLetters RPC.
+	WebhookServiceListDeadLettersProcedure = "/hooks.webhook.v1.WebhookService/ListDeadLetters"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	webhookServiceServiceDescriptor               = v1.File_hooks_webhook_v1_webhook_proto.Services().ByName("WebhookService")
+	webhookServiceSetWebhookMethodDescriptor      = webhookServiceServiceDescriptor.Methods().ByName("SetWebhook")
+	webhookServiceGetWebhookMethodDescriptor      = webhookServiceServiceDescriptor.Methods().ByName("GetWebhook")
+	webhookServiceDeleteWebhookMethodDescriptor   = webhookServiceServiceDescriptor.Methods().ByName("DeleteWebhook")
+	webhookServiceListDeadLettersMethodDescriptor = webhookServiceServiceDescriptor.Methods().ByName("ListDeadLetters")
+)
+
+// WebhookServiceClient is a client for the hooks.webhook.v1.WebhookService service.
+type WebhookServiceClient interface {
+	// SetWebhook creates or updates the webhook.
+	SetWebhook(context.Context, *connect.Request[v1.SetWebhookRequest]) (*connect.Response[v1.SetWebhookResponse], error)
+	// GetWebhook returns the webhook.
+	GetWebhook(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetWebhookResponse], error)
+	// DeleteWebhook removes the webhook along with its dead letters.
+	DeleteWebhook(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// ListDeadLetters returns the events that could not be delivered to the webhook.
+	ListDeadLetters(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListDeadLettersResponse], error)
+}
+
+// NewWebhookServiceClient constructs a client for the hooks.webhook.v1.WebhookService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &webhookServiceClient{
+		setWebhook: connect.NewClient[v1.SetWebhookRequest, v1.SetWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceSetWebhookProcedure,
+			connect.WithSchema(webhookServiceSetWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhook: connect.NewClient[emptypb.Empty, v1.GetWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookProcedure,
+			connect.WithSchema(webhookServiceGetWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhookProcedure,
+			connect.WithSchema(webhookServiceDeleteWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listDeadLetters: connect.NewClient[emptypb.Empty, v1.ListDeadLettersResponse](
+			httpClient,
+			baseURL+WebhookServiceListDeadLettersProcedure,
+			connect.WithSchema(webhookServiceListDeadLettersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	setWebhook      *connect.Client[v1.SetWebhookRequest, v1.SetWebhookResponse]
+	getWebhook      *connect.Client[emptypb.Empty, v1.GetWebhookResponse]
+	deleteWebhook   *connect.Client[emptypb.Empty, emptypb.Empty]
+	listDeadLetters *connect.Client[emptypb.Empty, v1.ListDeadLettersResponse]
+}
+
+// SetWebhook calls hooks.webhook.v1.WebhookService.SetWebhook.
+func (c *webhookServiceClient) SetWebhook(ctx context.Context, req *connect.Request[v1.SetWebhookRequest]) (*connect.Response[v1.SetWebhookResponse], error) {
+	return c.setWebhook.CallUnary(ctx, req)
+}
+
+// GetWebhook calls hooks.webhook.v1.WebhookService.GetWebhook.
+func (c *webhookServiceClient) GetWebhook(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetWebhookResponse], error) {
+	return c.getWebhook.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls hooks.webhook.v1.WebhookService.DeleteWebhook.
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// ListDeadLetters calls hooks.webhook.v1.WebhookService.ListDeadLetters.
+func (c *webhookServiceClient) ListDeadLetters(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return c.listDeadLetters.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the hooks.webhook.v1.WebhookService service.
+type WebhookServiceHandler interface {
+	// SetWebhook creates or updates the webhook.
+	SetWebhook(context.Context, *connect.Request[v1.SetWebhookRequest]) (*connect.Response[v1.SetWebhookResponse], error)
+	// GetWebhook returns the webhook.
+	GetWebhook(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetWebhookResponse], error)
+	// DeleteWebhook removes the webhook along with its dead letters.
+	DeleteWebhook(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// ListDeadLetters returns the events that could not be delivered to the webhook.
+	ListDeadLetters(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListDeadLettersResponse], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceSetWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceSetWebhookProcedure,
+		svc.SetWebhook,
+		connect.WithSchema(webhookServiceSetWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookProcedure,
+		svc.GetWebhook,
+		connect.WithSchema(webhookServiceGetWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(webhookServiceDeleteWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListDeadLettersHandler := connect.NewUnaryHandler(
+		WebhookServiceListDeadLettersProcedure,
+		svc.ListDeadLetters,
+		connect.WithSchema(webhookServiceListDeadLettersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/hooks.webhook.v1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceSetWebhookProcedure:
+			webhookServiceSetWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookProcedure:
+			webhookServiceGetWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhookProcedure:
+			webhookServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceListDeadLettersProcedure:
+			webhookServiceListDeadLettersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) SetWebhook(context.Context, *connect.Request[v1.SetWebhookRequest]) (*connect.Response[v1.SetWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.webhook.v1.WebhookService.SetWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhook(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.webhook.v1.WebhookService.GetWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhook(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.webhook.v1.WebhookService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListDeadLetters(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.webhook.v1.WebhookService.ListDeadLetters is not implemented"))
+}
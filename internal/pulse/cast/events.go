@@ -0,0 +1,75 @@
+package cast
+
+import (
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// RecordToProto converts a persisted event to its protobuf message. If the payload can't be decoded, the event is
+// returned without payload.
+func RecordToProto(record *pulse.Record) *eventsv1.Event {
+	parents := make([]string, 0, len(record.Parents))
+	for _, parent := range record.Parents {
+		parents = append(parents, parent.String())
+	}
+
+	proto := &eventsv1.Event{
+		Id:          record.ID.String(),
+		Version:     record.Version,
+		Parents:     parents,
+		Hook:        record.Hook,
+		Scope:       record.Scope,
+		Action:      record.Action,
+		Source:      record.Source,
+		SubjectId:   record.SubjectID.String(),
+		SubjectName: record.SubjectName,
+		UserId:      record.UserID.String(),
+		TeamId:      record.TeamID.String(),
+		OrgId:       record.OrgID.String(),
+		Timestamp:   timestamppb.New(record.Timestamp),
+	}
+
+	msg, err := pulse.DecodeAny(pulse.Format(record.Format), record.PayloadType, []byte(record.Payload))
+	if err != nil {
+		return proto
+	}
+
+	payload, err := anypb.New(msg)
+	if err != nil {
+		slog.Warn("pulse: unable to pack payload", "id", record.ID.String(), "error", err.Error())
+		return proto
+	}
+
+	proto.Payload = payload
+
+	return proto
+}
+
+// RecordsToProto converts persisted events to their protobuf messages.
+func RecordsToProto(records []pulse.Record) []*eventsv1.Event {
+	result := make([]*eventsv1.Event, 0, len(records))
+	for i := range records {
+		result = append(result, RecordToProto(&records[i]))
+	}
+
+	return result
+}
+
+// GraphToProto converts the causal graph of an event to the lineage response.
+func GraphToProto(graph *pulse.Graph) *eventsv1.GetLineageResponse {
+	edges := make([]*eventsv1.LineageEdge, 0, len(graph.Edges))
+	for _, edge := range graph.Edges {
+		edges = append(edges, &eventsv1.LineageEdge{From: edge.From.String(), To: edge.To.String()})
+	}
+
+	return &eventsv1.GetLineageResponse{
+		Root:  graph.Root.String(),
+		Nodes: RecordsToProto(graph.Nodes),
+		Edges: edges,
+	}
+}
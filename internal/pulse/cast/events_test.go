@@ -0,0 +1,117 @@
+package cast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+	"go.breu.io/quantm/internal/pulse/cast"
+)
+
+type (
+	EventsTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *EventsTestSuite) TestRecordToProto() {
+	event := events.New[eventsv1.RepoHook, eventsv1.Merge]().
+		SetScope(events.ScopeMerge).
+		SetAction(events.ActionFailure).
+		SetPayload(&eventsv1.Merge{HeadBranch: "feature", BaseBranch: "main"})
+
+	flat := event.Flatten()
+	parent := uuid.New()
+	record := &pulse.Record{
+		Version:     string(flat.Version),
+		Format:      uint8(pulse.FormatCurrent),
+		ID:          flat.ID,
+		Parents:     []uuid.UUID{parent},
+		Scope:       string(flat.Scope),
+		Action:      string(flat.Action),
+		SubjectID:   uuid.New(),
+		Timestamp:   time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC),
+		PayloadType: flat.PayloadType,
+		Payload:     string(flat.Payload),
+	}
+
+	proto := cast.RecordToProto(record)
+
+	s.Equal(record.ID.String(), proto.GetId())
+	s.Equal([]string{parent.String()}, proto.GetParents())
+	s.Equal("merge", proto.GetScope())
+	s.Equal("failure", proto.GetAction())
+	s.Equal(record.SubjectID.String(), proto.GetSubjectId())
+	s.Equal(uuid.Nil.String(), proto.GetUserId())
+	s.True(record.Timestamp.Equal(proto.GetTimestamp().AsTime()))
+
+	merge := &eventsv1.Merge{}
+
+	s.Require().NotNil(proto.GetPayload())
+	s.Require().NoError(proto.GetPayload().UnmarshalTo(merge))
+	s.Equal("feature", merge.GetHeadBranch())
+	s.Equal("main", merge.GetBaseBranch())
+}
+
+func (s *EventsTestSuite) TestRecordToProtoWithoutPayload() {
+	tests := []struct {
+		name   string
+		record *pulse.Record
+	}{
+		{"payloadless", &pulse.Record{ID: uuid.New(), Format: uint8(pulse.FormatCurrent)}},
+		{"flat", &pulse.Record{ID: uuid.New(), Format: uint8(pulse.FormatFlat), PayloadType: "ctrlplane.events.v1.Merge", Payload: "{}"}},
+		{"unknown type", &pulse.Record{ID: uuid.New(), Format: uint8(pulse.FormatPayload), PayloadType: "unknown", Payload: "{}"}},
+		{"garbled", &pulse.Record{ID: uuid.New(), Format: uint8(pulse.FormatPayload), PayloadType: "ctrlplane.events.v1.Merge", Payload: "{"}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			proto := cast.RecordToProto(tt.record)
+
+			s.Equal(tt.record.ID.String(), proto.GetId())
+			s.Nil(proto.GetPayload())
+		})
+	}
+}
+
+func (s *EventsTestSuite) TestRecordsToProto() {
+	records := []pulse.Record{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	protos := cast.RecordsToProto(records)
+
+	if s.Len(protos, 2) {
+		s.Equal(records[0].ID.String(), protos[0].GetId())
+		s.Equal(records[1].ID.String(), protos[1].GetId())
+	}
+
+	s.Empty(cast.RecordsToProto(nil))
+}
+
+func (s *EventsTestSuite) TestGraphToProto() {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	root := pulse.Record{ID: uuid.New(), Timestamp: now}
+	child := pulse.Record{ID: uuid.New(), Parents: []uuid.UUID{root.ID}, Timestamp: now.Add(time.Minute)}
+
+	proto := cast.GraphToProto(pulse.NewGraph(root.ID, []pulse.Record{child, root}))
+
+	s.Equal(root.ID.String(), proto.GetRoot())
+
+	if s.Len(proto.GetNodes(), 2) {
+		s.Equal(root.ID.String(), proto.GetNodes()[0].GetId())
+		s.Equal(child.ID.String(), proto.GetNodes()[1].GetId())
+	}
+
+	if s.Len(proto.GetEdges(), 1) {
+		s.Equal(root.ID.String(), proto.GetEdges()[0].GetFrom())
+		s.Equal(child.ID.String(), proto.GetEdges()[0].GetTo())
+	}
+}
+
+func TestEventsSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
@@ -0,0 +1,58 @@
+package cast
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// ProtoToGroup converts the protobuf metrics group to the pulse group. Unspecified defaults to the org.
+func ProtoToGroup(group eventsv1.MetricsGroup) pulse.Group {
+	switch group {
+	case eventsv1.MetricsGroup_METRICS_GROUP_TEAM:
+		return pulse.GroupTeam
+	case eventsv1.MetricsGroup_METRICS_GROUP_REPO:
+		return pulse.GroupRepo
+	case eventsv1.MetricsGroup_METRICS_GROUP_USER:
+		return pulse.GroupUser
+	case eventsv1.MetricsGroup_METRICS_GROUP_ORG, eventsv1.MetricsGroup_METRICS_GROUP_UNSPECIFIED:
+		return pulse.GroupOrg
+	}
+
+	return pulse.GroupOrg
+}
+
+// MetricsToProto converts the delivery metrics to their protobuf message.
+func MetricsToProto(metrics *pulse.Metrics) *eventsv1.DeliveryMetrics {
+	return &eventsv1.DeliveryMetrics{
+		Key:                   metrics.Key.String(),
+		LeadTime:              StatToProto(metrics.LeadTime),
+		CycleTime:             StatToProto(metrics.CycleTime),
+		TimeToFirstReview:     StatToProto(metrics.TimeToReview),
+		ReviewToMerge:         StatToProto(metrics.ReviewToMerge),
+		MergeQueueWait:        StatToProto(metrics.QueueWait),
+		MergeQueueTotal:       metrics.QueueTotal,
+		MergeQueueFailed:      metrics.QueueFailed,
+		MergeQueueFailureRate: metrics.FailureRate(),
+		Deployments:           metrics.Deployments,
+		DeploymentsPerDay:     metrics.DeploymentsDaily,
+		BranchLifetime:        StatToProto(metrics.BranchLifetime),
+	}
+}
+
+// StatToProto converts the stat, in seconds, to its protobuf message.
+func StatToProto(stat pulse.Stat) *eventsv1.DurationStats {
+	return &eventsv1.DurationStats{
+		Count: stat.Count,
+		Mean:  seconds(stat.Mean),
+		P50:   seconds(stat.P50),
+		P90:   seconds(stat.P90),
+	}
+}
+
+func seconds(s float64) *durationpb.Duration {
+	return durationpb.New(time.Duration(s * float64(time.Second)))
+}
@@ -0,0 +1,101 @@
+package cast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+	"go.breu.io/quantm/internal/pulse/cast"
+)
+
+type (
+	MetricsTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *MetricsTestSuite) TestProtoToGroup() {
+	tests := []struct {
+		name  string
+		proto eventsv1.MetricsGroup
+		want  pulse.Group
+	}{
+		{"unspecified", eventsv1.MetricsGroup_METRICS_GROUP_UNSPECIFIED, pulse.GroupOrg},
+		{"org", eventsv1.MetricsGroup_METRICS_GROUP_ORG, pulse.GroupOrg},
+		{"team", eventsv1.MetricsGroup_METRICS_GROUP_TEAM, pulse.GroupTeam},
+		{"repo", eventsv1.MetricsGroup_METRICS_GROUP_REPO, pulse.GroupRepo},
+		{"user", eventsv1.MetricsGroup_METRICS_GROUP_USER, pulse.GroupUser},
+		{"unknown", eventsv1.MetricsGroup(42), pulse.GroupOrg},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, cast.ProtoToGroup(tt.proto))
+		})
+	}
+}
+
+func (s *MetricsTestSuite) TestStatToProto() {
+	tests := []struct {
+		name string
+		stat pulse.Stat
+		mean time.Duration
+		p50  time.Duration
+		p90  time.Duration
+	}{
+		{"empty", pulse.Stat{}, 0, 0, 0},
+		{"whole seconds", pulse.Stat{Count: 2, Mean: 90, P50: 60, P90: 3600}, 90 * time.Second, time.Minute, time.Hour},
+		{"fractions", pulse.Stat{Count: 3, Mean: 1.5, P50: 0.25, P90: 2}, 1500 * time.Millisecond, 250 * time.Millisecond, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			proto := cast.StatToProto(tt.stat)
+
+			s.Equal(tt.stat.Count, proto.GetCount())
+			s.Equal(tt.mean, proto.GetMean().AsDuration())
+			s.Equal(tt.p50, proto.GetP50().AsDuration())
+			s.Equal(tt.p90, proto.GetP90().AsDuration())
+		})
+	}
+}
+
+func (s *MetricsTestSuite) TestMetricsToProto() {
+	metrics := &pulse.Metrics{
+		Key:              uuid.New(),
+		LeadTime:         pulse.Stat{Count: 1, Mean: 10},
+		CycleTime:        pulse.Stat{Count: 2, Mean: 20},
+		TimeToReview:     pulse.Stat{Count: 3, Mean: 30},
+		ReviewToMerge:    pulse.Stat{Count: 4, Mean: 40},
+		QueueWait:        pulse.Stat{Count: 5, Mean: 50},
+		QueueTotal:       8,
+		QueueFailed:      2,
+		Deployments:      6,
+		DeploymentsDaily: 0.5,
+		BranchLifetime:   pulse.Stat{Count: 7, Mean: 70},
+	}
+
+	proto := cast.MetricsToProto(metrics)
+
+	s.Equal(metrics.Key.String(), proto.GetKey())
+	s.Equal(uint64(1), proto.GetLeadTime().GetCount())
+	s.Equal(uint64(2), proto.GetCycleTime().GetCount())
+	s.Equal(uint64(3), proto.GetTimeToFirstReview().GetCount())
+	s.Equal(uint64(4), proto.GetReviewToMerge().GetCount())
+	s.Equal(uint64(5), proto.GetMergeQueueWait().GetCount())
+	s.Equal(uint64(7), proto.GetBranchLifetime().GetCount())
+	s.Equal(70*time.Second, proto.GetBranchLifetime().GetMean().AsDuration())
+	s.Equal(uint64(8), proto.GetMergeQueueTotal())
+	s.Equal(uint64(2), proto.GetMergeQueueFailed())
+	s.InDelta(0.25, proto.GetMergeQueueFailureRate(), 1e-9)
+	s.Equal(uint64(6), proto.GetDeployments())
+	s.InDelta(0.5, proto.GetDeploymentsPerDay(), 1e-9)
+}
+
+func TestMetricsSuite(t *testing.T) {
+	suite.Run(t, new(MetricsTestSuite))
+}
@@ -0,0 +1,188 @@
+package pulse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// CloudEvent is an event mapped to CloudEvents 1.0. The payload is the data, the context of the event is mapped to the
+	// core attributes and extensions.
+	//
+	//   - id, source, time and subject are the id, source, timestamp and subject id of the event.
+	//   - type is io.quantm.<scope>.<action>, e.g. io.quantm.pull_request.opened.
+	//   - orgid, userid, teamid, subjectname, parentid, payloadtype and quantmversion are extensions. parentid is the
+	//     direct parent of the event, userid, teamid and parentid are omitted when not set.
+	CloudEvent struct {
+		ID              string
+		Source          string
+		Type            string
+		Subject         string
+		Time            time.Time
+		DataContentType string
+		Extensions      map[string]string
+		Data            json.RawMessage
+	}
+
+	// HTTPSink posts the events as CloudEvents to an HTTP endpoint, in binary or structured content mode. Any 2xx
+	// response accepts the event.
+	HTTPSink struct {
+		url        string
+		structured bool
+		client     *http.Client
+	}
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+	CloudEventsTypePrefix  = "io.quantm."
+
+	// CloudEventsContentType is the content type of an event in structured content mode.
+	CloudEventsContentType = "application/cloudevents+json"
+
+	// cloudevents_source is the source of the events without one.
+	cloudevents_source = "quantm"
+)
+
+// ToCloudEvent maps the record to a CloudEvent.
+func ToCloudEvent(record Record) CloudEvent {
+	event := CloudEvent{
+		ID:              record.ID.String(),
+		Source:          record.Source,
+		Type:            CloudEventsTypePrefix + record.Scope + "." + record.Action,
+		Subject:         record.SubjectID.String(),
+		Time:            record.Timestamp.UTC(),
+		DataContentType: "application/json",
+		Extensions: map[string]string{
+			"orgid":         record.OrgID.String(),
+			"subjectname":   record.SubjectName,
+			"payloadtype":   record.PayloadType,
+			"quantmversion": record.Version,
+		},
+	}
+
+	if event.Source == "" {
+		event.Source = cloudevents_source
+	}
+
+	if record.UserID != uuid.Nil {
+		event.Extensions["userid"] = record.UserID.String()
+	}
+
+	if record.TeamID != uuid.Nil {
+		event.Extensions["teamid"] = record.TeamID.String()
+	}
+
+	if len(record.Parents) > 0 {
+		event.Extensions["parentid"] = record.Parents[len(record.Parents)-1].String()
+	}
+
+	if record.Payload != "" {
+		event.Data = json.RawMessage(record.Payload)
+	}
+
+	return event
+}
+
+// Attributes returns the context attributes of the event, extensions included, as used by the binary content mode.
+func (e CloudEvent) Attributes() map[string]string {
+	attrs := map[string]string{
+		"specversion": CloudEventsSpecVersion,
+		"id":          e.ID,
+		"source":      e.Source,
+		"type":        e.Type,
+		"subject":     e.Subject,
+		"time":        e.Time.Format(time.RFC3339Nano),
+	}
+
+	for k, v := range e.Extensions {
+		attrs[k] = v
+	}
+
+	return attrs
+}
+
+// MarshalJSON encodes the event in the JSON event format, as used by the structured content mode.
+func (e CloudEvent) MarshalJSON() ([]byte, error) {
+	event := make(map[string]any)
+	for k, v := range e.Attributes() {
+		event[k] = v
+	}
+
+	event["datacontenttype"] = e.DataContentType
+
+	if len(e.Data) > 0 {
+		event["data"] = e.Data
+	}
+
+	return json.Marshal(event)
+}
+
+// NewHTTPSink creates a sink posting to the url. mode is either binary or structured, binary if empty.
+func NewHTTPSink(url, mode string) *HTTPSink {
+	return &HTTPSink{url: url, structured: mode == "structured", client: &http.Client{}}
+}
+
+func (s *HTTPSink) Name() string { return "http" }
+
+// Send posts the record.
+func (s *HTTPSink) Send(ctx context.Context, record Record) error {
+	req, err := s.request(ctx, ToCloudEvent(record))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("pulse: sink responded with %s", res.Status)
+	}
+
+	return nil
+}
+
+// request builds the request for the event in the content mode of the sink.
+func (s *HTTPSink) request(ctx context.Context, event CloudEvent) (*http.Request, error) {
+	if s.structured {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Content-Type", CloudEventsContentType)
+
+		return req, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(event.Data))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range event.Attributes() {
+		req.Header.Set("ce-"+k, v)
+	}
+
+	req.Header.Set("Content-Type", event.DataContentType)
+
+	return req, nil
+}
@@ -20,6 +20,12 @@ type (
 		Password string `json:"pass" koanf:"PASS" validate:"required"` // Database password.
 		Name     string `json:"name" koanf:"NAME" validate:"required"` // Database name.
 
+		BatchSize   int `json:"batch_size" koanf:"BATCH_SIZE" validate:"gte=1"`     // Rows per table that trigger a flush.
+		BatchWait   int `json:"batch_wait" koanf:"BATCH_WAIT" validate:"gte=1"`     // Milliseconds between flushes.
+		BatchBuffer int `json:"batch_buffer" koanf:"BATCH_BUFFER" validate:"gte=1"` // Rows buffered before writes block.
+
+		Sinks Sinks `json:"sinks" koanf:"SINKS"` // Sinks the stored events are streamed to.
+
 		conn driver.Conn // Established database connection.
 		once *sync.Once  // Ensures single connection initialization.
 	}
@@ -37,6 +43,12 @@ var (
 		Password: "ctrlplane", // Default password.
 		Name:     "ctrlplane", // Default database name.
 
+		BatchSize:   500,   // Default batch size.
+		BatchWait:   250,   // Default wait between flushes, in milliseconds.
+		BatchBuffer: 10000, // Default buffer size.
+
+		Sinks: DefaultSinks, // No sink enabled by default.
+
 		once: &sync.Once{}, // Guarantees single connection attempt.
 	}
 )
@@ -153,6 +165,19 @@ func WithConfig(cfg *Config) Option {
 		c.User = cfg.User
 		c.Password = cfg.Password
 		c.Name = cfg.Name
+		c.BatchSize = cfg.BatchSize
+		c.BatchWait = cfg.BatchWait
+		c.BatchBuffer = cfg.BatchBuffer
+		c.Sinks = cfg.Sinks
+	}
+}
+
+// WithBatch sets the size, wait in milliseconds and buffer of the batched writes.
+func WithBatch(size, wait, buffer int) Option {
+	return func(c *Config) {
+		c.BatchSize = size
+		c.BatchWait = wait
+		c.BatchBuffer = buffer
 	}
 }
 
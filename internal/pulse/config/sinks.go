@@ -0,0 +1,30 @@
+package config
+
+type (
+	// Sinks configures the external systems the stored events are streamed to. A sink is enabled when its url is set.
+	Sinks struct {
+		HTTPURL     string `json:"http_url" koanf:"HTTP_URL" validate:"omitempty,url"`                       // CloudEvents HTTP endpoint.
+		HTTPMode    string `json:"http_mode" koanf:"HTTP_MODE" validate:"omitempty,oneof=binary structured"` // CloudEvents content mode.
+		NATSURL     string `json:"nats_url" koanf:"NATS_URL" validate:"omitempty,url"`                       // NATS server, e.g. nats://host:4222 or tls://host:4222.
+		NATSSubject string `json:"nats_subject" koanf:"NATS_SUBJECT"`                                        // Subject prefix of the events.
+		Timeout     int    `json:"timeout" koanf:"TIMEOUT" validate:"gte=1"`                                 // Milliseconds per delivery.
+		Buffer      int    `json:"buffer" koanf:"BUFFER" validate:"gte=1"`                                   // Events buffered before dropping.
+	}
+)
+
+var (
+	// DefaultSinks has no sink enabled.
+	DefaultSinks = Sinks{
+		HTTPMode:    "binary",
+		NATSSubject: "quantm.events",
+		Timeout:     5000,
+		Buffer:      10000,
+	}
+)
+
+// WithSinks sets the sinks the stored events are streamed to.
+func WithSinks(sinks Sinks) Option {
+	return func(c *Config) {
+		c.Sinks = sinks
+	}
+}
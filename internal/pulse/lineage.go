@@ -0,0 +1,157 @@
+package pulse
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Edge links a parent event to a child event.
+	Edge struct {
+		From uuid.UUID `json:"from"`
+		To   uuid.UUID `json:"to"`
+	}
+
+	// Graph is the causal graph of an event. Nodes are sorted oldest first.
+	Graph struct {
+		Root  uuid.UUID `json:"root"`
+		Nodes []Record  `json:"nodes"`
+		Edges []Edge    `json:"edges"`
+	}
+)
+
+const (
+	// DescendantsLimit caps the number of descendants loaded for a graph.
+	DescendantsLimit = 1000
+)
+
+// Descendants returns the events having the event among their parents, oldest first.
+//
+// The parents of an event created with events.Next hold the complete chain of ancestors, so a single lookup is enough
+// to find every generation of descendants.
+func Descendants(ctx context.Context, slug string, id uuid.UUID, limit int) ([]Record, error) {
+	stmt := fmt.Sprintf(statement__events__select, table_name("events", slug), "has(parents, ?)", limit)
+	result := make([]Record, 0)
+
+	if err := Get().Connection().Select(ctx, &result, stmt, id); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(result)
+
+	return result, nil
+}
+
+// LineageGraph returns the causal graph of the event with the given id, or nil if the event does not exist.
+func LineageGraph(ctx context.Context, slug string, id uuid.UUID, depth int) (*Graph, error) {
+	records, err := Records(ctx, slug, []uuid.UUID{id})
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+
+	ancestors, err := Lineage(ctx, slug, &records[0], depth)
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err := Descendants(ctx, slug, id, DescendantsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	records = append(records, ancestors...)
+	records = append(records, descendants...)
+
+	return NewGraph(id, records), nil
+}
+
+// NewGraph builds the graph from the given events. Duplicate events are dropped.
+//
+// An event is linked only to its direct parents, i.e. parents that are not the ancestor of another of its parents. For
+// a chain built with events.Next, that is the last parent.
+func NewGraph(root uuid.UUID, records []Record) *Graph {
+	graph := &Graph{Root: root, Nodes: make([]Record, 0, len(records)), Edges: make([]Edge, 0)}
+	nodes := make(map[uuid.UUID]*Record, len(records))
+
+	for i := range records {
+		if _, ok := nodes[records[i].ID]; ok {
+			continue
+		}
+
+		nodes[records[i].ID] = &records[i]
+		graph.Nodes = append(graph.Nodes, records[i])
+	}
+
+	slices.SortStableFunc(graph.Nodes, func(a, b Record) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+
+	for _, node := range graph.Nodes {
+		for _, parent := range node.Parents {
+			if _, ok := nodes[parent]; !ok || !is_direct(parent, node.Parents, nodes) {
+				continue
+			}
+
+			graph.Edges = append(graph.Edges, Edge{From: parent, To: node.ID})
+		}
+	}
+
+	return graph
+}
+
+// DOT renders the graph in Graphviz DOT. The root is highlighted.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph lineage {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box, style=rounded, fontname=\"Helvetica\"];\n")
+
+	for _, node := range g.Nodes {
+		ts := node.Timestamp.UTC().Format("2006-01-02 15:04:05")
+		label := fmt.Sprintf("%s/%s\\n%s\\n%s", node.Scope, node.Action, node.SubjectName, ts)
+		attrs := fmt.Sprintf("label=%s", dot_quote(label))
+
+		if node.ID == g.Root {
+			attrs += ", style=\"rounded,bold\""
+		}
+
+		fmt.Fprintf(&b, "\t%s [%s];\n", dot_quote(node.ID.String()), attrs)
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "\t%s -> %s;\n", dot_quote(edge.From.String()), dot_quote(edge.To.String()))
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// is_direct returns true if the parent is not an ancestor of any of the other known parents.
+func is_direct(parent uuid.UUID, parents []uuid.UUID, nodes map[uuid.UUID]*Record) bool {
+	for _, other := range parents {
+		if other == parent {
+			continue
+		}
+
+		if record, ok := nodes[other]; ok && slices.Contains(record.Parents, parent) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dot_quote quotes the string as a DOT identifier. Escaped newlines are kept as is.
+func dot_quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
@@ -0,0 +1,90 @@
+package pulse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	LineageTestSuite struct {
+		suite.Suite
+
+		now time.Time
+	}
+)
+
+func (s *LineageTestSuite) SetupTest() {
+	s.now = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+}
+
+// record returns an event created a minute after the last of its parents.
+func (s *LineageTestSuite) record(action string, parents ...pulse.Record) pulse.Record {
+	ids := make([]uuid.UUID, 0)
+	for _, parent := range parents {
+		ids = append(ids, parent.Parents...)
+		ids = append(ids, parent.ID)
+	}
+
+	return pulse.Record{
+		ID:        uuid.New(),
+		Parents:   ids,
+		Scope:     "branch",
+		Action:    action,
+		Timestamp: s.now.Add(time.Duration(len(ids)) * time.Minute),
+	}
+}
+
+func (s *LineageTestSuite) TestChain() {
+	push := s.record("created")
+	rebase := s.record("rebased", push)
+	conflict := s.record("failure", rebase)
+
+	graph := pulse.NewGraph(rebase.ID, []pulse.Record{conflict, rebase, push, rebase})
+
+	s.Require().Len(graph.Nodes, 3)
+	s.Equal(push.ID, graph.Nodes[0].ID)
+	s.Equal(conflict.ID, graph.Nodes[2].ID)
+	s.Equal([]pulse.Edge{{From: push.ID, To: rebase.ID}, {From: rebase.ID, To: conflict.ID}}, graph.Edges)
+}
+
+func (s *LineageTestSuite) TestMissingParent() {
+	push := s.record("created")
+	rebase := s.record("rebased", push)
+	conflict := s.record("failure", rebase)
+
+	graph := pulse.NewGraph(conflict.ID, []pulse.Record{push, conflict})
+
+	s.Equal([]pulse.Edge{{From: push.ID, To: conflict.ID}}, graph.Edges)
+}
+
+func (s *LineageTestSuite) TestBranches() {
+	push := s.record("created")
+	diff := s.record("exceeded", push)
+	conflict := s.record("failure", push)
+
+	graph := pulse.NewGraph(push.ID, []pulse.Record{push, diff, conflict})
+
+	s.ElementsMatch([]pulse.Edge{{From: push.ID, To: diff.ID}, {From: push.ID, To: conflict.ID}}, graph.Edges)
+}
+
+func (s *LineageTestSuite) TestDOT() {
+	push := s.record("created")
+	push.SubjectName = `say "hi"`
+	rebase := s.record("rebased", push)
+
+	dot := pulse.NewGraph(push.ID, []pulse.Record{push, rebase}).DOT()
+
+	s.Contains(dot, "digraph lineage {")
+	s.Contains(dot, `"`+push.ID.String()+`" -> "`+rebase.ID.String()+`";`)
+	s.Contains(dot, `branch/created\nsay \"hi\"\n2024-10-01 12:00:00`)
+	s.Contains(dot, `style="rounded,bold"`)
+}
+
+func TestLineage(t *testing.T) {
+	suite.Run(t, new(LineageTestSuite))
+}
@@ -0,0 +1,312 @@
+package pulse
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Group defines how the metrics are broken down.
+	Group string
+
+	// Stat summarizes a set of durations, in seconds.
+	Stat struct {
+		Key   uuid.UUID `ch:"key"`
+		Count uint64    `ch:"count"`
+		Mean  float64   `ch:"mean"`
+		P50   float64   `ch:"p50"`
+		P90   float64   `ch:"p90"`
+	}
+
+	// Metrics are the delivery metrics of an org, team, repo or user.
+	Metrics struct {
+		Key              uuid.UUID
+		LeadTime         Stat
+		CycleTime        Stat
+		TimeToReview     Stat
+		ReviewToMerge    Stat
+		QueueWait        Stat
+		QueueTotal       uint64
+		QueueFailed      uint64
+		Deployments      uint64
+		DeploymentsDaily float64
+		BranchLifetime   Stat
+	}
+
+	// MetricsFilter narrows down the events the metrics are computed from. Durations are counted in the period they end.
+	MetricsFilter struct {
+		Since     time.Time
+		Until     time.Time
+		SubjectID uuid.UUID
+		GroupBy   Group
+	}
+
+	// selector runs the select statement, scanning the rows into dest, e.g. the Select of the clickhouse connection.
+	selector func(ctx context.Context, dest any, query string, args ...any) error
+
+	// counts is the number of rows, and the number of those matching a condition.
+	counts struct {
+		Key     uuid.UUID `ch:"key"`
+		Total   uint64    `ch:"total"`
+		Matched uint64    `ch:"matched"`
+	}
+)
+
+const (
+	// rollups maintained alongside the events table, see migrations/000003_create_rollups.sql.
+	rollup_prs      = "rollup_prs"
+	rollup_branches = "rollup_branches"
+	rollup_queue    = "rollup_queue"
+	rollup_daily    = "rollup_daily"
+
+	nil_uuid = "toUUID('00000000-0000-0000-0000-000000000000')"
+)
+
+const (
+	GroupOrg  Group = "org"
+	GroupTeam Group = "team"
+	GroupRepo Group = "repo"
+	GroupUser Group = "user"
+)
+
+const (
+	// statement__metrics__stat summarizes the durations selected by the inner query. The inner query must select the
+	// subject_id, user_id, team_id, duration in seconds and the time the duration ended at.
+	statement__metrics__stat = `
+SELECT
+  %s AS key,
+  count() AS count,
+  avg(duration) AS mean,
+  quantile(0.5)(duration) AS p50,
+  quantile(0.9)(duration) AS p90
+FROM (%s)
+WHERE duration >= 0 AND at >= ? AND at < ? %s
+GROUP BY key
+`
+
+	// statement__metrics__queue counts the pull requests removed from the merge queue, and those that were not merged.
+	statement__metrics__queue = `
+SELECT
+  %s AS key,
+  count() AS total,
+  countIf(merged_at IS NULL) AS matched
+FROM (
+  SELECT q.subject_id AS subject_id, q.user_id AS user_id, q.team_id AS team_id, q.removed_at AS at, p.merged_at AS merged_at
+  FROM (%s) AS q
+  LEFT JOIN (%s) AS p ON q.subject_id = p.subject_id AND q.number = p.number
+  WHERE q.removed_at IS NOT NULL
+)
+WHERE at >= ? AND at < ? %s
+GROUP BY key
+`
+
+	// statement__metrics__deployments counts the successful deployments, i.e. the deployment statuses with a success state.
+	statement__metrics__deployments = `
+SELECT
+  %s AS key,
+  sum(count) AS total,
+  sum(count) AS matched
+FROM %s
+WHERE scope = 'deployment_status' AND action = 'completed' AND day >= toDate(?) AND day < toDate(?) %s
+GROUP BY key
+`
+
+	// statement__metrics__prs, statement__metrics__branches and statement__metrics__queued merge the partial rows of
+	// the rollups.
+	statement__metrics__prs = `
+SELECT
+  subject_id,
+  number,
+  max(branch) AS branch,
+  any(user_id) AS user_id,
+  any(team_id) AS team_id,
+  min(opened_at) AS opened_at,
+  min(reviewed_at) AS reviewed_at,
+  max(merged_at) AS merged_at
+FROM %s
+GROUP BY subject_id, number`
+
+	statement__metrics__branches = `
+SELECT
+  subject_id,
+  branch,
+  any(user_id) AS user_id,
+  any(team_id) AS team_id,
+  min(created_at) AS created_at,
+  min(first_push_at) AS first_push_at,
+  max(deleted_at) AS deleted_at
+FROM %s
+GROUP BY subject_id, branch`
+
+	statement__metrics__queued = `
+SELECT
+  subject_id,
+  number,
+  any(user_id) AS user_id,
+  any(team_id) AS team_id,
+  min(added_at) AS added_at,
+  max(removed_at) AS removed_at
+FROM %s
+GROUP BY subject_id, number`
+
+	// statement__metrics__lead_time selects the time from the first push on a branch to the merge of its pull request.
+	statement__metrics__lead_time = `
+SELECT
+  p.subject_id AS subject_id, p.user_id AS user_id, p.team_id AS team_id,
+  assumeNotNull(dateDiff('second', b.first_push_at, p.merged_at)) AS duration, p.merged_at AS at
+FROM (%s) AS p
+INNER JOIN (%s) AS b ON p.subject_id = b.subject_id AND p.branch = b.branch
+WHERE p.merged_at IS NOT NULL AND b.first_push_at IS NOT NULL`
+
+	// statement__metrics__durations selects the time between two columns of an aggregated rollup.
+	statement__metrics__durations = `
+SELECT subject_id, user_id, team_id, assumeNotNull(dateDiff('second', %s, %s)) AS duration, %s AS at
+FROM (%s)
+WHERE %s IS NOT NULL AND %s IS NOT NULL`
+)
+
+// Collect computes the delivery metrics of the org from its rollups, sorted by key.
+func Collect(ctx context.Context, slug string, filter MetricsFilter) ([]Metrics, error) {
+	return collect(ctx, Get().Connection().Select, slug, filter)
+}
+
+// collect computes the delivery metrics, running the statements with the selector.
+func collect(ctx context.Context, run selector, slug string, filter MetricsFilter) ([]Metrics, error) {
+	prs := fmt.Sprintf(statement__metrics__prs, table_name(rollup_prs, slug))
+	branches := fmt.Sprintf(statement__metrics__branches, table_name(rollup_branches, slug))
+	queued := fmt.Sprintf(statement__metrics__queued, table_name(rollup_queue, slug))
+
+	result := make(map[uuid.UUID]*Metrics)
+	get := func(key uuid.UUID) *Metrics {
+		if _, ok := result[key]; !ok {
+			result[key] = &Metrics{Key: key}
+		}
+
+		return result[key]
+	}
+
+	stats := []struct {
+		inner string
+		set   func(*Metrics, Stat)
+	}{
+		{
+			fmt.Sprintf(statement__metrics__lead_time, prs, branches),
+			func(m *Metrics, s Stat) { m.LeadTime = s },
+		},
+		{
+			durations(prs, "opened_at", "merged_at"),
+			func(m *Metrics, s Stat) { m.CycleTime = s },
+		},
+		{
+			durations(prs, "opened_at", "reviewed_at"),
+			func(m *Metrics, s Stat) { m.TimeToReview = s },
+		},
+		{
+			durations(prs, "reviewed_at", "merged_at"),
+			func(m *Metrics, s Stat) { m.ReviewToMerge = s },
+		},
+		{
+			durations(queued, "added_at", "removed_at"),
+			func(m *Metrics, s Stat) { m.QueueWait = s },
+		},
+		{
+			durations(branches, "created_at", "deleted_at"),
+			func(m *Metrics, s Stat) { m.BranchLifetime = s },
+		},
+	}
+
+	key, where, args := filter.clauses()
+
+	for _, stat := range stats {
+		rows := make([]Stat, 0)
+		stmt := fmt.Sprintf(statement__metrics__stat, key, stat.inner, where)
+
+		if err := run(ctx, &rows, stmt, args...); err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			stat.set(get(row.Key), row)
+		}
+	}
+
+	queue := make([]counts, 0)
+	stmt := fmt.Sprintf(statement__metrics__queue, key, queued, prs, where)
+
+	if err := run(ctx, &queue, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range queue {
+		m := get(row.Key)
+		m.QueueTotal, m.QueueFailed = row.Total, row.Matched
+	}
+
+	deployments := make([]counts, 0)
+	stmt = fmt.Sprintf(statement__metrics__deployments, key, table_name(rollup_daily, slug), where)
+
+	if err := run(ctx, &deployments, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	days := math.Max(math.Ceil(filter.Until.Sub(filter.Since).Hours()/24), 1)
+
+	for _, row := range deployments {
+		m := get(row.Key)
+		m.Deployments, m.DeploymentsDaily = row.Total, float64(row.Total)/days
+	}
+
+	metrics := make([]Metrics, 0, len(result))
+	for _, m := range result {
+		metrics = append(metrics, *m)
+	}
+
+	slices.SortFunc(metrics, func(a, b Metrics) int { return strings.Compare(a.Key.String(), b.Key.String()) })
+
+	return metrics, nil
+}
+
+// FailureRate returns the share of the pull requests removed from the merge queue without being merged.
+func (m *Metrics) FailureRate() float64 {
+	if m.QueueTotal == 0 {
+		return 0
+	}
+
+	return float64(m.QueueFailed) / float64(m.QueueTotal)
+}
+
+// clauses returns the key expression, the additional where clause and the arguments for the filter.
+func (f MetricsFilter) clauses() (string, string, []any) {
+	key := nil_uuid
+
+	switch f.GroupBy {
+	case GroupTeam:
+		key = "ifNull(team_id, " + nil_uuid + ")"
+	case GroupRepo:
+		key = "subject_id"
+	case GroupUser:
+		key = "ifNull(user_id, " + nil_uuid + ")"
+	case GroupOrg:
+	}
+
+	args := []any{f.Since, f.Until}
+	where := ""
+
+	if f.SubjectID != uuid.Nil {
+		where = "AND subject_id = ?"
+		args = append(args, f.SubjectID)
+	}
+
+	return key, where, args
+}
+
+// durations selects the time between two columns of an aggregated rollup.
+func durations(inner, from, to string) string {
+	return fmt.Sprintf(statement__metrics__durations, from, to, to, inner, from, to)
+}
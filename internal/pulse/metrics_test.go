@@ -0,0 +1,215 @@
+package pulse
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	MetricsTestSuite struct {
+		suite.Suite
+
+		since time.Time
+		until time.Time
+	}
+
+	// fake_rollups answers the statements of collect with canned rows, keyed by a fragment of the statement.
+	fake_rollups struct {
+		stats      map[string][]Stat
+		queue      []counts
+		deploys    []counts
+		statements []string
+		args       [][]any
+		err        error
+	}
+)
+
+func (f *fake_rollups) run(_ context.Context, dest any, query string, args ...any) error {
+	f.statements = append(f.statements, query)
+	f.args = append(f.args, args)
+
+	if f.err != nil {
+		return f.err
+	}
+
+	switch rows := dest.(type) {
+	case *[]Stat:
+		for fragment, stat := range f.stats {
+			if strings.Contains(query, fragment) {
+				*rows = append(*rows, stat...)
+			}
+		}
+	case *[]counts:
+		if strings.Contains(query, "deployment_status") {
+			*rows = append(*rows, f.deploys...)
+		} else {
+			*rows = append(*rows, f.queue...)
+		}
+	}
+
+	return nil
+}
+
+func (s *MetricsTestSuite) SetupTest() {
+	s.since = time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	s.until = s.since.Add(10 * 24 * time.Hour)
+}
+
+func (s *MetricsTestSuite) TestClauses() {
+	repo := uuid.New()
+
+	tests := []struct {
+		name  string
+		group Group
+		repo  uuid.UUID
+		key   string
+		where string
+	}{
+		{"org", GroupOrg, uuid.Nil, nil_uuid, ""},
+		{"unspecified", "", uuid.Nil, nil_uuid, ""},
+		{"team", GroupTeam, uuid.Nil, "ifNull(team_id, " + nil_uuid + ")", ""},
+		{"repo", GroupRepo, uuid.Nil, "subject_id", ""},
+		{"user", GroupUser, uuid.Nil, "ifNull(user_id, " + nil_uuid + ")", ""},
+		{"single repo", GroupUser, repo, "ifNull(user_id, " + nil_uuid + ")", "AND subject_id = ?"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			filter := MetricsFilter{Since: s.since, Until: s.until, GroupBy: tt.group, SubjectID: tt.repo}
+
+			key, where, args := filter.clauses()
+
+			s.Equal(tt.key, key)
+			s.Equal(tt.where, where)
+
+			if tt.repo != uuid.Nil {
+				s.Equal([]any{s.since, s.until, tt.repo}, args)
+			} else {
+				s.Equal([]any{s.since, s.until}, args)
+			}
+		})
+	}
+}
+
+func (s *MetricsTestSuite) TestCollect() {
+	a, b := uuid.MustParse("00000000-0000-0000-0000-00000000000a"), uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+
+	tests := []struct {
+		name     string
+		fragment string
+		get      func(*Metrics) Stat
+	}{
+		{"lead time", "b.first_push_at", func(m *Metrics) Stat { return m.LeadTime }},
+		{"cycle time", "dateDiff('second', opened_at, merged_at)", func(m *Metrics) Stat { return m.CycleTime }},
+		{"time to review", "dateDiff('second', opened_at, reviewed_at)", func(m *Metrics) Stat { return m.TimeToReview }},
+		{"review to merge", "dateDiff('second', reviewed_at, merged_at)", func(m *Metrics) Stat { return m.ReviewToMerge }},
+		{"queue wait", "dateDiff('second', added_at, removed_at)", func(m *Metrics) Stat { return m.QueueWait }},
+		{"branch lifetime", "dateDiff('second', created_at, deleted_at)", func(m *Metrics) Stat { return m.BranchLifetime }},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			stat := Stat{Key: b, Count: 3, Mean: 60, P50: 30, P90: 120}
+			fake := &fake_rollups{stats: map[string][]Stat{tt.fragment: {stat}}}
+
+			metrics, err := collect(context.Background(), fake.run, "acme-corp", MetricsFilter{Since: s.since, Until: s.until})
+
+			s.Require().NoError(err)
+			s.Require().Len(metrics, 1)
+			s.Equal(b, metrics[0].Key)
+			s.Equal(stat, tt.get(&metrics[0]))
+		})
+	}
+
+	s.Run("counts", func() {
+		fake := &fake_rollups{
+			queue:   []counts{{Key: b, Total: 4, Matched: 1}},
+			deploys: []counts{{Key: a, Total: 5, Matched: 5}},
+		}
+
+		metrics, err := collect(context.Background(), fake.run, "acme-corp", MetricsFilter{Since: s.since, Until: s.until})
+
+		s.Require().NoError(err)
+		s.Require().Len(metrics, 2)
+		s.Equal(a, metrics[0].Key, "metrics are sorted by key")
+		s.Equal(uint64(5), metrics[0].Deployments)
+		s.InDelta(0.5, metrics[0].DeploymentsDaily, 1e-9)
+		s.Equal(b, metrics[1].Key)
+		s.Equal(uint64(4), metrics[1].QueueTotal)
+		s.Equal(uint64(1), metrics[1].QueueFailed)
+		s.InDelta(0.25, metrics[1].FailureRate(), 1e-9)
+	})
+}
+
+func (s *MetricsTestSuite) TestCollectStatements() {
+	repo := uuid.New()
+	fake := &fake_rollups{}
+	filter := MetricsFilter{Since: s.since, Until: s.until, SubjectID: repo, GroupBy: GroupRepo}
+
+	_, err := collect(context.Background(), fake.run, "acme-corp", filter)
+
+	s.Require().NoError(err)
+	s.Require().Len(fake.statements, 8)
+
+	for i, statement := range fake.statements {
+		s.Contains(statement, "subject_id AS key")
+		s.Contains(statement, "AND subject_id = ?")
+		s.NotContains(statement, "events_acme_corp", "metrics are read from the rollups")
+		s.Equal([]any{s.since, s.until, repo}, fake.args[i])
+	}
+
+	s.Contains(fake.statements[6], "FROM rollup_queue_acme_corp")
+	s.Contains(fake.statements[6], "FROM rollup_prs_acme_corp")
+	s.Contains(fake.statements[7], "FROM rollup_daily_acme_corp")
+}
+
+func (s *MetricsTestSuite) TestCollectShortPeriod() {
+	a := uuid.New()
+	fake := &fake_rollups{deploys: []counts{{Key: a, Total: 3, Matched: 3}}}
+
+	metrics, err := collect(context.Background(), fake.run, "acme-corp", MetricsFilter{Since: s.since, Until: s.since.Add(time.Hour)})
+
+	s.Require().NoError(err)
+	s.Require().Len(metrics, 1)
+	s.InDelta(3, metrics[0].DeploymentsDaily, 1e-9, "a period shorter than a day counts as a day")
+}
+
+func (s *MetricsTestSuite) TestCollectError() {
+	fake := &fake_rollups{err: errors.New("unavailable")}
+
+	_, err := collect(context.Background(), fake.run, "acme-corp", MetricsFilter{Since: s.since, Until: s.until})
+
+	s.ErrorIs(err, fake.err)
+	s.Len(fake.statements, 1, "collect stops at the first failure")
+}
+
+func (s *MetricsTestSuite) TestFailureRate() {
+	tests := []struct {
+		name   string
+		total  uint64
+		failed uint64
+		want   float64
+	}{
+		{"empty queue", 0, 0, 0},
+		{"no failure", 4, 0, 0},
+		{"some failures", 4, 1, 0.25},
+		{"all failed", 2, 2, 1},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			m := &Metrics{QueueTotal: tt.total, QueueFailed: tt.failed}
+			s.InDelta(tt.want, m.FailureRate(), 1e-9)
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	suite.Run(t, new(MetricsTestSuite))
+}
@@ -0,0 +1,202 @@
+package pulse
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"go.breu.io/quantm/internal/db"
+)
+
+type (
+	// migration is a versioned set of DDL statements applied to the tables of every org. The statements are a text
+	// template, tables are referenced with {{ table "kind" }}, which is replaced by the table of the kind for the org.
+	migration struct {
+		version uint32
+		name    string
+		body    string
+	}
+)
+
+const (
+	// statement__migrations__create creates the table tracking the migrations applied to the tables of each org.
+	statement__migrations__create = `
+CREATE TABLE IF NOT EXISTS pulse_migrations (
+  slug String,
+  version UInt32,
+  name String,
+  applied_at DateTime DEFAULT now()
+)
+ENGINE = ReplacingMergeTree(applied_at)
+ORDER BY (slug, version);
+`
+
+	statement__migrations__version = `SELECT max(version) FROM pulse_migrations WHERE slug = ?`
+	statement__migrations__applied = `INSERT INTO pulse_migrations (slug, version, name) VALUES (?, ?, ?)`
+)
+
+var (
+	//go:embed migrations/*.sql
+	sql embed.FS
+
+	// migration_file matches the name of a migration file, e.g. 000001_create_events.sql.
+	migration_file = regexp.MustCompile(`^(\d+)_(\w+)\.sql$`)
+
+	// statement_end splits a rendered migration into statements.
+	statement_end = regexp.MustCompile(`;\s*(\n|$)`)
+)
+
+// Migrate applies the pending migrations to the tables of the org. A migration that failed halfway is applied again
+// from the start, so migrations must be safe to apply twice, e.g. tables are created if they do not exist, and backfills
+// either aggregate idempotently or remove what a previous run inserted.
+func Migrate(ctx context.Context, slug string) error {
+	if err := Get().Connection().Exec(ctx, statement__migrations__create); err != nil {
+		return err
+	}
+
+	var applied uint32
+	if err := Get().Connection().QueryRow(ctx, statement__migrations__version, slug).Scan(&applied); err != nil {
+		return err
+	}
+
+	all, err := migrations()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range all {
+		if m.version <= applied {
+			continue
+		}
+
+		statements, err := m.render(slug)
+		if err != nil {
+			return err
+		}
+
+		for _, statement := range statements {
+			if err := Get().Connection().Exec(ctx, statement); err != nil {
+				return fmt.Errorf("pulse: migration %d_%s failed for %s: %w", m.version, m.name, slug, err)
+			}
+		}
+
+		if err := Get().Connection().Exec(ctx, statement__migrations__applied, slug, m.version, m.name); err != nil {
+			return err
+		}
+
+		slog.Info("pulse: migration applied", "slug", slug, "version", m.version, "name", m.name)
+	}
+
+	return nil
+}
+
+// MigrateAll applies the pending migrations to the tables of every org. An org failing to migrate does not stop the
+// others, the errors are returned together.
+func MigrateAll(ctx context.Context, opts ...Option) error {
+	slog.Info("pulse: migrating ...")
+
+	if Get(opts...).Connection() == nil {
+		if err := Get().Start(ctx); err != nil {
+			return err
+		}
+
+		defer func() { _ = Get().Stop(ctx) }()
+	}
+
+	slugs, err := db.Queries().ListOrgSlugs(ctx)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, 0)
+
+	for _, slug := range slugs {
+		if err := Migrate(ctx, slug); err != nil {
+			slog.Error("pulse: unable to migrate", "slug", slug, "error", err.Error())
+
+			errs = append(errs, err)
+		}
+	}
+
+	slog.Info("pulse: migrations done", "orgs", len(slugs), "failed", len(errs))
+
+	return errors.Join(errs...)
+}
+
+// migrations returns the embedded migrations, sorted by version.
+func migrations() ([]migration, error) {
+	entries, err := sql.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]migration, 0, len(entries))
+
+	for _, entry := range entries {
+		matches := migration_file.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("pulse: invalid migration file name %s", entry.Name())
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := sql.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, migration{version: uint32(version), name: matches[2], body: string(body)})
+	}
+
+	slices.SortFunc(result, func(a, b migration) int { return int(a.version) - int(b.version) })
+
+	return result, nil
+}
+
+// render renders the migration for the org and splits it into statements.
+func (m migration) render(slug string) ([]string, error) {
+	funcs := template.FuncMap{"table": func(kind string) string { return table_name(kind, slug) }}
+
+	tmpl, err := template.New(m.name).Funcs(funcs).Parse(m.body)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		return nil, err
+	}
+
+	statements := make([]string, 0)
+
+	for _, statement := range statement_end.Split(buf.String(), -1) {
+		if statement = strings.TrimSpace(statement); !only_comments(statement) {
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements, nil
+}
+
+// only_comments returns true if the statement is empty or made only of comments.
+func only_comments(statement string) bool {
+	for _, line := range strings.Split(statement, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "--") {
+			return false
+		}
+	}
+
+	return true
+}
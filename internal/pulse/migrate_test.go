@@ -0,0 +1,144 @@
+package pulse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	MigrateTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *MigrateTestSuite) TestVersions() {
+	all, err := migrations()
+
+	s.Require().NoError(err)
+	s.Require().NotEmpty(all)
+
+	for i, m := range all {
+		s.Equal(uint32(i+1), m.version, "migrations must be numbered without gaps")
+	}
+}
+
+func (s *MigrateTestSuite) TestRender() {
+	all, err := migrations()
+	s.Require().NoError(err)
+
+	for _, m := range all {
+		statements, err := m.render("acme-corp")
+
+		s.Require().NoError(err, m.name)
+		s.Require().NotEmpty(statements, m.name)
+
+		for _, statement := range statements {
+			s.NotContains(statement, "{{", m.name)
+			s.False(strings.HasSuffix(statement, ";"), m.name)
+		}
+	}
+}
+
+func (s *MigrateTestSuite) TestRenderTables() {
+	m := migration{version: 1, name: "test", body: `
+-- comment only
+CREATE TABLE {{ table "events" }} (id UUID);
+
+{{ define "feed" }}SELECT * FROM {{ table "events" }}{{ end }}
+INSERT INTO {{ table "rollup_daily" }} {{ template "feed" }};
+`}
+
+	statements, err := m.render("acme-corp")
+
+	s.Require().NoError(err)
+	s.Require().Len(statements, 2)
+	s.Contains(statements[0], "CREATE TABLE events_acme_corp (id UUID)")
+	s.Equal("INSERT INTO rollup_daily_acme_corp SELECT * FROM events_acme_corp", statements[1])
+}
+
+func (s *MigrateTestSuite) TestRollupDailyCutoff() {
+	all, err := migrations()
+	s.Require().NoError(err)
+
+	statements, err := all[2].render("acme-corp")
+	s.Require().NoError(err)
+
+	cutoff := "(SELECT min(cutoff) FROM rollup_cutoff_acme_corp)"
+	view, deleted, backfill := -1, -1, -1
+
+	for i, statement := range statements {
+		switch {
+		case strings.HasPrefix(statement, "CREATE MATERIALIZED VIEW IF NOT EXISTS rollup_daily_mv_acme_corp"):
+			view = i
+
+			s.Contains(statement, "0 AS backfill")
+			s.Contains(statement, "WHERE timestamp >= "+cutoff)
+		case strings.HasPrefix(statement, "DELETE FROM rollup_daily_acme_corp"):
+			deleted = i
+		case strings.HasPrefix(statement, "INSERT INTO rollup_daily_acme_corp"):
+			backfill = i
+
+			s.Contains(statement, "1 AS backfill")
+			s.Contains(statement, "WHERE timestamp < "+cutoff)
+		}
+	}
+
+	s.Positive(view)
+	s.Greater(deleted, view, "backfilled rows must be removed after the view exists")
+	s.Equal(deleted+1, backfill, "the daily rollup must be backfilled right after removing the backfilled rows")
+}
+
+func (s *MigrateTestSuite) TestRollupFeeds() {
+	all, err := migrations()
+	s.Require().NoError(err)
+
+	statements, err := all[2].render("acme-corp")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name   string
+		rollup string
+		where  string
+	}{
+		{"prs", "rollup_prs_acme_corp", "WHERE scope = 'pr'"},
+		{"branches", "rollup_branches_acme_corp", "WHERE scope IN ('branch', 'push')"},
+		{"queue", "rollup_queue_acme_corp", "WHERE scope = 'merge_queue'"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			view_prefix := "CREATE MATERIALIZED VIEW IF NOT EXISTS " + strings.Replace(tt.rollup, "_acme_corp", "_mv_acme_corp", 1) +
+				" TO " + tt.rollup + " AS"
+			insert_prefix := "INSERT INTO " + tt.rollup
+			created, view, backfill := -1, -1, -1
+
+			for i, statement := range statements {
+				switch {
+				// the first statement of the migration starts with the header comment.
+				case strings.Contains(statement, "CREATE TABLE IF NOT EXISTS "+tt.rollup+" ("):
+					created = i
+				case strings.HasPrefix(statement, view_prefix):
+					view = i
+				case strings.HasPrefix(statement, insert_prefix):
+					backfill = i
+				}
+			}
+
+			s.Require().NotEqual(-1, created, "table")
+			s.Require().Greater(view, created, "the view feeds an existing table")
+			s.Require().Greater(backfill, view, "the backfill runs once the view feeds new events")
+
+			feed := strings.TrimSpace(strings.TrimPrefix(statements[view], view_prefix))
+
+			s.Equal(feed, strings.TrimSpace(strings.TrimPrefix(statements[backfill], insert_prefix)), "view and backfill share the feed")
+			s.Contains(feed, "FROM events_acme_corp")
+			s.Contains(feed, tt.where)
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
@@ -0,0 +1,3 @@
+ALTER TABLE {{ table "events" }}
+  ADD COLUMN IF NOT EXISTS payload_type LowCardinality(String) AFTER timestamp,
+  ADD COLUMN IF NOT EXISTS payload String CODEC(ZSTD(3)) AFTER payload_type;
@@ -0,0 +1,155 @@
+-- Rollups maintained alongside the events table, read by the delivery metrics. Each rollup is fed by a materialized view,
+-- the events persisted before the rollups existed are backfilled.
+--
+-- The pr, branch and queue rollups only keep the min, max or any of their columns, so an event seen by both the view
+-- and the backfill, or backfilled twice, is harmless. The daily rollup sums the events, so the events are split at a
+-- cutoff fixed by the first run of the migration: the view counts the events from the cutoff on, the backfill counts
+-- the events before the cutoff. The backfilled rows are flagged, and removed before backfilling again.
+
+{{ define "feed_prs" }}
+WITH (payload_type = 'ctrlplane.events.v1.PullRequest') AS is_pr
+SELECT
+  subject_id,
+  if(is_pr,
+    toInt64OrZero(JSONExtractString(payload, 'number')),
+    toInt64OrZero(JSONExtractString(payload, 'pullRequestNumber'))
+  ) AS number,
+  if(is_pr, JSONExtractString(payload, 'headBranch'), JSONExtractString(payload, 'branch')) AS branch,
+  if(is_pr, nullIf(user_id, toUUID('00000000-0000-0000-0000-000000000000')), NULL) AS user_id,
+  if(is_pr, nullIf(team_id, toUUID('00000000-0000-0000-0000-000000000000')), NULL) AS team_id,
+  if(is_pr AND action = 'opened', timestamp, NULL) AS opened_at,
+  if(NOT is_pr AND action = 'created', timestamp, NULL) AS reviewed_at,
+  if(is_pr AND action = 'completed', timestamp, NULL) AS merged_at,
+  if(is_pr AND action IN ('closed', 'completed'), timestamp, NULL) AS closed_at
+FROM {{ table "events" }}
+WHERE scope = 'pr' AND payload_type IN ('ctrlplane.events.v1.PullRequest', 'ctrlplane.events.v1.PullRequestReview')
+{{ end }}
+
+{{ define "feed_branches" }}
+SELECT
+  subject_id,
+  replaceOne(JSONExtractString(payload, 'ref'), 'refs/heads/', '') AS branch,
+  nullIf(user_id, toUUID('00000000-0000-0000-0000-000000000000')) AS user_id,
+  nullIf(team_id, toUUID('00000000-0000-0000-0000-000000000000')) AS team_id,
+  if(scope = 'branch' AND action = 'created', timestamp, NULL) AS created_at,
+  if(scope = 'push' AND action IN ('created', 'forced'), timestamp, NULL) AS first_push_at,
+  if(scope = 'branch' AND action = 'deleted', timestamp, NULL) AS deleted_at
+FROM {{ table "events" }}
+WHERE scope IN ('branch', 'push')
+  AND payload != ''
+  AND NOT startsWith(JSONExtractString(payload, 'ref'), 'refs/tags/')
+{{ end }}
+
+{{ define "feed_queue" }}
+SELECT
+  subject_id,
+  toInt64OrZero(JSONExtractString(payload, 'number')) AS number,
+  nullIf(user_id, toUUID('00000000-0000-0000-0000-000000000000')) AS user_id,
+  nullIf(team_id, toUUID('00000000-0000-0000-0000-000000000000')) AS team_id,
+  if(action = 'added', timestamp, NULL) AS added_at,
+  if(action = 'removed', timestamp, NULL) AS removed_at
+FROM {{ table "events" }}
+WHERE scope = 'merge_queue' AND payload != ''
+{{ end }}
+
+{{ define "feed_daily" }}
+{{- $backfill := eq . "backfill" -}}
+SELECT
+  toDate(timestamp) AS day,
+  scope,
+  action,
+  subject_id,
+  team_id,
+  user_id,
+  {{ if $backfill }}1{{ else }}0{{ end }} AS backfill,
+  count() AS count
+FROM {{ table "events" }}
+WHERE timestamp {{ if $backfill }}<{{ else }}>={{ end }} (SELECT min(cutoff) FROM {{ table "rollup_cutoff" }})
+GROUP BY day, scope, action, subject_id, team_id, user_id
+{{ end }}
+
+CREATE TABLE IF NOT EXISTS {{ table "rollup_prs" }} (
+  subject_id UUID,
+  number Int64,
+  branch SimpleAggregateFunction(max, String),
+  user_id SimpleAggregateFunction(any, Nullable(UUID)),
+  team_id SimpleAggregateFunction(any, Nullable(UUID)),
+  opened_at SimpleAggregateFunction(min, Nullable(DateTime)),
+  reviewed_at SimpleAggregateFunction(min, Nullable(DateTime)),
+  merged_at SimpleAggregateFunction(max, Nullable(DateTime)),
+  closed_at SimpleAggregateFunction(max, Nullable(DateTime))
+)
+ENGINE = AggregatingMergeTree()
+ORDER BY (subject_id, number);
+
+CREATE TABLE IF NOT EXISTS {{ table "rollup_branches" }} (
+  subject_id UUID,
+  branch String,
+  user_id SimpleAggregateFunction(any, Nullable(UUID)),
+  team_id SimpleAggregateFunction(any, Nullable(UUID)),
+  created_at SimpleAggregateFunction(min, Nullable(DateTime)),
+  first_push_at SimpleAggregateFunction(min, Nullable(DateTime)),
+  deleted_at SimpleAggregateFunction(max, Nullable(DateTime))
+)
+ENGINE = AggregatingMergeTree()
+ORDER BY (subject_id, branch);
+
+CREATE TABLE IF NOT EXISTS {{ table "rollup_queue" }} (
+  subject_id UUID,
+  number Int64,
+  user_id SimpleAggregateFunction(any, Nullable(UUID)),
+  team_id SimpleAggregateFunction(any, Nullable(UUID)),
+  added_at SimpleAggregateFunction(min, Nullable(DateTime)),
+  removed_at SimpleAggregateFunction(max, Nullable(DateTime))
+)
+ENGINE = AggregatingMergeTree()
+ORDER BY (subject_id, number);
+
+CREATE TABLE IF NOT EXISTS {{ table "rollup_daily" }} (
+  day Date,
+  scope LowCardinality(String),
+  action LowCardinality(String),
+  subject_id UUID,
+  team_id UUID,
+  user_id UUID,
+  backfill UInt8,
+  count UInt64
+)
+ENGINE = SummingMergeTree(count)
+PARTITION BY toYYYYMM(day)
+ORDER BY (day, scope, action, subject_id, team_id, user_id, backfill);
+
+CREATE TABLE IF NOT EXISTS {{ table "rollup_cutoff" }} (
+  cutoff DateTime64(3)
+)
+ENGINE = MergeTree()
+ORDER BY tuple();
+
+INSERT INTO {{ table "rollup_cutoff" }}
+SELECT now64(3) WHERE (SELECT count() FROM {{ table "rollup_cutoff" }}) = 0;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {{ table "rollup_prs_mv" }} TO {{ table "rollup_prs" }} AS
+{{ template "feed_prs" }};
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {{ table "rollup_branches_mv" }} TO {{ table "rollup_branches" }} AS
+{{ template "feed_branches" }};
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {{ table "rollup_queue_mv" }} TO {{ table "rollup_queue" }} AS
+{{ template "feed_queue" }};
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {{ table "rollup_daily_mv" }} TO {{ table "rollup_daily" }} AS
+{{ template "feed_daily" "view" }};
+
+INSERT INTO {{ table "rollup_prs" }}
+{{ template "feed_prs" }};
+
+INSERT INTO {{ table "rollup_branches" }}
+{{ template "feed_branches" }};
+
+INSERT INTO {{ table "rollup_queue" }}
+{{ template "feed_queue" }};
+
+DELETE FROM {{ table "rollup_daily" }} WHERE backfill = 1;
+
+INSERT INTO {{ table "rollup_daily" }}
+{{ template "feed_daily" "backfill" }};
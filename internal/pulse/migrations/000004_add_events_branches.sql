@@ -0,0 +1,18 @@
+-- The branches an event refers to, read by the branch filter of the events query. The column is materialized from the
+-- branch fields of the payload, protojson uses lower camel case names. The events persisted before the column existed
+-- are materialized in the background.
+
+ALTER TABLE {{ table "events" }}
+  ADD COLUMN IF NOT EXISTS branches Array(LowCardinality(String)) MATERIALIZED arrayDistinct(arrayFilter(b -> b != '', [
+    JSONExtractString(payload, 'branch'),
+    JSONExtractString(payload, 'headBranch'),
+    JSONExtractString(payload, 'baseBranch'),
+    replaceOne(JSONExtractString(payload, 'ref'), 'refs/heads/', '')
+  ])) AFTER payload;
+
+ALTER TABLE {{ table "events" }}
+  ADD INDEX IF NOT EXISTS branches_idx branches TYPE bloom_filter GRANULARITY 4;
+
+ALTER TABLE {{ table "events" }} MATERIALIZE COLUMN branches;
+
+ALTER TABLE {{ table "events" }} MATERIALIZE INDEX branches_idx;
@@ -0,0 +1,5 @@
+-- The storage format of the rows, see pulse.Format. The rows written before the column existed store their payload if
+-- the payload column is set, the default computes their format on read.
+
+ALTER TABLE {{ table "events" }}
+  ADD COLUMN IF NOT EXISTS format UInt8 DEFAULT if(payload = '', 1, 2) AFTER version;
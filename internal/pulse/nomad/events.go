@@ -0,0 +1,218 @@
+package nomad
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/erratic"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/events/v1/eventsv1connect"
+	"go.breu.io/quantm/internal/pulse"
+	"go.breu.io/quantm/internal/pulse/cast"
+)
+
+type (
+	// EventService is the read path of pulse. Events are always read from the events table of the org of the
+	// authenticated user.
+	EventService struct {
+		eventsv1connect.UnimplementedEventServiceHandler
+	}
+)
+
+var (
+	// EventServicePolicy declares the permissions required by the procedures of the EventService.
+	EventServicePolicy = auth.Policy{
+		eventsv1connect.EventServiceListEventsProcedure: {auth.PermissionEventsRead},
+		eventsv1connect.EventServiceGetEventProcedure:   {auth.PermissionEventsRead},
+		eventsv1connect.EventServiceGetLineageProcedure: {auth.PermissionEventsRead},
+	}
+)
+
+const (
+	PageSizeDefault = 50
+	PageSizeMax     = 500
+
+	// LineageDepth is the maximum number of generations walked when resolving the parents of an event.
+	LineageDepth = 64
+)
+
+// ListEvents lists the events of the org, newest first.
+func (s *EventService) ListEvents(
+	ctx context.Context, req *connect.Request[eventsv1.ListEventsRequest],
+) (*connect.Response[eventsv1.ListEventsResponse], error) {
+	slug, err := org_slug(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := list_query(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := pulse.Find(ctx, slug, query)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.PulseModule).Wrap(err)
+	}
+
+	return connect.NewResponse(list_response(records, query.Limit)), nil
+}
+
+// GetEvent retrieves the event with its parent chain.
+func (s *EventService) GetEvent(
+	ctx context.Context, req *connect.Request[eventsv1.GetEventRequest],
+) (*connect.Response[eventsv1.GetEventResponse], error) {
+	slug, err := org_slug(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.PulseModule).AddHint("id", req.Msg.GetId())
+	}
+
+	records, err := pulse.Records(ctx, slug, []uuid.UUID{id})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.PulseModule).Wrap(err)
+	}
+
+	if len(records) == 0 {
+		return nil, erratic.NewNotFoundError(erratic.PulseModule, "id", req.Msg.GetId())
+	}
+
+	parents, err := pulse.Lineage(ctx, slug, &records[0], LineageDepth)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.PulseModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&eventsv1.GetEventResponse{
+		Event:   cast.RecordToProto(&records[0]),
+		Parents: cast.RecordsToProto(parents),
+	}), nil
+}
+
+// GetLineage retrieves the causal graph of the event, its ancestors and its descendants.
+func (s *EventService) GetLineage(
+	ctx context.Context, req *connect.Request[eventsv1.GetLineageRequest],
+) (*connect.Response[eventsv1.GetLineageResponse], error) {
+	slug, err := org_slug(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.PulseModule).AddHint("id", req.Msg.GetId())
+	}
+
+	graph, err := pulse.LineageGraph(ctx, slug, id, LineageDepth)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.PulseModule).Wrap(err)
+	}
+
+	if graph == nil {
+		return nil, erratic.NewNotFoundError(erratic.PulseModule, "id", req.Msg.GetId())
+	}
+
+	response := cast.GraphToProto(graph)
+
+	if req.Msg.GetIncludeDot() {
+		response.Dot = graph.DOT()
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// list_query converts the request to the query of the events. The page size defaults to PageSizeDefault, and is capped
+// at PageSizeMax.
+func list_query(msg *eventsv1.ListEventsRequest) (pulse.Query, error) {
+	query := pulse.Query{
+		Branch:  msg.GetBranch(),
+		Scopes:  msg.GetScopes(),
+		Actions: msg.GetActions(),
+		Limit:   int(msg.GetPageSize()),
+	}
+
+	ids := []struct {
+		field string
+		value string
+		dest  *uuid.UUID
+	}{
+		{"repo_id", msg.GetRepoId(), &query.SubjectID},
+		{"user_id", msg.GetUserId(), &query.UserID},
+		{"team_id", msg.GetTeamId(), &query.TeamID},
+	}
+
+	for _, id := range ids {
+		if id.value == "" {
+			continue
+		}
+
+		parsed, err := uuid.Parse(id.value)
+		if err != nil {
+			return query, erratic.NewBadRequestError(erratic.PulseModule).AddHint(id.field, id.value)
+		}
+
+		*id.dest = parsed
+	}
+
+	if msg.GetCursor() != "" {
+		cursor, err := pulse.ParseCursor(msg.GetCursor())
+		if err != nil {
+			return query, erratic.NewBadRequestError(erratic.PulseModule).AddHint("cursor", msg.GetCursor())
+		}
+
+		query.Cursor = cursor
+	}
+
+	if msg.GetSince() != nil {
+		query.Since = msg.GetSince().AsTime()
+	}
+
+	if msg.GetUntil() != nil {
+		query.Until = msg.GetUntil().AsTime()
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = PageSizeDefault
+	}
+
+	query.Limit = min(query.Limit, PageSizeMax)
+
+	return query, nil
+}
+
+// list_response converts a page of events to the response. A full page has a cursor to the next page.
+func list_response(records []pulse.Record, limit int) *eventsv1.ListEventsResponse {
+	response := &eventsv1.ListEventsResponse{Events: cast.RecordsToProto(records)}
+
+	if len(records) > 0 && len(records) == limit {
+		response.NextCursor = pulse.CursorOf(&records[len(records)-1]).String()
+	}
+
+	return response
+}
+
+// org_slug returns the slug of the org of the authenticated user, the events table is named after it.
+func org_slug(ctx context.Context) (string, error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	slug, err := pulse.OrgSlug(ctx, org_id)
+	if err != nil {
+		return "", erratic.NewDatabaseError(erratic.PulseModule).Wrap(err)
+	}
+
+	return slug, nil
+}
+
+// NewEventServiceHandler creates a new EventServiceHandler and returns the service name and handler.
+func NewEventServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	auth.Declare(EventServicePolicy)
+
+	return eventsv1connect.NewEventServiceHandler(&EventService{}, opts...)
+}
@@ -0,0 +1,118 @@
+package nomad
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	EventsTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *EventsTestSuite) TestListQuery() {
+	repo := uuid.New()
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	cursor := pulse.Cursor{Timestamp: since, ID: uuid.New()}
+
+	msg := &eventsv1.ListEventsRequest{
+		RepoId:   repo.String(),
+		Branch:   "feature",
+		Since:    timestamppb.New(since),
+		Scopes:   []string{"pr"},
+		PageSize: 10,
+		Cursor:   cursor.String(),
+	}
+
+	query, err := list_query(msg)
+
+	s.Require().NoError(err)
+	s.Equal(repo, query.SubjectID)
+	s.Equal("feature", query.Branch)
+	s.Equal(since, query.Since)
+	s.True(query.Until.IsZero())
+	s.Equal([]string{"pr"}, query.Scopes)
+	s.Equal(cursor, query.Cursor)
+	s.Equal(10, query.Limit)
+}
+
+func (s *EventsTestSuite) TestListQueryLimit() {
+	tests := []struct {
+		name string
+		size int32
+		want int
+	}{
+		{"default", 0, PageSizeDefault},
+		{"negative", -1, PageSizeDefault},
+		{"requested", 20, 20},
+		{"capped", PageSizeMax + 1, PageSizeMax},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			query, err := list_query(&eventsv1.ListEventsRequest{PageSize: tt.size})
+
+			s.Require().NoError(err)
+			s.Equal(tt.want, query.Limit)
+		})
+	}
+}
+
+func (s *EventsTestSuite) TestListQueryInvalid() {
+	tests := []struct {
+		name string
+		msg  *eventsv1.ListEventsRequest
+	}{
+		{"repo", &eventsv1.ListEventsRequest{RepoId: "repo"}},
+		{"user", &eventsv1.ListEventsRequest{UserId: "user"}},
+		{"team", &eventsv1.ListEventsRequest{TeamId: "team"}},
+		{"cursor", &eventsv1.ListEventsRequest{Cursor: "cursor!"}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := list_query(tt.msg)
+			s.Error(err)
+		})
+	}
+}
+
+func (s *EventsTestSuite) TestListResponse() {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	records := []pulse.Record{
+		{ID: uuid.New(), Timestamp: now},
+		{ID: uuid.New(), Timestamp: now.Add(-time.Minute)},
+	}
+
+	tests := []struct {
+		name    string
+		records []pulse.Record
+		limit   int
+		cursor  string
+	}{
+		{"full page", records, 2, pulse.CursorOf(&records[1]).String()},
+		{"last page", records, 3, ""},
+		{"empty", []pulse.Record{}, 0, ""},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			response := list_response(tt.records, tt.limit)
+
+			s.Len(response.GetEvents(), len(tt.records))
+			s.Equal(tt.cursor, response.GetNextCursor())
+		})
+	}
+}
+
+func TestEventsSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
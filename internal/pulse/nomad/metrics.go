@@ -0,0 +1,95 @@
+package nomad
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/erratic"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/events/v1/eventsv1connect"
+	"go.breu.io/quantm/internal/pulse"
+	"go.breu.io/quantm/internal/pulse/cast"
+)
+
+type (
+	// MetricsService computes the delivery metrics of the org of the authenticated user.
+	MetricsService struct {
+		eventsv1connect.UnimplementedMetricsServiceHandler
+	}
+)
+
+var (
+	// MetricsServicePolicy declares the permissions required by the procedures of the MetricsService.
+	MetricsServicePolicy = auth.Policy{
+		eventsv1connect.MetricsServiceGetDeliveryMetricsProcedure: {auth.PermissionEventsRead},
+	}
+)
+
+// GetDeliveryMetrics computes the delivery metrics over the requested period.
+func (s *MetricsService) GetDeliveryMetrics(
+	ctx context.Context, req *connect.Request[eventsv1.GetDeliveryMetricsRequest],
+) (*connect.Response[eventsv1.GetDeliveryMetricsResponse], error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	slug, err := org_slug(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := pulse.MetricsFilter{
+		Since:   req.Msg.GetSince().AsTime(),
+		Until:   time.Now(),
+		GroupBy: cast.ProtoToGroup(req.Msg.GetGroupBy()),
+	}
+
+	if req.Msg.GetUntil() != nil {
+		filter.Until = req.Msg.GetUntil().AsTime()
+	}
+
+	if !filter.Since.Before(filter.Until) {
+		return nil, erratic.NewBadRequestError(erratic.PulseModule).
+			WithReason("since must be before until").
+			AddHint("since", filter.Since.String()).
+			AddHint("until", filter.Until.String())
+	}
+
+	if req.Msg.GetRepoId() != "" {
+		filter.SubjectID, err = uuid.Parse(req.Msg.GetRepoId())
+		if err != nil {
+			return nil, erratic.NewBadRequestError(erratic.PulseModule).AddHint("repo_id", req.Msg.GetRepoId())
+		}
+	}
+
+	metrics, err := pulse.Collect(ctx, slug, filter)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.PulseModule).Wrap(err)
+	}
+
+	response := &eventsv1.GetDeliveryMetricsResponse{
+		GroupBy: req.Msg.GetGroupBy(),
+		Metrics: make([]*eventsv1.DeliveryMetrics, 0, len(metrics)),
+	}
+
+	for i := range metrics {
+		// metrics of the org are keyed by the org itself.
+		if filter.GroupBy == pulse.GroupOrg {
+			metrics[i].Key = org_id
+		}
+
+		response.Metrics = append(response.Metrics, cast.MetricsToProto(&metrics[i]))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// NewMetricsServiceHandler creates a new MetricsServiceHandler and returns the service name and handler.
+func NewMetricsServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	auth.Declare(MetricsServicePolicy)
+
+	return eventsv1connect.NewMetricsServiceHandler(&MetricsService{}, opts...)
+}
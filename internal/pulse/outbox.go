@@ -0,0 +1,317 @@
+package pulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	// Relay drains the event outbox to clickhouse. Events land in the outbox when they could not be written while
+	// persisting, e.g. clickhouse was unavailable, or the org could not be found. Failed events are retried with an
+	// exponential backoff, up to OutboxMaxAttempts. Events exceeding the attempts stay in the outbox for inspection.
+	//
+	// The relay runs on every replica. Due events are claimed with a lease, see OutboxLease, so that an event is relayed
+	// by a single replica at a time, and relayed again once the lease expires if the replica died while relaying it.
+	//
+	// Counters are published with expvar under pulse_outbox.
+	Relay struct {
+		interval time.Duration
+		stop     chan struct{}
+		done     sync.WaitGroup
+		once     sync.Once
+	}
+)
+
+const (
+	// WriteTimeout bounds the time spent waiting for room in the buffer of the writer before falling back to the outbox.
+	WriteTimeout = 10 * time.Second
+
+	// OutboxMaxAttempts is the number of attempts to relay an event before giving up.
+	OutboxMaxAttempts = 50
+
+	// OutboxBatch is the number of events relayed at once.
+	OutboxBatch = 500
+
+	// RelayInterval is the interval between two drains of the outbox.
+	RelayInterval = 30 * time.Second
+
+	// OutboxLease is the duration a claimed event is hidden from the other replicas while it is relayed.
+	OutboxLease = 5 * time.Minute
+
+	outbox_backoff_min = 10 * time.Second
+	outbox_backoff_max = time.Hour
+)
+
+var (
+	ErrOutboxRecord = errors.New("invalid outbox record")
+)
+
+var (
+	// stats are the counters of the outbox: queued, relayed, failed and pending.
+	stats = expvar.NewMap("pulse_outbox")
+)
+
+// write queues the record for the events table of the org in the buffered writer, without waiting for the flush. If
+// the flush fails, the record is kept in the outbox, see keep. If the record cannot be queued, it is kept in the outbox
+// right away and nil is returned. An error is returned only if the outbox is unavailable as well, so that the activity
+// is retried.
+func write(ctx context.Context, org_id uuid.UUID, record Record) error {
+	slug, err := OrgSlug(ctx, org_id)
+	if err == nil {
+		wctx, cancel := context.WithTimeout(ctx, WriteTimeout)
+		err = Buffered().Queue(wctx, table_name("events", slug), record)
+
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+	}
+
+	slog.Warn("pulse: unable to write event, keeping in outbox", "id", record.ID.String(), "error", err.Error())
+
+	return enqueue(ctx, org_id, record, err)
+}
+
+// keep adds the records of a failed flush to the outbox. The activities persisting the records have already completed,
+// so a record is lost if the outbox is unavailable as well.
+func keep(table string, records []Record, cause error) {
+	ctx, cancel := context.WithTimeout(context.Background(), FlushTimeout)
+	defer cancel()
+
+	for _, record := range records {
+		// the slug of the org may have changed since it was cached.
+		if is_unknown_table(cause) {
+			InvalidateSlug(record.OrgID)
+		}
+
+		if err := enqueue(ctx, record.OrgID, record, cause); err != nil {
+			slog.Error("pulse: unable to keep event in outbox", "id", record.ID.String(), "table", table, "error", err.Error())
+		}
+	}
+}
+
+// enqueue adds the record to the outbox.
+func enqueue(ctx context.Context, org_id uuid.UUID, record Record, cause error) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	params := entities.CreateEventOutboxParams{EventID: record.ID, OrgID: org_id, Record: data, Error: cause.Error()}
+
+	if err := db.Queries().CreateEventOutbox(ctx, params); err != nil {
+		return err
+	}
+
+	stats.Add("queued", 1)
+
+	return nil
+}
+
+// NewRelay creates a relay draining the outbox every interval.
+func NewRelay(interval time.Duration) *Relay {
+	return &Relay{interval: interval, stop: make(chan struct{})}
+}
+
+// Start starts draining the outbox in the background.
+func (r *Relay) Start(_ context.Context) error {
+	r.done.Add(1)
+
+	go r.loop()
+
+	slog.Info("pulse: relay started", "interval", r.interval.String())
+
+	return nil
+}
+
+// Stop stops draining the outbox, waiting for the current drain to finish.
+func (r *Relay) Stop(_ context.Context) error {
+	r.once.Do(func() { close(r.stop) })
+	r.done.Wait()
+
+	slog.Info("pulse: relay stopped")
+
+	return nil
+}
+
+func (r *Relay) loop() {
+	defer r.done.Done()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), r.interval)
+
+			if err := r.Drain(ctx); err != nil {
+				slog.Warn("pulse: unable to drain outbox", "error", err.Error())
+			}
+
+			cancel()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// Drain relays the events due in the outbox, until none is left or the context is done.
+func (r *Relay) Drain(ctx context.Context) error {
+	defer r.pending(ctx)
+
+	for ctx.Err() == nil {
+		rows, err := db.Queries().ClaimEventOutbox(ctx, entities.ClaimEventOutboxParams{
+			LeaseUntil:  time.Now().Add(OutboxLease),
+			MaxAttempts: OutboxMaxAttempts,
+			RowLimit:    OutboxBatch,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		r.relay(ctx, rows)
+
+		if len(rows) < OutboxBatch {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+// relay writes the claimed rows to the events tables of their orgs, one batch per org. Written rows are removed from the
+// outbox, failed rows are rescheduled. Each row is either removed or rescheduled, once.
+func (r *Relay) relay(ctx context.Context, rows []entities.EventOutbox) {
+	orgs := make(map[uuid.UUID][]entities.EventOutbox)
+	for _, row := range rows {
+		orgs[row.OrgID] = append(orgs[row.OrgID], row)
+	}
+
+	for org_id, group := range orgs {
+		decoded, records, invalid := decode(group)
+
+		// rows that cannot be decoded are rescheduled, and never removed.
+		for _, row := range invalid {
+			r.fail(ctx, row, ErrOutboxRecord)
+		}
+
+		if len(decoded) == 0 {
+			continue
+		}
+
+		if err := r.send(ctx, org_id, records); err != nil {
+			for _, row := range decoded {
+				r.fail(ctx, row, err)
+			}
+
+			continue
+		}
+
+		ids := make([]uuid.UUID, 0, len(decoded))
+		for _, row := range decoded {
+			ids = append(ids, row.ID)
+		}
+
+		if err := db.Queries().DeleteEventOutbox(ctx, ids); err != nil {
+			// the events are relayed again, and skipped as already stored.
+			slog.Warn("pulse: unable to clear outbox", "org_id", org_id.String(), "error", err.Error())
+			continue
+		}
+
+		stats.Add("relayed", int64(len(ids)))
+	}
+}
+
+// decode splits the rows into the rows with a valid record, along with their records, and the invalid rows.
+func decode(rows []entities.EventOutbox) ([]entities.EventOutbox, []Record, []entities.EventOutbox) {
+	decoded := make([]entities.EventOutbox, 0, len(rows))
+	records := make([]Record, 0, len(rows))
+	invalid := make([]entities.EventOutbox, 0)
+
+	for _, row := range rows {
+		record := Record{}
+		if err := json.Unmarshal(row.Record, &record); err != nil {
+			slog.Warn("pulse: unable to decode outbox record", "id", row.EventID.String(), "error", err.Error())
+
+			invalid = append(invalid, row)
+
+			continue
+		}
+
+		decoded = append(decoded, row)
+		records = append(records, record)
+	}
+
+	return decoded, records, invalid
+}
+
+// send writes the records to the events table of the org, skipping the buffer.
+func (r *Relay) send(ctx context.Context, org_id uuid.UUID, records []Record) error {
+	slug, err := OrgSlug(ctx, org_id)
+	if err != nil {
+		return err
+	}
+
+	w := Buffered()
+
+	err = w.send(ctx, table_name("events", slug), w.dedup(records))
+	if is_unknown_table(err) {
+		InvalidateSlug(org_id)
+	}
+
+	return err
+}
+
+// fail reschedules the row with an exponential backoff.
+func (r *Relay) fail(ctx context.Context, row entities.EventOutbox, cause error) {
+	stats.Add("failed", 1)
+
+	if row.Attempts+1 >= OutboxMaxAttempts {
+		slog.Error("pulse: giving up on event", "id", row.EventID.String(), "error", cause.Error())
+	}
+
+	params := entities.FailEventOutboxParams{ID: row.ID, Error: cause.Error(), NextAttemptAt: time.Now().Add(backoff(row.Attempts))}
+
+	if err := db.Queries().FailEventOutbox(ctx, params); err != nil {
+		slog.Warn("pulse: unable to reschedule event", "id", row.EventID.String(), "error", err.Error())
+	}
+}
+
+// pending publishes the number of events in the outbox.
+func (r *Relay) pending(ctx context.Context) {
+	count, err := db.Queries().CountEventOutbox(context.WithoutCancel(ctx))
+	if err != nil {
+		return
+	}
+
+	pending := new(expvar.Int)
+	pending.Set(count)
+	stats.Set("pending", pending)
+}
+
+// backoff returns the delay before the next attempt, doubling with each attempt.
+func backoff(attempts int32) time.Duration {
+	delay := outbox_backoff_min
+
+	for i := int32(0); i < attempts && delay < outbox_backoff_max; i++ {
+		delay *= 2
+	}
+
+	return min(delay, outbox_backoff_max)
+}
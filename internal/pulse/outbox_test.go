@@ -0,0 +1,40 @@
+package pulse
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+type (
+	OutboxTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *OutboxTestSuite) TestDecodeSplitsInvalidRows() {
+	record := Record{ID: uuid.New(), Scope: "branch", Action: "created"}
+
+	data, err := json.Marshal(record)
+	s.Require().NoError(err)
+
+	valid := entities.EventOutbox{ID: uuid.New(), EventID: record.ID, Record: data}
+	invalid := entities.EventOutbox{ID: uuid.New(), EventID: uuid.New(), Record: []byte("{")}
+
+	decoded, records, failed := decode([]entities.EventOutbox{invalid, valid})
+
+	s.Require().Len(decoded, 1)
+	s.Equal(valid.ID, decoded[0].ID)
+	s.Require().Len(records, 1)
+	s.Equal(record.ID, records[0].ID)
+	s.Require().Len(failed, 1)
+	s.Equal(invalid.ID, failed[0].ID)
+}
+
+func TestOutbox(t *testing.T) {
+	suite.Run(t, new(OutboxTestSuite))
+}
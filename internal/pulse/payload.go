@@ -0,0 +1,81 @@
+package pulse
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"go.breu.io/quantm/internal/events"
+)
+
+type (
+	// Format is the version of the storage format of a row of the events table. It is independent of the version of
+	// the event, the format changes with the way pulse stores events, not with the shape of the events.
+	Format uint8
+)
+
+const (
+	// FormatFlat rows store the flat event without its payload.
+	FormatFlat Format = 1
+
+	// FormatPayload rows store the payload as protojson in the payload column, along with the full name of the payload
+	// message in the payload_type column.
+	FormatPayload Format = 2
+
+	// FormatCurrent is the format of the rows written by pulse.
+	FormatCurrent = FormatPayload
+)
+
+var (
+	// ErrNoPayload is returned when decoding the payload of an event persisted without payload.
+	ErrNoPayload = errors.New("pulse: event persisted without payload")
+
+	// ErrPayloadType is returned when the persisted payload is not of the requested type.
+	ErrPayloadType = errors.New("pulse: payload type mismatch")
+)
+
+// DecodePayload decodes the payload column of a persisted event. The format and payload type are the values of the
+// format and payload_type columns of the same row. Fields unknown to the current payload message are discarded, so
+// rows persisted by older releases can still be decoded.
+func DecodePayload[P events.Payload](format Format, kind string, data []byte) (*P, error) {
+	if format == FormatFlat || len(data) == 0 {
+		return nil, ErrNoPayload
+	}
+
+	payload := new(P)
+	msg := any(payload).(proto.Message)
+
+	if string(msg.ProtoReflect().Descriptor().FullName()) != kind {
+		return nil, ErrPayloadType
+	}
+
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// DecodeAny decodes the payload column of a persisted event into the message registered under the payload type. It is
+// used by readers that handle every kind of event.
+func DecodeAny(format Format, kind string, data []byte) (proto.Message, error) {
+	if format == FormatFlat || len(data) == 0 {
+		return nil, ErrNoPayload
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(kind))
+	if err != nil {
+		return nil, ErrPayloadType
+	}
+
+	msg := mt.New().Interface()
+
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,69 @@
+package pulse_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	PayloadTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *PayloadTestSuite) TestRoundTrip() {
+	payload := &eventsv1.Merge{HeadBranch: "main", BaseBranch: "feature", Files: []string{"a.go", "b.go"}}
+	event := events.New[eventsv1.RepoHook, eventsv1.Merge]().
+		SetScope(events.ScopeMerge).
+		SetAction(events.ActionFailure).
+		SetPayload(payload)
+
+	flat := event.Flatten()
+
+	s.Equal("ctrlplane.events.v1.Merge", flat.PayloadType)
+	s.NotEmpty(flat.Payload)
+
+	decoded, err := pulse.DecodePayload[eventsv1.Merge](pulse.FormatCurrent, flat.PayloadType, flat.Payload)
+
+	s.Require().NoError(err)
+	s.Equal(payload.GetHeadBranch(), decoded.GetHeadBranch())
+	s.Equal(payload.GetBaseBranch(), decoded.GetBaseBranch())
+	s.Equal(payload.GetFiles(), decoded.GetFiles())
+}
+
+func (s *PayloadTestSuite) TestPayloadless() {
+	_, err := pulse.DecodePayload[eventsv1.Merge](pulse.FormatCurrent, "", nil)
+
+	s.ErrorIs(err, pulse.ErrNoPayload)
+
+	_, err = pulse.DecodePayload[eventsv1.Merge](pulse.FormatFlat, "ctrlplane.events.v1.Merge", []byte("{}"))
+
+	s.ErrorIs(err, pulse.ErrNoPayload)
+}
+
+func (s *PayloadTestSuite) TestTypeMismatch() {
+	event := events.New[eventsv1.RepoHook, eventsv1.Diff]().SetPayload(&eventsv1.Diff{})
+	flat := event.Flatten()
+
+	_, err := pulse.DecodePayload[eventsv1.Merge](pulse.FormatCurrent, flat.PayloadType, []byte("{}"))
+
+	s.ErrorIs(err, pulse.ErrPayloadType)
+}
+
+func (s *PayloadTestSuite) TestUnknownFields() {
+	data := []byte(`{"headBranch": "main", "removedField": true}`)
+
+	decoded, err := pulse.DecodePayload[eventsv1.Merge](pulse.FormatPayload, "ctrlplane.events.v1.Merge", data)
+
+	s.Require().NoError(err)
+	s.Equal("main", decoded.GetHeadBranch())
+}
+
+func TestPayload(t *testing.T) {
+	suite.Run(t, new(PayloadTestSuite))
+}
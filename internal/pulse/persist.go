@@ -2,38 +2,26 @@ package pulse
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.breu.io/durex/dispatch"
 	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
-	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/events"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
-const (
-	statement__events__persist = `
-INSERT INTO %s (
-	version,
-	id,
-	parents,
-	hook,
-	scope,
-	action,
-	source,
-	subject_id,
-	subject_name,
-	user_id,
-	team_id,
-	org_id,
-	timestamp
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-`
+var (
+	ErrUnknownHook = errors.New("pulse: unknown hook")
 )
 
 // Persist persists an event to clickhouse, routing it to the appropriate activity handler based on the
 // event's associated hook.  It's a workflow-scoped function, mandating execution immediately post-event creation.
+//
+// The activities queue the event in the buffered writer and return without waiting for the batch to be flushed. A
+// failed flush keeps the event in the outbox, so persisting does not fail the workflow.
 func Persist[H events.Hook, P events.Payload](ctx workflow.Context, event *events.Event[H, P]) error {
 	ctx = dispatch.WithDefaultActivityContext(ctx)
 	flat := event.Flatten()
@@ -45,6 +33,8 @@ func Persist[H events.Hook, P events.Payload](ctx workflow.Context, event *event
 		future = workflow.ExecuteActivity(ctx, PersistRepoEvent, flat)
 	case eventsv1.ChatHook:
 		future = workflow.ExecuteActivity(ctx, PersistChatEvent, flat)
+	default:
+		return fmt.Errorf("%w: %T", ErrUnknownHook, flat.Hook)
 	}
 
 	return future.Get(ctx, nil)
@@ -52,62 +42,32 @@ func Persist[H events.Hook, P events.Payload](ctx workflow.Context, event *event
 
 // PersistRepoEvent persists a repo event to the database.
 func PersistRepoEvent(ctx context.Context, flat events.Flat[eventsv1.RepoHook]) error {
-	slug, err := db.Queries().GetOrgSlugByID(ctx, flat.OrgID)
-	if err != nil {
-		return nil
-	}
-
-	table := table_name("events", slug)
-	stmt := fmt.Sprintf(statement__events__persist, table)
-
-	return Get().
-		Connection().
-		Exec(
-			ctx,
-			stmt,
-			flat.Version,
-			flat.ID,
-			flat.Parents,
-			flat.Hook.Number(),
-			flat.Scope,
-			flat.Action,
-			flat.Source,
-			flat.SubjectID,
-			flat.SubjectName,
-			flat.UserID,
-			flat.TeamID,
-			flat.OrgID,
-			flat.Timestamp,
-		)
+	return write(ctx, flat.OrgID, to_record(flat))
 }
 
 // PersistChatEvent persists a chat event to the database.
 func PersistChatEvent(ctx context.Context, flat events.Flat[eventsv1.ChatHook]) error {
-	slug, err := db.Queries().GetOrgSlugByID(ctx, flat.OrgID)
-	if err != nil {
-		return nil
-	}
-
-	table := table_name("events", slug)
-	stmt := fmt.Sprintf(statement__events__persist, table)
+	return write(ctx, flat.OrgID, to_record(flat))
+}
 
-	return Get().
-		Connection().
-		Exec(
-			ctx,
-			stmt,
-			flat.Version,
-			flat.ID,
-			flat.Parents,
-			flat.Hook.Number(),
-			flat.Scope,
-			flat.Action,
-			flat.Source,
-			flat.SubjectID,
-			flat.SubjectName,
-			flat.UserID,
-			flat.TeamID,
-			flat.OrgID,
-			flat.Timestamp,
-		)
+// to_record converts the flattened event to a row of the events table.
+func to_record[H events.Hook](flat events.Flat[H]) Record {
+	return Record{
+		Version:     string(flat.Version),
+		Format:      uint8(FormatCurrent),
+		ID:          flat.ID,
+		Parents:     flat.Parents,
+		Hook:        int32(any(flat.Hook).(protoreflect.Enum).Number()),
+		Scope:       string(flat.Scope),
+		Action:      string(flat.Action),
+		Source:      flat.Source,
+		SubjectID:   flat.SubjectID,
+		SubjectName: string(flat.SubjectName),
+		UserID:      flat.UserID,
+		TeamID:      flat.TeamID,
+		OrgID:       flat.OrgID,
+		Timestamp:   flat.Timestamp,
+		PayloadType: flat.PayloadType,
+		Payload:     string(flat.Payload),
+	}
 }
@@ -0,0 +1,264 @@
+package pulse
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Record is a row of the events table.
+	Record struct {
+		Version     string      `ch:"version" json:"version"`
+		Format      uint8       `ch:"format" json:"format"` // Format is the storage format of the row, see Format.
+		ID          uuid.UUID   `ch:"id" json:"id"`
+		Parents     []uuid.UUID `ch:"parents" json:"parents"`
+		Hook        int32       `ch:"hook" json:"hook"`
+		Scope       string      `ch:"scope" json:"scope"`
+		Action      string      `ch:"action" json:"action"`
+		Source      string      `ch:"source" json:"source"`
+		SubjectID   uuid.UUID   `ch:"subject_id" json:"subject_id"`
+		SubjectName string      `ch:"subject_name" json:"subject_name"`
+		UserID      uuid.UUID   `ch:"user_id" json:"user_id"`
+		TeamID      uuid.UUID   `ch:"team_id" json:"team_id"`
+		OrgID       uuid.UUID   `ch:"org_id" json:"org_id"`
+		Timestamp   time.Time   `ch:"timestamp" json:"timestamp"`
+		PayloadType string      `ch:"payload_type" json:"payload_type"`
+		Payload     string      `ch:"payload" json:"payload"`
+	}
+
+	// Query narrows down the events returned by Find. Zero values are ignored. Events are returned newest first, the
+	// cursor is the position of the last event of the previous page.
+	Query struct {
+		SubjectID uuid.UUID
+		Branch    string
+		UserID    uuid.UUID
+		TeamID    uuid.UUID
+		Since     time.Time
+		Until     time.Time
+		Scopes    []string
+		Actions   []string
+		Cursor    Cursor
+		Limit     int
+	}
+
+	// Cursor is the position of an event in the pages of Find. Events are paged on their timestamp, then their id for
+	// the events sharing a timestamp.
+	Cursor struct {
+		Timestamp time.Time
+		ID        uuid.UUID
+	}
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+const (
+	statement__events__select = `
+SELECT
+	version,
+	format,
+	id,
+	parents,
+	hook,
+	scope,
+	action,
+	source,
+	subject_id,
+	subject_name,
+	user_id,
+	team_id,
+	org_id,
+	timestamp,
+	payload_type,
+	payload
+FROM %s
+WHERE %s
+ORDER BY timestamp DESC, id DESC
+LIMIT %d
+`
+)
+
+// CursorOf returns the cursor positioned at the record.
+func CursorOf(record *Record) Cursor {
+	return Cursor{Timestamp: record.Timestamp, ID: record.ID}
+}
+
+// ParseCursor parses a cursor encoded with Cursor.String.
+func ParseCursor(value string) (Cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	nanos, id, ok := strings.Cut(string(decoded), "_")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	unix, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{Timestamp: time.Unix(0, unix).UTC(), ID: parsed}, nil
+}
+
+// IsZero returns true if the cursor is not positioned, i.e. the first page.
+func (c Cursor) IsZero() bool {
+	return c.ID == uuid.Nil
+}
+
+// String encodes the cursor, the encoding is opaque to the clients.
+func (c Cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.Timestamp.UnixNano(), 10) + "_" + c.ID.String()))
+}
+
+// Find returns the events of the org matching the query.
+//
+// Events are ordered by timestamp, then by id, which follows the sorting key of the events table. Paging on both keeps
+// the pages stable while new events are persisted, and lets clickhouse skip the parts after the cursor.
+func Find(ctx context.Context, slug string, query Query) ([]Record, error) {
+	stmt, args := query.statement(table_name("events", slug))
+	result := make([]Record, 0)
+
+	if err := Get().Connection().Select(ctx, &result, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// statement builds the select statement of the query against the table, along with its arguments.
+func (query Query) statement(table string) (string, []any) {
+	where := []string{"1 = 1"}
+	args := make([]any, 0)
+
+	if query.SubjectID != uuid.Nil {
+		where = append(where, "subject_id = ?")
+		args = append(args, query.SubjectID)
+	}
+
+	if query.Branch != "" {
+		where = append(where, "has(branches, ?)")
+		args = append(args, query.Branch)
+	}
+
+	if query.UserID != uuid.Nil {
+		where = append(where, "user_id = ?")
+		args = append(args, query.UserID)
+	}
+
+	if query.TeamID != uuid.Nil {
+		where = append(where, "team_id = ?")
+		args = append(args, query.TeamID)
+	}
+
+	if !query.Since.IsZero() {
+		where = append(where, "timestamp >= ?")
+		args = append(args, query.Since)
+	}
+
+	if !query.Until.IsZero() {
+		where = append(where, "timestamp < ?")
+		args = append(args, query.Until)
+	}
+
+	if len(query.Scopes) > 0 {
+		where = append(where, "scope IN (?)")
+		args = append(args, query.Scopes)
+	}
+
+	if len(query.Actions) > 0 {
+		where = append(where, "action IN (?)")
+		args = append(args, query.Actions)
+	}
+
+	// the bound on the timestamp alone is redundant, it lets clickhouse prune the parts with the primary key.
+	if !query.Cursor.IsZero() {
+		where = append(where, "timestamp <= ?", "(timestamp, id) < (?, ?)")
+		args = append(args, query.Cursor.Timestamp, query.Cursor.Timestamp, query.Cursor.ID)
+	}
+
+	return fmt.Sprintf(statement__events__select, table, strings.Join(where, " AND "), query.Limit), args
+}
+
+// Records returns the events of the org with the given ids, in no particular order. Unknown ids are ignored.
+func Records(ctx context.Context, slug string, ids []uuid.UUID) ([]Record, error) {
+	result := make([]Record, 0)
+
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	stmt := fmt.Sprintf(statement__events__select, table_name("events", slug), "id IN (?)", len(ids))
+
+	if err := Get().Connection().Select(ctx, &result, stmt, ids); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Lineage returns the ancestors of the event, nearest first. The parents of each ancestor are followed until no new
+// ancestors are found, or the depth is reached.
+func Lineage(ctx context.Context, slug string, event *Record, depth int) ([]Record, error) {
+	seen := map[uuid.UUID]bool{event.ID: true}
+	result := make([]Record, 0)
+	next := unseen(event.Parents, seen)
+
+	for level := 0; level < depth && len(next) > 0; level++ {
+		records, err := Records(ctx, slug, next)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make(map[uuid.UUID]Record, len(records))
+		for _, record := range records {
+			found[record.ID] = record
+		}
+
+		parents := make([]uuid.UUID, 0)
+
+		// the parents list is oldest first, so it is walked backwards to return the nearest first.
+		for i := len(next) - 1; i >= 0; i-- {
+			record, ok := found[next[i]]
+			if !ok {
+				continue
+			}
+
+			result = append(result, record)
+			parents = append(parents, record.Parents...)
+		}
+
+		next = unseen(parents, seen)
+	}
+
+	return result, nil
+}
+
+// unseen returns the ids not yet seen, marking them as seen.
+func unseen(ids []uuid.UUID, seen map[uuid.UUID]bool) []uuid.UUID {
+	result := make([]uuid.UUID, 0)
+
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
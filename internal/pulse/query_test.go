@@ -0,0 +1,109 @@
+package pulse
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	QueryTestSuite struct {
+		suite.Suite
+	}
+)
+
+func (s *QueryTestSuite) TestStatement() {
+	subject := uuid.New()
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	cursor := Cursor{Timestamp: since.Add(time.Hour), ID: uuid.New()}
+
+	tests := []struct {
+		name  string
+		query Query
+		where string
+		args  []any
+	}{
+		{
+			name:  "everything",
+			query: Query{Limit: 50},
+			where: "WHERE 1 = 1\n",
+			args:  []any{},
+		},
+		{
+			name:  "subject and branch",
+			query: Query{SubjectID: subject, Branch: "feature", Limit: 50},
+			where: "WHERE 1 = 1 AND subject_id = ? AND has(branches, ?)\n",
+			args:  []any{subject, "feature"},
+		},
+		{
+			name:  "time range",
+			query: Query{Since: since, Until: since.Add(time.Hour), Limit: 50},
+			where: "WHERE 1 = 1 AND timestamp >= ? AND timestamp < ?\n",
+			args:  []any{since, since.Add(time.Hour)},
+		},
+		{
+			name:  "scopes and actions",
+			query: Query{Scopes: []string{"pr"}, Actions: []string{"opened", "closed"}, Limit: 50},
+			where: "WHERE 1 = 1 AND scope IN (?) AND action IN (?)\n",
+			args:  []any{[]string{"pr"}, []string{"opened", "closed"}},
+		},
+		{
+			name:  "cursor",
+			query: Query{Cursor: cursor, Limit: 50},
+			where: "WHERE 1 = 1 AND timestamp <= ? AND (timestamp, id) < (?, ?)\n",
+			args:  []any{cursor.Timestamp, cursor.Timestamp, cursor.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			stmt, args := tt.query.statement("events_acme")
+
+			s.Contains(stmt, "FROM events_acme\n")
+			s.Contains(stmt, tt.where)
+			s.Contains(stmt, "ORDER BY timestamp DESC, id DESC\nLIMIT 50")
+			s.NotContains(stmt, "JSONExtract")
+			s.NotContains(stmt, "toString")
+			s.Equal(tt.args, args)
+		})
+	}
+}
+
+func (s *QueryTestSuite) TestCursor() {
+	record := &Record{ID: uuid.New(), Timestamp: time.Date(2024, 10, 1, 12, 30, 15, 0, time.UTC)}
+	cursor := CursorOf(record)
+
+	parsed, err := ParseCursor(cursor.String())
+
+	s.Require().NoError(err)
+	s.Equal(cursor, parsed)
+	s.False(parsed.IsZero())
+	s.True(Cursor{}.IsZero())
+	s.NotContains(cursor.String(), record.ID.String())
+}
+
+func (s *QueryTestSuite) TestInvalidCursor() {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"not base64", "not a cursor!"},
+		{"no separator", "MTIz"},
+		{"bad timestamp", "YWJjXzAxOTI3YzBlLTAwMDAtNzAwMC04MDAwLTAwMDAwMDAwMDAwMA"},
+		{"bad id", "MTIzX25vdC1hLXV1aWQ"},
+		{"event id", uuid.New().String()},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := ParseCursor(tt.value)
+			s.ErrorIs(err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestQuerySuite(t *testing.T) {
+	suite.Run(t, new(QueryTestSuite))
+}
@@ -0,0 +1,248 @@
+package pulse
+
+import (
+	"context"
+	"expvar"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"go.breu.io/quantm/internal/pulse/config"
+)
+
+type (
+	// Sink is an external system the stored events are streamed to, so that other systems can react to the events
+	// without polling clickhouse. A sink implementing io.Closer is closed when the fanout stops.
+	Sink interface {
+		// Name identifies the sink in the logs and counters.
+		Name() string
+
+		// Send delivers the record. It must return once the sink has accepted the record, or the context is done.
+		Send(ctx context.Context, record Record) error
+	}
+
+	// Fanout streams the stored events to the sinks. Events are published by the writer once stored, queued, and sent
+	// to every sink in order.
+	//
+	// Delivery is best effort. Clickhouse stays the source of truth: when the queue is full, or a sink fails, the event
+	// is dropped for the sink and counted. Events relayed from the outbox may be sent twice, so consumers must skip
+	// duplicates by the event id.
+	//
+	// Counters are published with expvar under pulse_sinks.
+	Fanout struct {
+		sinks   []Sink
+		timeout time.Duration
+		queue   chan Record
+		stop    chan struct{}
+		done    sync.WaitGroup
+
+		mu      sync.Mutex
+		running bool
+	}
+
+	// MemorySink keeps the records in memory. It is meant for tests.
+	MemorySink struct {
+		mu      sync.Mutex
+		records []Record
+	}
+)
+
+var (
+	_f     *Fanout
+	_fonce sync.Once
+
+	// fanned are the counters of the fanout: dropped, plus sent and failed for each sink.
+	fanned = expvar.NewMap("pulse_sinks")
+)
+
+// Sinks returns the fanout streaming the stored events to the sinks configured in pulse.
+func Sinks() *Fanout {
+	_fonce.Do(func() {
+		cfg := Get().Sinks
+		_f = NewFanout(cfg.Buffer, time.Duration(cfg.Timeout)*time.Millisecond, configured(cfg)...)
+	})
+
+	return _f
+}
+
+// NewFanout creates a fanout to the sinks, queueing up to buffer events, and bounding each delivery by the timeout.
+func NewFanout(buffer int, timeout time.Duration, sinks ...Sink) *Fanout {
+	return &Fanout{
+		sinks:   sinks,
+		timeout: timeout,
+		queue:   make(chan Record, buffer),
+	}
+}
+
+// Add adds the sink to the fanout. It must be called before the fanout is started.
+func (f *Fanout) Add(sink Sink) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sinks = append(f.sinks, sink)
+}
+
+// Start starts sending the queued events in the background.
+func (f *Fanout) Start(_ context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.running {
+		return nil
+	}
+
+	f.running = true
+	f.stop = make(chan struct{})
+	f.done.Add(1)
+
+	go f.loop(f.stop)
+
+	names := make([]string, 0, len(f.sinks))
+	for _, sink := range f.sinks {
+		names = append(names, sink.Name())
+	}
+
+	slog.Info("pulse: sinks started", "sinks", names, "buffer", cap(f.queue))
+
+	return nil
+}
+
+// Stop sends the events still queued, and closes the sinks.
+func (f *Fanout) Stop(_ context.Context) error {
+	f.mu.Lock()
+
+	if !f.running {
+		f.mu.Unlock()
+		return nil
+	}
+
+	f.running = false
+	stop := f.stop
+	f.mu.Unlock()
+
+	close(stop)
+	f.done.Wait()
+
+	for _, sink := range f.sinks {
+		if closer, ok := sink.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+
+	slog.Info("pulse: sinks stopped")
+
+	return nil
+}
+
+// Publish queues the records for the sinks. It never blocks, records not fitting in the queue are dropped.
+func (f *Fanout) Publish(records ...Record) {
+	f.mu.Lock()
+	empty := len(f.sinks) == 0
+	f.mu.Unlock()
+
+	if empty {
+		return
+	}
+
+	for _, record := range records {
+		select {
+		case f.queue <- record:
+		default:
+			fanned.Add("dropped", 1)
+			slog.Warn("pulse: sink queue full, dropping event", "id", record.ID.String())
+		}
+	}
+}
+
+func (f *Fanout) loop(stop <-chan struct{}) {
+	defer f.done.Done()
+
+	for {
+		select {
+		case record := <-f.queue:
+			f.send(record)
+		case <-stop:
+			for {
+				select {
+				case record := <-f.queue:
+					f.send(record)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// send delivers the record to every sink.
+func (f *Fanout) send(record Record) {
+	f.mu.Lock()
+	sinks := slices.Clone(f.sinks)
+	f.mu.Unlock()
+
+	for _, sink := range sinks {
+		ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+		err := sink.Send(ctx, record)
+
+		cancel()
+
+		if err != nil {
+			fanned.Add(sink.Name()+"_failed", 1)
+			slog.Warn("pulse: unable to send event", "sink", sink.Name(), "id", record.ID.String(), "error", err.Error())
+
+			continue
+		}
+
+		fanned.Add(sink.Name()+"_sent", 1)
+	}
+}
+
+// configured returns the sinks enabled by the configuration.
+func configured(cfg config.Sinks) []Sink {
+	sinks := make([]Sink, 0)
+
+	if cfg.HTTPURL != "" {
+		sinks = append(sinks, NewHTTPSink(cfg.HTTPURL, cfg.HTTPMode))
+	}
+
+	if cfg.NATSURL != "" {
+		sinks = append(sinks, NewNATSSink(cfg.NATSURL, cfg.NATSSubject))
+	}
+
+	return sinks
+}
+
+// NewMemorySink creates an empty memory sink.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{records: make([]Record, 0)}
+}
+
+func (m *MemorySink) Name() string { return "memory" }
+
+// Send keeps the record.
+func (m *MemorySink) Send(_ context.Context, record Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.records = append(m.records, record)
+
+	return nil
+}
+
+// Records returns the records received so far, oldest first.
+func (m *MemorySink) Records() []Record {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.records)
+}
+
+// Reset drops the records received so far.
+func (m *MemorySink) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.records = m.records[:0]
+}
@@ -0,0 +1,72 @@
+package pulse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Tally is the number of events for a subject with the given scope and action.
+	Tally struct {
+		SubjectID uuid.UUID `ch:"subject_id"`
+		Scope     string    `ch:"scope"`
+		Action    string    `ch:"action"`
+		Count     uint64    `ch:"count"`
+	}
+
+	// TallyFilter narrows down the events counted by Tallies. An event is counted when it belongs to the user or to any
+	// of the teams. Without a user, only the events of the teams are counted.
+	TallyFilter struct {
+		Since   time.Time
+		UserID  uuid.UUID
+		TeamIDs []uuid.UUID
+	}
+)
+
+const (
+	statement__events__tally = `
+SELECT
+	subject_id,
+	scope,
+	action,
+	count() AS count
+FROM %s
+WHERE timestamp >= ? AND (%s)
+GROUP BY subject_id, scope, action
+`
+)
+
+// Tallies counts the events in the events table of the org since the time given in the filter, grouped by subject,
+// scope and action.
+func Tallies(ctx context.Context, slug string, filter TallyFilter) ([]Tally, error) {
+	table := table_name("events", slug)
+	owners := make([]string, 0, 2)
+	args := []any{filter.Since}
+
+	if filter.UserID != uuid.Nil {
+		owners = append(owners, "user_id = ?")
+		args = append(args, filter.UserID)
+	}
+
+	if len(filter.TeamIDs) > 0 {
+		owners = append(owners, "has(?, team_id)")
+		args = append(args, filter.TeamIDs)
+	}
+
+	if len(owners) == 0 {
+		return []Tally{}, nil
+	}
+
+	stmt := fmt.Sprintf(statement__events__tally, table, strings.Join(owners, " OR "))
+	result := make([]Tally, 0)
+
+	if err := Get().Connection().Select(ctx, &result, stmt, args...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
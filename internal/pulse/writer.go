@@ -0,0 +1,504 @@
+package pulse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db"
+)
+
+type (
+	// Writer buffers the events to persist and writes them in batches, one batch per table. A batch is flushed when it
+	// reaches the batch size, or when the batch wait is over.
+	//
+	// Write returns once the event is flushed, and reports the result of the flush. Queue returns once the event is
+	// buffered, the events of a failed flush are handed to the fallback of the writer. Events already stored are skipped
+	// by their id, so retries do not duplicate events.
+	//
+	// When the buffer is full, Write and Queue block until a flush frees up room, or the context is done.
+	//
+	// A writer can be started again after it is stopped.
+	Writer struct {
+		size     int
+		wait     time.Duration
+		slots    chan struct{} // slots limits the number of buffered events.
+		kick     chan string   // kick requests the flush of a table.
+		done     sync.WaitGroup
+		insert   inserter // insert writes a batch to clickhouse, replaced in tests.
+		fallback fallback // fallback receives the queued events of a failed flush, nil drops them.
+
+		mu      sync.Mutex
+		running bool
+		stop    chan struct{} // stop is created on each start, and closed on stop.
+		batches map[string][]*queued
+		stored  *recent
+	}
+
+	// inserter inserts the records in the table, and returns the records inserted, i.e. the records not already stored.
+	inserter func(ctx context.Context, table string, records []Record) ([]Record, error)
+
+	// fallback receives the queued records of a failed flush, along with the error of the flush.
+	fallback func(table string, records []Record, cause error)
+
+	// queued is an event waiting in the buffer along with the channel its writer waits on. Queued events have no channel.
+	queued struct {
+		record Record
+		result chan error
+	}
+
+	// recent is a bounded set of the ids of the events recently stored.
+	recent struct {
+		ids  map[uuid.UUID]struct{}
+		ring []uuid.UUID
+		next int
+	}
+
+	// slug is a cached org slug.
+	slug struct {
+		value   string
+		expires time.Time
+	}
+)
+
+const (
+	// SlugTTL is the duration an org slug is cached for.
+	SlugTTL = 15 * time.Minute
+
+	// FlushTimeout bounds the time a single flush may take.
+	FlushTimeout = 30 * time.Second
+
+	// recent_size is the number of stored ids remembered by the writer.
+	recent_size = 10000
+
+	// code__unknown_table is the code of the clickhouse exception raised when writing to a table that does not exist.
+	code__unknown_table = 60
+
+	statement__events__batch = `
+INSERT INTO %s (
+	version,
+	format,
+	id,
+	parents,
+	hook,
+	scope,
+	action,
+	source,
+	subject_id,
+	subject_name,
+	user_id,
+	team_id,
+	org_id,
+	timestamp,
+	payload_type,
+	payload
+)`
+
+	statement__events__stored = `
+SELECT id
+FROM %s
+WHERE timestamp >= ? AND timestamp <= ? AND id IN (?)
+`
+)
+
+var (
+	_w     *Writer
+	_wonce sync.Once
+
+	slugs   = make(map[uuid.UUID]slug)
+	slugsmu sync.RWMutex
+)
+
+// Buffered returns the writer used to persist events, configured with the batch settings of pulse.
+func Buffered() *Writer {
+	_wonce.Do(func() {
+		cfg := Get()
+		_w = NewWriter(cfg.BatchSize, time.Duration(cfg.BatchWait)*time.Millisecond, cfg.BatchBuffer)
+		_w.fallback = keep
+	})
+
+	return _w
+}
+
+// NewWriter creates a writer flushing batches of the given size, at least every wait, buffering up to buffer events.
+func NewWriter(size int, wait time.Duration, buffer int) *Writer {
+	return &Writer{
+		size:    size,
+		wait:    wait,
+		slots:   make(chan struct{}, buffer),
+		kick:    make(chan string, buffer),
+		insert:  insert,
+		batches: make(map[string][]*queued),
+		stored:  &recent{ids: make(map[uuid.UUID]struct{}), ring: make([]uuid.UUID, recent_size)},
+	}
+}
+
+// Start starts flushing the buffered events in the background.
+func (w *Writer) Start(_ context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return nil
+	}
+
+	w.running = true
+	w.stop = make(chan struct{})
+	w.done.Add(1)
+
+	go w.loop(w.stop)
+
+	slog.Info("pulse: writer started", "size", w.size, "wait", w.wait.String(), "buffer", cap(w.slots))
+
+	return nil
+}
+
+// Stop stops the background flushes, flushing the events still buffered.
+func (w *Writer) Stop(_ context.Context) error {
+	w.mu.Lock()
+
+	if !w.running {
+		w.mu.Unlock()
+		return nil
+	}
+
+	w.running = false
+	stop := w.stop
+	w.mu.Unlock()
+
+	close(stop)
+	w.done.Wait()
+
+	slog.Info("pulse: writer stopped")
+
+	return nil
+}
+
+// Write buffers the record for the table and waits until it is flushed. If the writer is not started, the record is
+// flushed right away.
+func (w *Writer) Write(ctx context.Context, table string, record Record) error {
+	q := &queued{record: record, result: make(chan error, 1)}
+
+	if err := w.buffer(ctx, table, q); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-q.result:
+		return err
+	case <-ctx.Done():
+		// the record stays buffered, if it is flushed, the retry of the caller is deduplicated.
+		return ctx.Err()
+	}
+}
+
+// Queue buffers the record for the table, without waiting for the flush. If the flush fails, the record is handed to
+// the fallback of the writer. If the writer is not started, the record is flushed right away.
+func (w *Writer) Queue(ctx context.Context, table string, record Record) error {
+	return w.buffer(ctx, table, &queued{record: record})
+}
+
+// buffer adds the event to the batch of the table, waiting for room in the buffer, and requests the flush of the batch
+// once it is full.
+func (w *Writer) buffer(ctx context.Context, table string, q *queued) error {
+	select {
+	case w.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	w.mu.Lock()
+	running := w.running
+	w.batches[table] = append(w.batches[table], q)
+	full := len(w.batches[table]) >= w.size
+	w.mu.Unlock()
+
+	switch {
+	case !running:
+		for w.flush(table) { // drain the table, the record may not be in the first batch.
+		}
+	case full:
+		select {
+		case w.kick <- table:
+		default: // a flush is already requested
+		}
+	}
+
+	return nil
+}
+
+// loop flushes the tables on request, and every table on each tick, until stop is closed.
+func (w *Writer) loop(stop <-chan struct{}) {
+	defer w.done.Done()
+
+	ticker := time.NewTicker(w.wait)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case table := <-w.kick:
+			w.flush(table)
+		case <-ticker.C:
+			w.flush_all()
+		case <-stop:
+			w.flush_all()
+			return
+		}
+	}
+}
+
+// flush_all flushes every table with buffered events.
+func (w *Writer) flush_all() {
+	w.mu.Lock()
+	tables := make([]string, 0, len(w.batches))
+
+	for table, batch := range w.batches {
+		if len(batch) > 0 {
+			tables = append(tables, table)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, table := range tables {
+		w.flush(table)
+	}
+}
+
+// flush writes the buffered events of the table, up to the batch size, and reports the result to their writers. It
+// returns false if there was nothing to flush.
+func (w *Writer) flush(table string) bool {
+	w.mu.Lock()
+	batch := w.batches[table]
+	n := min(len(batch), w.size)
+	take := batch[:n]
+	w.batches[table] = batch[n:]
+	w.mu.Unlock()
+
+	if n == 0 {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), FlushTimeout)
+	defer cancel()
+
+	err := w.send(ctx, table, w.pending(take))
+	if err != nil {
+		slog.Warn("pulse: unable to flush", "table", table, "events", n, "error", err.Error())
+	}
+
+	failed := make([]Record, 0)
+
+	for _, q := range take {
+		switch {
+		case q.result != nil:
+			q.result <- err
+		case err != nil:
+			failed = append(failed, q.record)
+		}
+
+		<-w.slots
+	}
+
+	if len(failed) > 0 {
+		if w.fallback != nil {
+			w.fallback(table, failed, err)
+		} else {
+			slog.Error("pulse: dropping events", "table", table, "events", len(failed), "error", err.Error())
+		}
+	}
+
+	// more events may have been buffered while flushing.
+	w.mu.Lock()
+	more := len(w.batches[table]) >= w.size
+	w.mu.Unlock()
+
+	if more {
+		select {
+		case w.kick <- table:
+		default:
+		}
+	}
+
+	return true
+}
+
+// send inserts the records not yet stored. The inserted records are published to the sinks.
+func (w *Writer) send(ctx context.Context, table string, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	appended, err := w.insert(ctx, table, records)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	for _, record := range appended {
+		w.stored.add(record.ID)
+	}
+	w.mu.Unlock()
+
+	Sinks().Publish(appended...)
+
+	return nil
+}
+
+// insert inserts the records not already in the table, using the native batch api.
+func insert(ctx context.Context, table string, records []Record) ([]Record, error) {
+	stored, err := stored_ids(ctx, table, records)
+	if err != nil {
+		return nil, err
+	}
+
+	prepared, err := Get().Connection().PrepareBatch(ctx, fmt.Sprintf(statement__events__batch, table))
+	if err != nil {
+		return nil, err
+	}
+
+	appended := make([]Record, 0, len(records))
+
+	for i := range records {
+		if _, ok := stored[records[i].ID]; ok {
+			continue
+		}
+
+		if err := prepared.AppendStruct(&records[i]); err != nil {
+			_ = prepared.Abort()
+			return nil, err
+		}
+
+		appended = append(appended, records[i])
+	}
+
+	if len(appended) == 0 {
+		return appended, prepared.Abort()
+	}
+
+	if err := prepared.Send(); err != nil {
+		return nil, err
+	}
+
+	return appended, nil
+}
+
+// pending returns the records of the batch that are not known to be stored, without duplicates.
+func (w *Writer) pending(batch []*queued) []Record {
+	records := make([]Record, 0, len(batch))
+	for _, q := range batch {
+		records = append(records, q.record)
+	}
+
+	return w.dedup(records)
+}
+
+// dedup returns the records that are not known to be stored, without duplicates.
+func (w *Writer) dedup(records []Record) []Record {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	result := make([]Record, 0, len(records))
+	seen := make(map[uuid.UUID]struct{}, len(records))
+
+	for _, record := range records {
+		if _, ok := seen[record.ID]; ok || w.stored.has(record.ID) {
+			continue
+		}
+
+		seen[record.ID] = struct{}{}
+		result = append(result, record)
+	}
+
+	return result
+}
+
+// stored_ids returns the ids of the records already in the table. The lookup is bounded by the timestamps of the
+// records, so only the matching partitions are read.
+func stored_ids(ctx context.Context, table string, records []Record) (map[uuid.UUID]struct{}, error) {
+	ids := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+
+	first := slices.MinFunc(records, func(a, b Record) int { return a.Timestamp.Compare(b.Timestamp) }).Timestamp
+	last := slices.MaxFunc(records, func(a, b Record) int { return a.Timestamp.Compare(b.Timestamp) }).Timestamp
+
+	rows := make([]struct {
+		ID uuid.UUID `ch:"id"`
+	}, 0)
+
+	if err := Get().Connection().Select(ctx, &rows, fmt.Sprintf(statement__events__stored, table), first, last, ids); err != nil {
+		return nil, err
+	}
+
+	result := make(map[uuid.UUID]struct{}, len(rows))
+	for _, row := range rows {
+		result[row.ID] = struct{}{}
+	}
+
+	return result, nil
+}
+
+// is_unknown_table returns true if the error is raised by clickhouse for a table that does not exist.
+func is_unknown_table(err error) bool {
+	var ex *clickhouse.Exception
+
+	return errors.As(err, &ex) && ex.Code == code__unknown_table
+}
+
+// add adds the id to the set, evicting the oldest id if the set is full.
+func (r *recent) add(id uuid.UUID) {
+	if _, ok := r.ids[id]; ok {
+		return
+	}
+
+	if old := r.ring[r.next]; old != uuid.Nil {
+		delete(r.ids, old)
+	}
+
+	r.ring[r.next] = id
+	r.ids[id] = struct{}{}
+	r.next = (r.next + 1) % len(r.ring)
+}
+
+// has returns true if the id is in the set.
+func (r *recent) has(id uuid.UUID) bool {
+	_, ok := r.ids[id]
+	return ok
+}
+
+// OrgSlug returns the slug of the org, cached for SlugTTL.
+func OrgSlug(ctx context.Context, org_id uuid.UUID) (string, error) {
+	slugsmu.RLock()
+	cached, ok := slugs[org_id]
+	slugsmu.RUnlock()
+
+	if ok && time.Now().Before(cached.expires) {
+		return cached.value, nil
+	}
+
+	value, err := db.Queries().GetOrgSlugByID(ctx, org_id)
+	if err != nil {
+		return "", err
+	}
+
+	slugsmu.Lock()
+	slugs[org_id] = slug{value: value, expires: time.Now().Add(SlugTTL)}
+	slugsmu.Unlock()
+
+	return value, nil
+}
+
+// InvalidateSlug drops the cached slug of the org. It must be called when the slug of an org changes.
+func InvalidateSlug(org_id uuid.UUID) {
+	slugsmu.Lock()
+	delete(slugs, org_id)
+	slugsmu.Unlock()
+}
@@ -0,0 +1,279 @@
+package pulse
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	WriterTestSuite struct {
+		suite.Suite
+	}
+
+	// fake_table records the batches inserted by a writer.
+	fake_table struct {
+		mu      sync.Mutex
+		batches [][]uuid.UUID
+	}
+)
+
+func (t *fake_table) insert(_ context.Context, _ string, records []Record) ([]Record, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ids := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+
+	t.batches = append(t.batches, ids)
+
+	return records, nil
+}
+
+func (t *fake_table) inserted() [][]uuid.UUID {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.batches
+}
+
+// writer returns a writer inserting into the table.
+func (s *WriterTestSuite) writer(size int, wait time.Duration, buffer int, table *fake_table) *Writer {
+	w := NewWriter(size, wait, buffer)
+	w.insert = table.insert
+
+	return w
+}
+
+// write writes a record in the background, the result is sent on the returned channel.
+func (s *WriterTestSuite) write(ctx context.Context, w *Writer, id uuid.UUID) <-chan error {
+	result := make(chan error, 1)
+
+	go func() { result <- w.Write(ctx, "events_acme", Record{ID: id, Timestamp: time.Now()}) }()
+
+	return result
+}
+
+func (s *WriterTestSuite) TestFlushOnSize() {
+	table := &fake_table{}
+	w := s.writer(2, time.Hour, 10, table)
+	ctx := context.Background()
+	a, b := uuid.New(), uuid.New()
+
+	s.Require().NoError(w.Start(ctx))
+
+	first, second := s.write(ctx, w, a), s.write(ctx, w, b)
+
+	s.NoError(s.wait(first))
+	s.NoError(s.wait(second))
+	s.Require().Len(table.inserted(), 1)
+	s.ElementsMatch([]uuid.UUID{a, b}, table.inserted()[0])
+
+	s.NoError(w.Stop(ctx))
+}
+
+func (s *WriterTestSuite) TestFlushOnInterval() {
+	table := &fake_table{}
+	w := s.writer(100, 20*time.Millisecond, 10, table)
+	ctx := context.Background()
+	id := uuid.New()
+
+	s.Require().NoError(w.Start(ctx))
+
+	s.NoError(s.wait(s.write(ctx, w, id)))
+	s.Equal([][]uuid.UUID{{id}}, table.inserted())
+
+	s.NoError(w.Stop(ctx))
+}
+
+func (s *WriterTestSuite) TestFlushOnStop() {
+	table := &fake_table{}
+	w := s.writer(100, time.Hour, 10, table)
+	ctx := context.Background()
+	id := uuid.New()
+
+	s.Require().NoError(w.Start(ctx))
+
+	result := s.write(ctx, w, id)
+
+	s.Eventually(func() bool { return s.buffered(w) == 1 }, time.Second, time.Millisecond)
+	s.NoError(w.Stop(ctx))
+	s.NoError(s.wait(result))
+	s.Equal([][]uuid.UUID{{id}}, table.inserted())
+}
+
+func (s *WriterTestSuite) TestBackpressure() {
+	table := &fake_table{}
+	w := s.writer(100, time.Hour, 1, table)
+	ctx := context.Background()
+
+	s.Require().NoError(w.Start(ctx))
+
+	held := s.write(ctx, w, uuid.New())
+
+	s.Eventually(func() bool { return s.buffered(w) == 1 }, time.Second, time.Millisecond)
+
+	// the buffer is full, the write blocks until the context is done.
+	tctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	s.ErrorIs(w.Write(tctx, "events_acme", Record{ID: uuid.New()}), context.DeadlineExceeded)
+	s.Empty(table.inserted())
+
+	s.NoError(w.Stop(ctx))
+	s.NoError(s.wait(held))
+	s.Len(table.inserted(), 1)
+}
+
+func (s *WriterTestSuite) TestDedup() {
+	table := &fake_table{}
+	w := s.writer(10, time.Hour, 10, table)
+	ctx := context.Background()
+	id := uuid.New()
+
+	// the writer is not started, each write is flushed right away.
+	s.NoError(w.Write(ctx, "events_acme", Record{ID: id}))
+	s.NoError(w.Write(ctx, "events_acme", Record{ID: id}))
+
+	s.Equal([][]uuid.UUID{{id}}, table.inserted())
+	s.True(w.stored.has(id))
+}
+
+func (s *WriterTestSuite) TestQueue() {
+	table := &fake_table{}
+	w := s.writer(10, time.Hour, 10, table)
+	ctx := context.Background()
+	id := uuid.New()
+
+	s.Require().NoError(w.Start(ctx))
+
+	// the record is buffered, queue does not wait for the flush.
+	s.NoError(w.Queue(ctx, "events_acme", Record{ID: id, Timestamp: time.Now()}))
+	s.Equal(1, s.buffered(w))
+	s.Empty(table.inserted())
+
+	s.NoError(w.Stop(ctx))
+	s.Equal([][]uuid.UUID{{id}}, table.inserted())
+}
+
+func (s *WriterTestSuite) TestQueueFallback() {
+	w := NewWriter(10, time.Hour, 10)
+	ctx := context.Background()
+	cause := errors.New("clickhouse is down")
+	queued, written := uuid.New(), uuid.New()
+	kept := make([]uuid.UUID, 0)
+
+	w.insert = func(context.Context, string, []Record) ([]Record, error) { return nil, cause }
+	w.fallback = func(table string, records []Record, err error) {
+		s.Equal("events_acme", table)
+		s.ErrorIs(err, cause)
+
+		for _, record := range records {
+			kept = append(kept, record.ID)
+		}
+	}
+
+	s.Require().NoError(w.Start(ctx))
+
+	result := s.write(ctx, w, written)
+
+	s.Eventually(func() bool { return s.buffered(w) == 1 }, time.Second, time.Millisecond)
+	s.NoError(w.Queue(ctx, "events_acme", Record{ID: queued, Timestamp: time.Now()}))
+	s.NoError(w.Stop(ctx))
+
+	// the writer waiting on the flush gets the error, the queued record goes to the fallback.
+	s.ErrorIs(s.wait(result), cause)
+	s.Equal([]uuid.UUID{queued}, kept)
+}
+
+func (s *WriterTestSuite) TestRestart() {
+	table := &fake_table{}
+	w := s.writer(1, time.Hour, 10, table)
+	ctx := context.Background()
+
+	s.Require().NoError(w.Start(ctx))
+	s.Require().NoError(w.Stop(ctx))
+	s.Require().NoError(w.Start(ctx))
+
+	s.NoError(s.wait(s.write(ctx, w, uuid.New())))
+
+	s.NoError(w.Stop(ctx))
+	s.NoError(w.Stop(ctx))
+	s.Len(table.inserted(), 1)
+}
+
+// buffered returns the number of records buffered for the table.
+func (s *WriterTestSuite) buffered(w *Writer) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.batches["events_acme"])
+}
+
+// wait waits for the result of a write, failing after a second.
+func (s *WriterTestSuite) wait(result <-chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(time.Second):
+		s.Fail("write did not return")
+		return nil
+	}
+}
+
+func (s *WriterTestSuite) TestRecentEviction() {
+	r := &recent{ids: make(map[uuid.UUID]struct{}), ring: make([]uuid.UUID, 2)}
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	r.add(a)
+	r.add(b)
+	r.add(b)
+
+	s.True(r.has(a))
+	s.True(r.has(b))
+
+	r.add(c)
+
+	s.False(r.has(a))
+	s.True(r.has(b))
+	s.True(r.has(c))
+}
+
+func (s *WriterTestSuite) TestPendingSkipsDuplicates() {
+	w := NewWriter(10, 0, 10)
+	stored, twice, fresh := uuid.New(), uuid.New(), uuid.New()
+
+	w.stored.add(stored)
+
+	batch := []*queued{
+		{record: Record{ID: stored}},
+		{record: Record{ID: twice}},
+		{record: Record{ID: fresh}},
+		{record: Record{ID: twice}},
+	}
+
+	records := w.pending(batch)
+
+	s.Require().Len(records, 2)
+	s.Equal(twice, records[0].ID)
+	s.Equal(fresh, records[1].ID)
+}
+
+func (s *WriterTestSuite) TestOutboxBackoff() {
+	s.Equal(10*time.Second, backoff(0))
+	s.Equal(20*time.Second, backoff(1))
+	s.Equal(80*time.Second, backoff(3))
+	s.Equal(time.Hour, backoff(12))
+	s.Equal(time.Hour, backoff(OutboxMaxAttempts))
+}
+
+func TestWriter(t *testing.T) {
+	suite.Run(t, new(WriterTestSuite))
+}